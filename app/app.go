@@ -2,55 +2,266 @@ package app
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
 
+	"github.com/glekoz/online-shop_product/pkg/blob"
+	"github.com/glekoz/online-shop_product/pkg/i18n"
 	"github.com/glekoz/online-shop_product/pkg/log"
 	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+	"github.com/glekoz/online-shop_product/pkg/promo"
 	"github.com/google/uuid"
 )
 
 type RepoAPI interface {
 	Create(ctx context.Context, id string, prod models.Product) error
 	Get(ctx context.Context, id string) (models.Product, error)
+	GetBySlug(ctx context.Context, slug string) (string, models.Product, error)
+	GetMany(ctx context.Context, ids []string) (map[string]models.Product, error)
 	GetAll(ctx context.Context) ([]models.ProductDigest, error)
 	Delete(ctx context.Context, id string) error
 	Update(ctx context.Context, id string, prod models.Product) error
+
+	CreateCategory(ctx context.Context, id string, c models.Category) error
+	GetCategory(ctx context.Context, id string) (models.Category, error)
+	GetCategoryBySlug(ctx context.Context, slug string) (models.Category, error)
+	ListCategories(ctx context.Context) ([]models.Category, error)
+	UpdateCategory(ctx context.Context, id string, c models.Category) error
+	DeleteCategory(ctx context.Context, id string) error
+	SetProductCategories(ctx context.Context, productID string, categoryIDs []string) error
+	GetProductCategories(ctx context.Context, productID string) ([]models.Category, error)
+	ListProductsByCategory(ctx context.Context, categoryID string, descendants bool, limit, offset int) ([]models.ProductDigest, error)
+
+	CreateAttributeDefinition(ctx context.Context, id string, d models.AttributeDefinition) error
+	DeleteAttributeDefinition(ctx context.Context, id string) error
+	ListAttributeDefinitions(ctx context.Context, categoryID string) ([]models.AttributeDefinition, error)
+	ProductAttributeDefinitions(ctx context.Context, productID string) ([]models.AttributeDefinition, error)
+	SetProductAttributes(ctx context.Context, productID string, attrs models.Attributes) error
+	GetProductAttributes(ctx context.Context, productID string) (models.Attributes, error)
+	FilterProducts(ctx context.Context, f models.ProductFilter) (models.FilterResult, error)
+
+	CreateVariant(ctx context.Context, id string, v models.Variant) error
+	GetVariant(ctx context.Context, id string) (models.Variant, error)
+	GetVariantBySKU(ctx context.Context, sku string) (models.Variant, error)
+	ListVariants(ctx context.Context, productID string) ([]models.Variant, error)
+	UpdateVariant(ctx context.Context, id string, v models.Variant) error
+	DeleteVariant(ctx context.Context, id string) error
+
+	AdjustStock(ctx context.Context, productID, location string, delta int, reason, actor string) (int, error)
+	GetStock(ctx context.Context, productID string) (models.Stock, error)
+	SetLowStockThreshold(ctx context.Context, productID string, threshold int) error
+	ListLowStock(ctx context.Context, limit, offset int) ([]models.Stock, error)
+	ListStockMovements(ctx context.Context, productID string, limit, offset int) ([]models.StockMovement, error)
+
+	Reserve(ctx context.Context, res models.Reservation, ttl time.Duration) (models.Reservation, error)
+	CommitReservation(ctx context.Context, id, actor string) (models.Reservation, error)
+	ReleaseReservation(ctx context.Context, id string) (models.Reservation, error)
+	GetReservation(ctx context.Context, id string) (models.Reservation, error)
+	ExpireReservations(ctx context.Context, limit int) (int, error)
+
+	SetBasePrice(ctx context.Context, productID string, price money.Money) error
+	SetProductPrice(ctx context.Context, productID string, price money.Money) error
+	GetProductPrice(ctx context.Context, productID, currency string) (money.Money, error)
+	ListProductPrices(ctx context.Context, productID string) ([]money.Money, error)
+	DeleteProductPrice(ctx context.Context, productID, currency string) error
+	SetCurrencyRate(ctx context.Context, rate money.Rate) error
+	GetCurrencyRate(ctx context.Context, base, quote string) (models.CurrencyRate, error)
+	ListCurrencyRates(ctx context.Context) ([]models.CurrencyRate, error)
+	DeleteCurrencyRate(ctx context.Context, base, quote string) error
+
+	SchedulePrice(ctx context.Context, productID string, price money.Money, at time.Time) (int64, error)
+	CancelScheduledPrice(ctx context.Context, id int64) error
+	ListPriceHistory(ctx context.Context, productID string, limit, offset int) ([]models.PriceChange, error)
+	PriceAt(ctx context.Context, productID string, at time.Time) (money.Money, error)
+	ApplyDuePrices(ctx context.Context, limit int) (int, error)
+
+	SetStatus(ctx context.Context, id string, to models.ProductStatus, check func(from models.ProductStatus) error) error
+	SetSchedule(ctx context.Context, id string, s models.Schedule, check func(status models.ProductStatus) error) error
+	ApplyDueStatuses(ctx context.Context, limit int) (int, error)
+
+	CreatePromotion(ctx context.Context, id string, p promo.Promotion) error
+	UpdatePromotion(ctx context.Context, id string, p promo.Promotion) error
+	DeletePromotion(ctx context.Context, id string) error
+	GetPromotion(ctx context.Context, id string) (promo.Promotion, error)
+	ListPromotions(ctx context.Context) ([]promo.Promotion, error)
+	ListCurrentPromotions(ctx context.Context) ([]promo.Promotion, error)
+	ProductCategoryPaths(ctx context.Context, productIDs []string) (map[string][]string, error)
+
+	AddImage(ctx context.Context, img models.Image) (models.Image, error)
+	GetImage(ctx context.Context, id string) (models.Image, error)
+	ListImages(ctx context.Context, productID string) ([]models.Image, error)
+	PrimaryImages(ctx context.Context, productIDs []string) (map[string]models.Image, error)
+	DeleteImage(ctx context.Context, id string) (models.Image, error)
+	SetPrimaryImage(ctx context.Context, id string) error
+	ReorderImages(ctx context.Context, productID string, imageIDs []string) error
+
+	Search(ctx context.Context, q models.SearchQuery) (models.SearchResult, error)
+	SetProductSearchLanguage(ctx context.Context, productID, language string) error
+	FuzzySearch(ctx context.Context, q models.FuzzyQuery) (models.SearchResult, error)
+	SuggestNames(ctx context.Context, query string, threshold float32, limit int) ([]string, error)
+	SimilarProducts(ctx context.Context, name string, threshold float32, limit int) ([]models.SimilarProduct, error)
+
+	ImportProducts(ctx context.Context, rows []models.ImportRow, dryRun bool) ([]models.ImportRowResult, error)
+	Export(ctx context.Context, f models.ExportFilter, fn func([]models.ExportRow) error) error
+	BulkUpdatePrices(ctx context.Context, updates []models.PriceUpdate, atomic bool) ([]models.BulkItemResult, error)
+	BulkDelete(ctx context.Context, ids []string, atomic bool) ([]models.BulkItemResult, []models.Image, error)
+	ListAudit(ctx context.Context, f models.AuditFilter) ([]models.AuditEntry, error)
+
+	SetTranslation(ctx context.Context, productID string, t models.Translation) error
+	DeleteTranslation(ctx context.Context, productID, locale string) error
+	ListTranslations(ctx context.Context, productID string) ([]models.Translation, error)
+	FindTranslations(ctx context.Context, productIDs, locales []string) (map[string]map[string]models.Translation, error)
+	ListMissingTranslations(ctx context.Context, locale string, limit, offset int) ([]models.UntranslatedProduct, error)
 }
 
 type App struct {
-	r RepoAPI
+	r        RepoAPI
+	promos   promoCache
+	storage  blob.Storage
+	imageCfg ImageConfig
+	// searchLang - словарь для поисковых запросов без явного языка
+	searchLang string
+	// fuzzyThreshold - порог сходства для поиска с опечатками и подсказок,
+	// duplicateThreshold - для предупреждения о дублях при создании
+	fuzzyThreshold     float32
+	duplicateThreshold float32
+	feed               FeedConfig
+	// defaultLocale - язык Name и Description самих товаров
+	defaultLocale string
 }
 
-func New(r RepoAPI) *App {
-	return &App{r: r}
+type Option func(a *App)
+
+func New(r RepoAPI, opts ...Option) *App {
+	a := &App{
+		r:                  r,
+		searchLang:         DefaultSearchLanguage,
+		fuzzyThreshold:     DefaultFuzzyThreshold,
+		duplicateThreshold: DefaultDuplicateThreshold,
+		defaultLocale:      i18n.DefaultLocale,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
 }
 
-func (a *App) Create(ctx context.Context, prod models.Product) (string, error) {
+// Create возвращает и товары с похожими названиями: уникальность в базе
+// проверяет только точное совпадение, а "Glazed Donuts" рядом с "Glazed Donut" -
+// скорее всего дубль. Создание они не блокируют, решает администратор.
+// Новый товар - черновик, на витрину он попадет после проверки и публикации
+func (a *App) Create(ctx context.Context, prod models.Product) (string, []models.SimilarProduct, error) {
 	uuid, err := uuid.NewV7()
 	if err != nil {
 		//log.WrapError()
 		//return "", models.ErrInternal
-		return "", err
+		return "", nil, err
 	}
+	similar := a.similarProducts(ctx, prod.Name)
 	if err = a.r.Create(ctx, uuid.String(), models.Product{
 		Name:        prod.Name,
 		Price:       prod.Price,
 		Description: prod.Description,
 	}); err != nil {
-		return "", log.WrapError(ctx, err)
+		return "", nil, log.WrapError(ctx, err)
 	}
-	return uuid.String(), nil
+	return uuid.String(), similar, nil
 }
 
+// Get: неопубликованный товар для всех, кроме администраторов каталога, - ErrNotFound
 func (a *App) Get(ctx context.Context, id string) (models.Product, error) {
-	return a.r.Get(ctx, id)
+	p, err := a.r.Get(ctx, id)
+	if err != nil {
+		return models.Product{}, err
+	}
+	if !visible(ctx, p) {
+		return models.Product{}, models.ErrNotFound
+	}
+	a.decorateProduct(ctx, id, &p)
+	return p, nil
+}
+
+// decorateProduct - акции и перевод для одного товара, как в листингах
+func (a *App) decorateProduct(ctx context.Context, id string, p *models.Product) {
+	digest := []models.ProductDigest{{ID: id, Price: p.Price}}
+	a.applyPromotions(ctx, digest)
+	p.EffectivePrice, p.Promotions = digest[0].EffectivePrice, digest[0].Promotions
+	a.translateProduct(p, a.translate(ctx, []string{id}), id)
+}
+
+// MaxBatchGet - больше id за раз не принимаем: в корзине и заказе столько позиций не бывает
+const MaxBatchGet = 100
+
+// BatchGet возвращает товары в порядке ids; ненайденные помечаются Found=false,
+// а не обрывают весь запрос. Повторы id допустимы и получают тот же товар
+func (a *App) BatchGet(ctx context.Context, ids []string) ([]models.BatchItem, error) {
+	if len(ids) > MaxBatchGet {
+		return nil, fmt.Errorf("%w: at most %d", models.ErrTooManyIDs, MaxBatchGet)
+	}
+	uniq := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !slices.Contains(uniq, id) {
+			uniq = append(uniq, id)
+		}
+	}
+	found, err := a.r.GetMany(ctx, uniq)
+	if err != nil {
+		return nil, log.WrapError(ctx, err)
+	}
+	maps.DeleteFunc(found, func(_ string, p models.Product) bool { return !visible(ctx, p) })
+	digests := make([]models.ProductDigest, 0, len(found))
+	for _, id := range uniq {
+		if p, ok := found[id]; ok {
+			digests = append(digests, models.ProductDigest{ID: id, Price: p.Price})
+		}
+	}
+	a.applyPromotions(ctx, digests)
+	tr := a.translate(ctx, uniq)
+	for _, d := range digests {
+		p := found[d.ID]
+		p.EffectivePrice, p.Promotions = d.EffectivePrice, d.Promotions
+		a.translateProduct(&p, tr, d.ID)
+		found[d.ID] = p
+	}
+
+	items := make([]models.BatchItem, len(ids))
+	for i, id := range ids {
+		p, ok := found[id]
+		items[i] = models.BatchItem{ID: id, Found: ok, Product: p}
+	}
+	return items, nil
 }
 
 func (a *App) GetAll(ctx context.Context) ([]models.ProductDigest, error) {
-	return a.r.GetAll(ctx)
+	prods, err := a.r.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a.applyPromotions(ctx, prods)
+	a.applyImages(ctx, prods)
+	a.applyTranslations(ctx, prods)
+	return prods, nil
 }
 
+// Delete удаляет и файлы картинок товара: записи о них удалит каскад в базе
 func (a *App) Delete(ctx context.Context, id string) error {
-	return a.r.Delete(ctx, id)
+	var imgs []models.Image
+	if a.storage != nil {
+		var err error
+		if imgs, err = a.r.ListImages(ctx, id); err != nil {
+			return err
+		}
+	}
+	if err := a.r.Delete(ctx, id); err != nil {
+		return err
+	}
+	for _, img := range imgs {
+		a.deleteBlobs(ctx, imageKeys(img))
+	}
+	return nil
 }
 
 // хотя нужно сделать проверку ещё в шлюзе, чтобы пустых полей в запросе (форме) не было
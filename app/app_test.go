@@ -0,0 +1,937 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/blob"
+	"github.com/glekoz/online-shop_product/pkg/bulk"
+	"github.com/glekoz/online-shop_product/pkg/i18n"
+	"github.com/glekoz/online-shop_product/pkg/imaging"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+	"github.com/glekoz/online-shop_product/pkg/promo"
+)
+
+// repoStub реализует только нужные тесту методы, остальные паникуют
+type repoStub struct {
+	RepoAPI
+	categories []models.Category
+}
+
+func (r *repoStub) ListCategories(ctx context.Context) ([]models.Category, error) {
+	return r.categories, nil
+}
+
+func TestCategoryTree(t *testing.T) {
+	a := New(&repoStub{categories: []models.Category{
+		{ID: "3", ParentID: "2", Name: "Glazed", Slug: "glazed"},
+		{ID: "1", Name: "Bakery", Slug: "bakery"},
+		{ID: "2", ParentID: "1", Name: "Donuts", Slug: "donuts"},
+		{ID: "4", ParentID: "1", Name: "Eclairs", Slug: "eclairs"},
+		{ID: "5", Name: "Drinks", Slug: "drinks"},
+	}})
+	roots, err := a.CategoryTree(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roots) != 2 || roots[0].Slug != "bakery" || roots[1].Slug != "drinks" {
+		t.Fatalf("unexpected roots: %+v", roots)
+	}
+	bakery := roots[0]
+	if len(bakery.Children) != 2 || bakery.Children[0].Slug != "donuts" || bakery.Children[1].Slug != "eclairs" {
+		t.Fatalf("unexpected bakery children: %+v", bakery.Children)
+	}
+	if len(bakery.Children[0].Children) != 1 || bakery.Children[0].Children[0].Slug != "glazed" {
+		t.Fatalf("unexpected donuts children: %+v", bakery.Children[0].Children)
+	}
+}
+
+func TestUpdateCategorySelfParent(t *testing.T) {
+	a := New(&repoStub{})
+	err := a.UpdateCategory(context.Background(), "1", models.Category{ParentID: "1", Name: "Bakery", Slug: "bakery"})
+	if err != models.ErrCategoryCycle {
+		t.Fatalf("got %v, want %v", err, models.ErrCategoryCycle)
+	}
+}
+
+func TestValidateAttributes(t *testing.T) {
+	defs := []models.AttributeDefinition{
+		{Code: "brand", Type: models.AttributeString},
+		{Code: "weight", Type: models.AttributeNumber},
+		{Code: "vegan", Type: models.AttributeBoolean},
+		{Code: "color", Type: models.AttributeEnum, EnumValues: []string{"red", "green"}},
+	}
+	tests := []struct {
+		name  string
+		attrs models.Attributes
+		ok    bool
+	}{
+		{"valid", models.Attributes{"brand": "Acme", "weight": 1.5, "vegan": true, "color": "red"}, true},
+		{"unknown code", models.Attributes{"size": "XL"}, false},
+		{"string for number", models.Attributes{"weight": "heavy"}, false},
+		{"number for bool", models.Attributes{"vegan": 1.0}, false},
+		{"enum value not allowed", models.Attributes{"color": "blue"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAttributes(defs, tt.attrs)
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && !errors.Is(err, models.ErrInvalidAttribute) {
+				t.Fatalf("got %v, want %v", err, models.ErrInvalidAttribute)
+			}
+		})
+	}
+}
+
+// stockStub запоминает склад, на который ушло движение
+type stockStub struct {
+	RepoAPI
+	location string
+}
+
+func (r *stockStub) AdjustStock(ctx context.Context, productID, location string, delta int, reason, actor string) (int, error) {
+	r.location = location
+	return delta, nil
+}
+
+func TestAdjustStockDefaultLocation(t *testing.T) {
+	r := &stockStub{}
+	qty, err := New(r).AdjustStock(context.Background(), "1", "", 3, "")
+	if err != nil || qty != 3 {
+		t.Fatalf("got %d, %v", qty, err)
+	}
+	if r.location != models.DefaultLocation {
+		t.Fatalf("got location %q, want %q", r.location, models.DefaultLocation)
+	}
+}
+
+func TestNormalizeReservationItems(t *testing.T) {
+	got := normalizeItems([]models.ReservationItem{
+		{ProductID: "b", Quantity: 1},
+		{ProductID: "a", Location: "spb", Quantity: 2},
+		{ProductID: "b", Location: models.DefaultLocation, Quantity: 3},
+	})
+	want := []models.ReservationItem{
+		{ProductID: "a", Location: "spb", Quantity: 2},
+		{ProductID: "b", Location: models.DefaultLocation, Quantity: 4},
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+// priceStub: товар стоит 100 рублей, в долларах цена задана вручную, в евро - только курс
+type priceStub struct {
+	RepoAPI
+}
+
+func (r *priceStub) Get(ctx context.Context, id string) (models.Product, error) {
+	return models.Product{Name: "Donut", Price: money.New(10000, "RUB"), Status: models.StatusPublished}, nil
+}
+
+func (r *priceStub) GetProductPrice(ctx context.Context, productID, currency string) (money.Money, error) {
+	if currency == "USD" {
+		return money.New(125, "USD"), nil
+	}
+	return money.Money{}, models.ErrNotFound
+}
+
+func (r *priceStub) GetCurrencyRate(ctx context.Context, base, quote string) (models.CurrencyRate, error) {
+	if base == "RUB" && quote == "EUR" {
+		return models.CurrencyRate{Rate: money.Rate{Base: "RUB", Quote: "EUR", Rate: "0.0107", Rounding: money.RoundUp, Increment: 10}}, nil
+	}
+	return models.CurrencyRate{}, models.ErrNotFound
+}
+
+func TestQuotePrice(t *testing.T) {
+	a := New(&priceStub{})
+	tests := []struct {
+		currency string
+		want     models.PriceQuote
+		err      error
+	}{
+		{"RUB", models.PriceQuote{Price: money.New(10000, "RUB"), Source: models.PriceBase}, nil},
+		{"USD", models.PriceQuote{Price: money.New(125, "USD"), Source: models.PriceExplicit}, nil},
+		{"EUR", models.PriceQuote{Price: money.New(110, "EUR"), Source: models.PriceConverted, Rate: "0.0107"}, nil},
+		{"JPY", models.PriceQuote{}, models.ErrNoExchangeRate},
+	}
+	for _, tt := range tests {
+		t.Run(tt.currency, func(t *testing.T) {
+			got, err := a.QuotePrice(context.Background(), "1", tt.currency)
+			if !errors.Is(err, tt.err) || got != tt.want {
+				t.Fatalf("got %+v, %v; want %+v, %v", got, err, tt.want, tt.err)
+			}
+		})
+	}
+}
+
+func TestSchedulePriceInPast(t *testing.T) {
+	_, err := New(&repoStub{}).SchedulePrice(context.Background(), "1", money.New(900, "RUB"), time.Now().Add(-time.Minute))
+	if err != models.ErrScheduleInPast {
+		t.Fatalf("got %v, want %v", err, models.ErrScheduleInPast)
+	}
+}
+
+// promoStub считает, сколько раз загружались акции
+type promoStub struct {
+	RepoAPI
+	loads int
+}
+
+func (r *promoStub) Get(ctx context.Context, id string) (models.Product, error) {
+	return models.Product{Name: "Donut", Price: money.New(10000, "RUB"), Status: models.StatusPublished}, nil
+}
+
+func (r *promoStub) ListCurrentPromotions(ctx context.Context) ([]promo.Promotion, error) {
+	r.loads++
+	return []promo.Promotion{{ID: "week", Kind: promo.Percent, Value: 1000, Target: promo.TargetCategory, TargetID: "bakery"}}, nil
+}
+
+func (r *promoStub) ProductCategoryPaths(ctx context.Context, productIDs []string) (map[string][]string, error) {
+	return map[string][]string{"1": {"donuts", "bakery"}}, nil
+}
+
+func (r *promoStub) DeletePromotion(ctx context.Context, id string) error {
+	return nil
+}
+
+func TestGetAppliesPromotions(t *testing.T) {
+	r := &promoStub{}
+	a := New(r)
+	ctx := context.Background()
+	for range 2 {
+		p, err := a.Get(ctx, "1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Price.Amount != 10000 || p.EffectivePrice.Amount != 9000 || !slices.Equal(p.Promotions, []string{"week"}) {
+			t.Fatalf("unexpected product: %+v", p)
+		}
+	}
+	if r.loads != 1 {
+		t.Fatalf("promotions loaded %d times, want 1", r.loads)
+	}
+	if err := a.DeletePromotion(ctx, "week"); err != nil {
+		t.Fatal(err)
+	}
+	a.Get(ctx, "1")
+	if r.loads != 2 {
+		t.Fatalf("promotions are not reloaded after change")
+	}
+}
+
+type imageStub struct {
+	RepoAPI
+	addErr error
+	added  []models.Image
+}
+
+func (r *imageStub) Get(ctx context.Context, id string) (models.Product, error) {
+	if id != "1" {
+		return models.Product{}, models.ErrNotFound
+	}
+	return models.Product{Name: "Donut"}, nil
+}
+
+func (r *imageStub) AddImage(ctx context.Context, img models.Image) (models.Image, error) {
+	if r.addErr != nil {
+		return models.Image{}, r.addErr
+	}
+	img.Position, img.Primary = len(r.added), len(r.added) == 0
+	r.added = append(r.added, img)
+	return img, nil
+}
+
+func pngBytes(t *testing.T, w, h int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, w, h))); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestUploadImage(t *testing.T) {
+	dir := t.TempDir()
+	storage, err := blob.NewFS(dir, "https://cdn.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &imageStub{}
+	a := New(r, WithImages(storage, ImageConfig{MaxSize: 4096, Sizes: []imaging.Size{{Name: "small", Max: 10}}}))
+	ctx := context.Background()
+
+	img, err := a.UploadImage(ctx, models.Image{ProductID: "1", ContentType: "image/png", Alt: "donut"}, bytes.NewReader(pngBytes(t, 40, 20)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !img.Primary || img.Width != 40 || img.Alt != "donut" || len(img.Thumbnails) != 1 {
+		t.Fatalf("unexpected image: %+v", img)
+	}
+	th := img.Thumbnails[0]
+	if th.Width != 10 || th.Height != 5 || th.URL != "https://cdn.example.com/"+th.Key {
+		t.Fatalf("unexpected thumbnail: %+v", th)
+	}
+	for _, key := range []string{img.Key, th.Key} {
+		if _, err := os.Stat(filepath.Join(dir, key)); err != nil {
+			t.Fatalf("blob %s: %v", key, err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		meta models.Image
+		data []byte
+		want error
+	}{
+		{"Unknown Product", models.Image{ProductID: "2"}, pngBytes(t, 4, 4), models.ErrNotFound},
+		{"Declared Type Mismatch", models.Image{ProductID: "1", ContentType: "image/jpeg"}, pngBytes(t, 4, 4), imaging.ErrUnsupportedType},
+		{"Not An Image", models.Image{ProductID: "1"}, []byte("<html></html>"), imaging.ErrUnsupportedType},
+		{"Too Large", models.Image{ProductID: "1"}, make([]byte, 4097), models.ErrImageTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := a.UploadImage(ctx, tt.meta, bytes.NewReader(tt.data)); !errors.Is(err, tt.want) {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	// файлы не должны оставаться, если запись в базу не удалась
+	r.addErr = errors.New("db is down")
+	if _, err := a.UploadImage(ctx, models.Image{ProductID: "1"}, bytes.NewReader(pngBytes(t, 4, 4))); err == nil {
+		t.Fatal("expected error")
+	}
+	entries, _ := os.ReadDir(filepath.Join(dir, "products", "1"))
+	if len(entries) != 1 {
+		t.Fatalf("orphaned blobs left: %d image directories, want 1", len(entries))
+	}
+}
+
+func TestUploadImageDisabled(t *testing.T) {
+	_, err := New(&imageStub{}).UploadImage(context.Background(), models.Image{ProductID: "1"}, bytes.NewReader(nil))
+	if !errors.Is(err, models.ErrImagesDisabled) {
+		t.Fatalf("got %v, want %v", err, models.ErrImagesDisabled)
+	}
+}
+
+func TestPrefixQuery(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"шоколадный пон", "'шоколадный' & 'пон':*"},
+		{"  don", "'don':*"},
+		{"it's 100% o'clock!", "'it' & 's' & '100' & 'o' & 'clock':*"},
+		{"a:* | !b", "'a' & 'b':*"},
+		{" - ! ", ""},
+	}
+	for _, tt := range tests {
+		if got := prefixQuery(tt.in); got != tt.want {
+			t.Errorf("prefixQuery(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+type searchStub struct {
+	RepoAPI
+	got       models.SearchQuery
+	suggested string
+}
+
+func (r *searchStub) SuggestNames(ctx context.Context, query string, threshold float32, limit int) ([]string, error) {
+	r.suggested = query
+	return []string{"Glazed Donut"}, nil
+}
+
+func (r *searchStub) Search(ctx context.Context, q models.SearchQuery) (models.SearchResult, error) {
+	r.got = q
+	return models.SearchResult{}, nil
+}
+
+func TestSearchDefaults(t *testing.T) {
+	r := &searchStub{}
+	a := New(r, WithSearchLanguage("english"))
+	ctx := context.Background()
+	maxPrice := int64(50000)
+	if _, err := a.Search(ctx, models.SearchQuery{Query: " donut ", MaxPrice: &maxPrice}); err != nil {
+		t.Fatal(err)
+	}
+	if r.got.Query != "donut" || r.got.Language != "english" || r.got.Currency != money.DefaultCurrency || r.got.Limit != defaultPageSize {
+		t.Fatalf("unexpected query: %+v", r.got)
+	}
+	// подсказка строится по введенному тексту, а не по tsquery
+	res, err := a.Search(ctx, models.SearchQuery{Query: "donutt gla", Prefix: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.suggested != "donutt gla" || len(res.DidYouMean) != 1 || res.DidYouMean[0] != "Glazed Donut" {
+		t.Fatalf("unexpected suggestion for %q: %v", r.suggested, res.DidYouMean)
+	}
+	if _, err := a.Search(ctx, models.SearchQuery{Query: "   "}); !errors.Is(err, models.ErrEmptyQuery) {
+		t.Fatalf("got %v, want %v", err, models.ErrEmptyQuery)
+	}
+	if _, err := a.Search(ctx, models.SearchQuery{Query: "donut", Language: "klingon"}); !errors.Is(err, models.ErrUnknownLanguage) {
+		t.Fatalf("got %v, want %v", err, models.ErrUnknownLanguage)
+	}
+}
+
+type fuzzyStub struct {
+	RepoAPI
+	got     models.FuzzyQuery
+	created bool
+}
+
+func (r *fuzzyStub) FuzzySearch(ctx context.Context, q models.FuzzyQuery) (models.SearchResult, error) {
+	r.got = q
+	return models.SearchResult{}, nil
+}
+
+func (r *fuzzyStub) Create(ctx context.Context, id string, prod models.Product) error {
+	r.created = true
+	return nil
+}
+
+func (r *fuzzyStub) SimilarProducts(ctx context.Context, name string, threshold float32, limit int) ([]models.SimilarProduct, error) {
+	if name == "broken" {
+		return nil, errors.New("pg_trgm is not installed")
+	}
+	return []models.SimilarProduct{{ID: "1", Name: "Glazed Donut", Similarity: threshold}}, nil
+}
+
+func TestFuzzy(t *testing.T) {
+	r := &fuzzyStub{}
+	a := New(r, WithFuzzyThresholds(0, 0.8))
+	ctx := context.Background()
+
+	if _, err := a.FuzzySearch(ctx, models.FuzzyQuery{Query: " donutt "}); err != nil {
+		t.Fatal(err)
+	}
+	if r.got.Query != "donutt" || r.got.Threshold != DefaultFuzzyThreshold || r.got.Limit != defaultPageSize {
+		t.Fatalf("unexpected query: %+v", r.got)
+	}
+	if _, err := a.FuzzySearch(ctx, models.FuzzyQuery{Query: "donutt", Threshold: 1.5}); !errors.Is(err, models.ErrInvalidThreshold) {
+		t.Fatalf("got %v, want %v", err, models.ErrInvalidThreshold)
+	}
+
+	_, similar, err := a.Create(ctx, models.Product{Name: "Glazed Donuts", Price: money.New(100, "RUB")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(similar) != 1 || similar[0].Similarity != 0.8 {
+		t.Fatalf("unexpected similar products: %+v", similar)
+	}
+	// сбой проверки дублей не мешает создать товар
+	r.created = false
+	if _, similar, err = a.Create(ctx, models.Product{Name: "broken"}); err != nil || !r.created || similar != nil {
+		t.Fatalf("create with failed duplicate check: created %v, similar %v, err %v", r.created, similar, err)
+	}
+}
+
+type importStub struct {
+	RepoAPI
+	got []models.ImportRow
+}
+
+func (r *importStub) ImportProducts(ctx context.Context, rows []models.ImportRow, dryRun bool) ([]models.ImportRowResult, error) {
+	r.got = rows
+	results := make([]models.ImportRowResult, len(rows))
+	for i, row := range rows {
+		results[i] = models.ImportRowResult{Line: row.Line, Name: row.Name, ID: row.ID, Status: models.ImportCreated}
+	}
+	return results, nil
+}
+
+func TestImport(t *testing.T) {
+	r := &importStub{}
+	a := New(r)
+	ctx := context.Background()
+	data := "sku,name,description,price,currency\n" +
+		"D-1,Glazed Donut,Tasty,120.50,\n" +
+		"D-2,Eclair,Tasty,0,\n" +
+		"D-1,Cake,Tasty,10,\n" +
+		"D-3,Pie,Tasty,10,XXX\n" +
+		"D-4,Broken\n" +
+		",Muffin,Tasty,5,USD\n"
+
+	report, err := a.Import(ctx, bulk.CSV, strings.NewReader(data), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.got) != 2 || r.got[0].Price != money.New(12050, "RUB") || r.got[1].Price != money.New(500, "USD") {
+		t.Fatalf("unexpected valid rows: %+v", r.got)
+	}
+	if report.Created != 2 || report.Failed != 4 || !report.DryRun || len(report.Rows) != 6 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	for i, row := range report.Rows {
+		if row.Line != i+2 {
+			t.Fatalf("rows are not in file order: %+v", report.Rows)
+		}
+		if row.Status == models.ImportCreated && row.ID != "" {
+			t.Fatalf("dry run reports id %s", row.ID)
+		}
+	}
+	if !strings.Contains(report.Rows[2].Error, "line 2") {
+		t.Fatalf("duplicate sku: %q", report.Rows[2].Error)
+	}
+
+	if _, err := a.Import(ctx, bulk.CSV, strings.NewReader("name,weight\n"), false); !errors.Is(err, bulk.ErrBadHeader) {
+		t.Fatalf("got %v, want %v", err, bulk.ErrBadHeader)
+	}
+	huge := strings.Repeat(`{"name":"x","description":"y","price":1}`+"\n", MaxImportRows+1)
+	if _, err := a.Import(ctx, bulk.NDJSON, strings.NewReader(huge), false); !errors.Is(err, models.ErrImportTooLarge) {
+		t.Fatalf("got %v, want %v", err, models.ErrImportTooLarge)
+	}
+}
+
+// exportStub отдает товары двумя пачками, как курсор
+type exportStub struct {
+	RepoAPI
+	filter models.ExportFilter
+}
+
+func (r *exportStub) Export(ctx context.Context, f models.ExportFilter, fn func([]models.ExportRow) error) error {
+	r.filter = f
+	batches := [][]models.ExportRow{
+		{{ProductDigest: models.ProductDigest{ID: "1", Name: "Donut", Price: money.New(12050, "RUB"), Available: true}, SKU: "D-1"}},
+		{{ProductDigest: models.ProductDigest{ID: "2", Name: "Eclair", Price: money.New(500, "JPY")}}},
+	}
+	for _, b := range batches {
+		if err := fn(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *exportStub) ListCurrentPromotions(ctx context.Context) ([]promo.Promotion, error) {
+	return []promo.Promotion{{ID: "sale", Kind: promo.Percent, Value: 1000, Target: promo.TargetProduct, TargetID: "1"}}, nil
+}
+
+func TestExport(t *testing.T) {
+	r := &exportStub{}
+	a := New(r, WithFeed(FeedConfig{ProductURL: "https://shop.example.com/p/{id}"}))
+	ctx := context.Background()
+	var buf strings.Builder
+	f := models.ExportFilter{Currency: "RUB", InStockOnly: true}
+	n, err := a.Export(ctx, f, bulk.CSV, []string{"id", "sku", "price", "sale_price", "link"}, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "id,sku,price,sale_price,link\n" +
+		"1,D-1,120.50,108.45,https://shop.example.com/p/1\n" +
+		"2,,500,,https://shop.example.com/p/2\n"
+	if n != 2 || buf.String() != want {
+		t.Fatalf("exported %d products:\n%s\nwant:\n%s", n, buf.String(), want)
+	}
+	if r.filter != f {
+		t.Fatalf("filter %+v is not passed to repository", r.filter)
+	}
+
+	if _, err := a.Export(ctx, models.ExportFilter{Currency: "XXX"}, bulk.CSV, nil, io.Discard); !errors.Is(err, money.ErrUnknownCurrency) {
+		t.Fatalf("got %v, want %v", err, money.ErrUnknownCurrency)
+	}
+	if _, err := a.Export(ctx, models.ExportFilter{}, bulk.CSV, []string{"weight"}, io.Discard); !errors.Is(err, bulk.ErrUnknownColumn) {
+		t.Fatalf("got %v, want %v", err, bulk.ErrUnknownColumn)
+	}
+}
+
+// batchStub знает товары 1 и 2 и запоминает, какие id у него спросили
+type batchStub struct {
+	promoStub
+	asked []string
+}
+
+func (r *batchStub) GetMany(ctx context.Context, ids []string) (map[string]models.Product, error) {
+	r.asked = ids
+	prods := map[string]models.Product{
+		"1": {Name: "Donut", Price: money.New(10000, "RUB"), Status: models.StatusPublished},
+		"2": {Name: "Eclair", Price: money.New(500, "RUB"), Status: models.StatusPublished},
+		"3": {Name: "Croissant", Price: money.New(300, "RUB"), Status: models.StatusDraft},
+	}
+	res := make(map[string]models.Product)
+	for _, id := range ids {
+		if p, ok := prods[id]; ok {
+			res[id] = p
+		}
+	}
+	return res, nil
+}
+
+func TestBatchGet(t *testing.T) {
+	r := &batchStub{}
+	a := New(r)
+	ctx := context.Background()
+	items, err := a.BatchGet(ctx, []string{"2", "missing", "1", "2", "3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(r.asked, []string{"2", "missing", "1", "3"}) {
+		t.Fatalf("repository asked for %v", r.asked)
+	}
+	if len(items) != 5 {
+		t.Fatalf("got %d items", len(items))
+	}
+	// черновик 3 витрине не виден
+	for i, want := range []string{"2", "missing", "1", "2", "3"} {
+		if items[i].ID != want || items[i].Found != (want != "missing" && want != "3") {
+			t.Fatalf("item %d: %+v", i, items[i])
+		}
+	}
+	// акция на категорию bakery из promoStub действует на товар 1
+	if items[2].Product.EffectivePrice.Amount != 9000 || items[0].Product.EffectivePrice.Amount != 500 {
+		t.Fatalf("promotions are not applied: %+v", items)
+	}
+
+	admin := auth.WithClaims(ctx, auth.Claims{Roles: []string{auth.RoleCatalogAdmin}})
+	if items, err := a.BatchGet(admin, []string{"3"}); err != nil || !items[0].Found {
+		t.Fatalf("admin must see drafts: %+v, %v", items, err)
+	}
+
+	if _, err := a.BatchGet(ctx, make([]string, MaxBatchGet+1)); !errors.Is(err, models.ErrTooManyIDs) {
+		t.Fatalf("got %v, want %v", err, models.ErrTooManyIDs)
+	}
+}
+
+// bulkStub применяет все, что дошло до базы, кроме товара "missing"
+type bulkStub struct {
+	RepoAPI
+	calls int
+	got   []models.PriceUpdate
+}
+
+func (r *bulkStub) BulkUpdatePrices(ctx context.Context, updates []models.PriceUpdate, atomic bool) ([]models.BulkItemResult, error) {
+	r.calls++
+	r.got = updates
+	res := make([]models.BulkItemResult, len(updates))
+	for i, u := range updates {
+		res[i] = models.BulkItemResult{ID: u.ProductID, Status: models.BulkApplied}
+		if u.ProductID == "missing" {
+			res[i].Status, res[i].Error = models.BulkFailed, models.ErrNotFound.Error()
+		}
+	}
+	return res, nil
+}
+
+func (r *bulkStub) BulkDelete(ctx context.Context, ids []string, atomic bool) ([]models.BulkItemResult, []models.Image, error) {
+	r.calls++
+	res := make([]models.BulkItemResult, len(ids))
+	for i, id := range ids {
+		res[i] = models.BulkItemResult{ID: id, Status: models.BulkApplied}
+	}
+	return res, nil, nil
+}
+
+func TestBulkUpdatePrices(t *testing.T) {
+	r := &bulkStub{}
+	a := New(r)
+	ctx := context.Background()
+	updates := []models.PriceUpdate{
+		{ProductID: "1", ChangeBP: 500},
+		{ProductID: "2"},
+		{ProductID: "missing", Price: money.New(100, "RUB")},
+		{ProductID: "1", ChangeBP: 100},
+		{ProductID: "3", Price: money.New(100, "XXX")},
+		{ProductID: "4", ChangeBP: -10_000},
+		{ProductID: "5", Price: money.New(100, "USD"), ChangeBP: 100},
+	}
+
+	report, err := a.BulkUpdatePrices(ctx, updates, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.calls != 0 || report.Applied != 0 || report.Failed != 5 || report.Skipped != 2 || !report.Atomic {
+		t.Fatalf("atomic update with invalid items: %d calls, report %+v", r.calls, report)
+	}
+
+	report, err = a.BulkUpdatePrices(ctx, updates, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.got) != 2 || r.got[0].ProductID != "1" || r.got[1].ProductID != "missing" {
+		t.Fatalf("repository got %+v", r.got)
+	}
+	if report.Applied != 1 || report.Failed != 6 || report.Skipped != 0 || len(report.Results) != len(updates) {
+		t.Fatalf("unexpected report %+v", report)
+	}
+	for i, res := range report.Results {
+		if res.ID != updates[i].ProductID {
+			t.Fatalf("results are not in request order: %+v", report.Results)
+		}
+	}
+	if report.Results[0].Status != models.BulkApplied || report.Results[2].Error != models.ErrNotFound.Error() {
+		t.Fatalf("unexpected results %+v", report.Results)
+	}
+
+	if _, err := a.BulkUpdatePrices(ctx, make([]models.PriceUpdate, MaxBulkItems+1), false); !errors.Is(err, models.ErrTooManyIDs) {
+		t.Fatalf("got %v, want %v", err, models.ErrTooManyIDs)
+	}
+}
+
+func TestBulkDelete(t *testing.T) {
+	r := &bulkStub{}
+	a := New(r)
+	report, err := a.BulkDelete(context.Background(), []string{"1", "", "2", "1"}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.calls != 1 || report.Applied != 2 || report.Failed != 2 {
+		t.Fatalf("unexpected report %+v", report)
+	}
+	if report.Results[2].ID != "2" || report.Results[2].Status != models.BulkApplied || report.Results[3].Status != models.BulkFailed {
+		t.Fatalf("unexpected results %+v", report.Results)
+	}
+}
+
+type auditStub struct {
+	RepoAPI
+	got models.AuditFilter
+}
+
+func (r *auditStub) ListAudit(ctx context.Context, f models.AuditFilter) ([]models.AuditEntry, error) {
+	r.got = f
+	return []models.AuditEntry{{ID: 1, ProductID: f.ProductID, Action: models.AuditCreate}}, nil
+}
+
+func TestListAudit(t *testing.T) {
+	r := &auditStub{}
+	a := New(r)
+	ctx := context.Background()
+	if _, err := a.ListAudit(ctx, models.AuditFilter{Limit: 10}); !errors.Is(err, models.ErrAuditFilter) {
+		t.Fatalf("got %v, want %v", err, models.ErrAuditFilter)
+	}
+	if _, err := a.ListAudit(ctx, models.AuditFilter{Actor: "admin", Limit: 1_000_000, Offset: -5}); err != nil {
+		t.Fatal(err)
+	}
+	if r.got.Limit != maxPageSize || r.got.Offset != 0 || r.got.Actor != "admin" {
+		t.Fatalf("repository got %+v", r.got)
+	}
+}
+
+type translationStub struct {
+	RepoAPI
+	locales []string
+}
+
+func (r *translationStub) Get(ctx context.Context, id string) (models.Product, error) {
+	return models.Product{Name: "Пончик", Description: "Вкусный", Price: money.New(10000, "RUB"), Status: models.StatusPublished}, nil
+}
+
+func (r *translationStub) ListCurrentPromotions(ctx context.Context) ([]promo.Promotion, error) {
+	return nil, nil
+}
+
+func (r *translationStub) FindTranslations(ctx context.Context, productIDs, locales []string) (map[string]map[string]models.Translation, error) {
+	r.locales = locales
+	return map[string]map[string]models.Translation{"1": {
+		"en": {Locale: "en", Name: "Donut", Description: "Tasty"},
+		"de": {Locale: "de", Name: "Krapfen", Description: "Lecker"},
+	}}, nil
+}
+
+func TestGetTranslates(t *testing.T) {
+	r := &translationStub{}
+	a := New(r)
+	tests := []struct {
+		header  string
+		locales []string
+		name    string
+		locale  string
+	}{
+		{"", nil, "Пончик", "ru"},
+		{"de-CH, en;q=0.5", []string{"de-CH", "de", "en"}, "Krapfen", "de"},
+		{"fr, en;q=0.9", []string{"fr", "en"}, "Donut", "en"},
+		// язык по умолчанию в цепочке раньше перевода: дальше него не ищем
+		{"ru, en;q=0.9", nil, "Пончик", "ru"},
+		{"fr", []string{"fr"}, "Пончик", "ru"},
+	}
+	for _, tt := range tests {
+		r.locales = nil
+		ctx := i18n.WithPreferences(context.Background(), i18n.ParseAcceptLanguage(tt.header))
+		p, err := a.Get(ctx, "1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(r.locales, tt.locales) || p.Name != tt.name || p.Locale != tt.locale {
+			t.Errorf("%q: got %q in %q, queried %v", tt.header, p.Name, p.Locale, r.locales)
+		}
+	}
+	if err := a.SetTranslation(context.Background(), "1", models.Translation{Locale: "RU", Name: "x", Description: "y"}); !errors.Is(err, models.ErrDefaultLocale) {
+		t.Fatalf("got %v, want %v", err, models.ErrDefaultLocale)
+	}
+	if err := a.SetTranslation(context.Background(), "1", models.Translation{Locale: "english", Name: "x", Description: "y"}); !errors.Is(err, i18n.ErrInvalidLocale) {
+		t.Fatalf("got %v, want %v", err, i18n.ErrInvalidLocale)
+	}
+}
+
+type slugStub struct {
+	translationStub
+	asked []string
+}
+
+func (r *slugStub) GetBySlug(ctx context.Context, s string) (string, models.Product, error) {
+	r.asked = append(r.asked, s)
+	return "1", models.Product{Name: "Пончик", Slug: "ponchik", Price: money.New(10000, "RUB"), Status: models.StatusPublished}, nil
+}
+
+func TestGetBySlug(t *testing.T) {
+	r := &slugStub{}
+	a := New(r)
+	for _, s := range []string{"", "Ponchik", "-ponchik", "ponchik--2", "пончик"} {
+		if _, _, err := a.GetBySlug(context.Background(), s); !errors.Is(err, models.ErrInvalidSlug) {
+			t.Fatalf("%q: got %v, want %v", s, err, models.ErrInvalidSlug)
+		}
+	}
+	if len(r.asked) != 0 {
+		t.Fatalf("invalid slugs reached repository: %v", r.asked)
+	}
+	// перевод и акции как в Get, слаг остается текущим
+	ctx := i18n.WithPreferences(context.Background(), []string{"en"})
+	id, p, err := a.GetBySlug(ctx, "old-ponchik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "1" || p.Name != "Donut" || p.Slug != "ponchik" || p.EffectivePrice != p.Price {
+		t.Fatalf("got %s %+v", id, p)
+	}
+}
+
+type statusStub struct {
+	translationStub
+	status   models.ProductStatus
+	schedule models.Schedule
+}
+
+func (r *statusStub) Get(ctx context.Context, id string) (models.Product, error) {
+	return models.Product{Name: "Пончик", Price: money.New(10000, "RUB"), Status: r.status}, nil
+}
+
+func (r *statusStub) GetVariant(ctx context.Context, id string) (models.Variant, error) {
+	return models.Variant{ID: id, ProductID: "1", SKU: "DONUT-S"}, nil
+}
+
+func (r *statusStub) ListVariants(ctx context.Context, productID string) ([]models.Variant, error) {
+	return []models.Variant{{ID: "v1", ProductID: productID, SKU: "DONUT-S"}}, nil
+}
+
+func (r *statusStub) SetStatus(ctx context.Context, id string, to models.ProductStatus, check func(from models.ProductStatus) error) error {
+	if err := check(r.status); err != nil {
+		return err
+	}
+	r.status = to
+	return nil
+}
+
+func (r *statusStub) SetSchedule(ctx context.Context, id string, s models.Schedule, check func(status models.ProductStatus) error) error {
+	if err := check(r.status); err != nil {
+		return err
+	}
+	r.schedule = s
+	return nil
+}
+
+func TestProductStatus(t *testing.T) {
+	r := &statusStub{status: models.StatusDraft}
+	a := New(r)
+	ctx := context.Background()
+	admin := auth.WithClaims(ctx, auth.Claims{Roles: []string{auth.RoleCatalogAdmin}})
+
+	// черновик видит только администратор
+	if _, err := a.Get(ctx, "1"); !errors.Is(err, models.ErrNotFound) {
+		t.Fatalf("draft is visible: %v", err)
+	}
+	if _, err := a.Get(admin, "1"); err != nil {
+		t.Fatal(err)
+	}
+
+	steps := []struct {
+		to  models.ProductStatus
+		err error
+	}{
+		{models.StatusPublished, models.ErrStatusTransition},
+		{"archived", models.ErrInvalidStatus},
+		{models.StatusInReview, nil},
+		{models.StatusPublished, nil},
+		{models.StatusDraft, models.ErrStatusTransition},
+		{models.StatusDiscontinued, nil},
+		{models.StatusPublished, nil},
+	}
+	for _, st := range steps {
+		from := r.status
+		if err := a.SetStatus(admin, "1", st.to); !errors.Is(err, st.err) {
+			t.Fatalf("%s -> %s: got %v, want %v", from, st.to, err, st.err)
+		}
+	}
+	if _, err := a.Get(ctx, "1"); err != nil {
+		t.Fatalf("published product is hidden: %v", err)
+	}
+
+	hour := time.Now().Add(time.Hour)
+	schedules := []struct {
+		s   models.Schedule
+		err error
+	}{
+		{models.Schedule{PublishAt: time.Now().Add(-time.Minute)}, models.ErrScheduleInPast},
+		{models.Schedule{PublishAt: hour, UnpublishAt: hour.Add(-time.Minute)}, models.ErrScheduleOrder},
+		// уже опубликован: публиковать нечего, снять можно
+		{models.Schedule{PublishAt: hour}, models.ErrScheduleStatus},
+		{models.Schedule{UnpublishAt: hour}, nil},
+		{models.Schedule{}, nil},
+	}
+	for _, sc := range schedules {
+		if err := a.SetSchedule(admin, "1", sc.s); !errors.Is(err, sc.err) {
+			t.Fatalf("%+v: got %v, want %v", sc.s, err, sc.err)
+		}
+	}
+	r.status = models.StatusInReview
+	if err := a.SetSchedule(admin, "1", models.Schedule{PublishAt: hour, UnpublishAt: hour.Add(time.Hour)}); err != nil || r.schedule.PublishAt != hour {
+		t.Fatalf("got %v, schedule %+v", err, r.schedule)
+	}
+}
+
+func TestUnpublishedProductParts(t *testing.T) {
+	r := &statusStub{status: models.StatusDiscontinued}
+	a := New(r)
+	ctx := context.Background()
+	admin := auth.WithClaims(ctx, auth.Claims{Roles: []string{auth.RoleCatalogAdmin}})
+
+	// по угаданному id витрина не должна получить ни варианты, ни цену
+	if _, err := a.GetVariant(ctx, "v1"); !errors.Is(err, models.ErrNotFound) {
+		t.Fatalf("GetVariant: %v", err)
+	}
+	if _, err := a.ListVariants(ctx, "1"); !errors.Is(err, models.ErrNotFound) {
+		t.Fatalf("ListVariants: %v", err)
+	}
+	if _, err := a.QuotePrice(ctx, "1", "RUB"); !errors.Is(err, models.ErrNotFound) {
+		t.Fatalf("QuotePrice: %v", err)
+	}
+
+	if v, err := a.GetVariant(admin, "v1"); err != nil || v.SKU != "DONUT-S" {
+		t.Fatalf("admin GetVariant: %+v, %v", v, err)
+	}
+	if q, err := a.QuotePrice(admin, "1", "RUB"); err != nil || q.Price != money.New(10000, "RUB") {
+		t.Fatalf("admin QuotePrice: %+v, %v", q, err)
+	}
+
+	r.status = models.StatusPublished
+	if _, err := a.GetVariant(ctx, "v1"); err != nil {
+		t.Fatalf("published GetVariant: %v", err)
+	}
+	if _, err := a.QuotePrice(ctx, "1", "RUB"); err != nil {
+		t.Fatalf("published QuotePrice: %v", err)
+	}
+}
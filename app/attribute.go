@@ -0,0 +1,111 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/google/uuid"
+)
+
+func (a *App) DefineAttribute(ctx context.Context, d models.AttributeDefinition) (string, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	if err = a.r.CreateAttributeDefinition(ctx, id.String(), d); err != nil {
+		return "", log.WrapError(ctx, err)
+	}
+	return id.String(), nil
+}
+
+func (a *App) DeleteAttribute(ctx context.Context, id string) error {
+	return a.r.DeleteAttributeDefinition(ctx, id)
+}
+
+func (a *App) ListAttributeDefinitions(ctx context.Context, categoryID string) ([]models.AttributeDefinition, error) {
+	return a.r.ListAttributeDefinitions(ctx, categoryID)
+}
+
+// SetProductAttributes принимает только атрибуты, описанные в категориях
+// товара или их предках
+func (a *App) SetProductAttributes(ctx context.Context, productID string, attrs models.Attributes) error {
+	if len(attrs) > 0 {
+		defs, err := a.r.ProductAttributeDefinitions(ctx, productID)
+		if err != nil {
+			return err
+		}
+		if err := validateAttributes(defs, attrs); err != nil {
+			return err
+		}
+	}
+	return a.r.SetProductAttributes(ctx, productID, attrs)
+}
+
+func (a *App) GetProductAttributes(ctx context.Context, productID string) (models.Attributes, error) {
+	if err := a.checkVisible(ctx, productID); err != nil {
+		return nil, err
+	}
+	return a.r.GetProductAttributes(ctx, productID)
+}
+
+func (a *App) FilterProducts(ctx context.Context, f models.ProductFilter) (models.FilterResult, error) {
+	f.Limit = pageSize(f.Limit)
+	f.Offset = max(f.Offset, 0)
+	res, err := a.r.FilterProducts(ctx, f)
+	if err != nil {
+		return models.FilterResult{}, err
+	}
+	a.applyPromotions(ctx, res.Products)
+	a.applyImages(ctx, res.Products)
+	a.applyTranslations(ctx, res.Products)
+	return res, nil
+}
+
+func validateAttributes(defs []models.AttributeDefinition, attrs models.Attributes) error {
+	byCode := make(map[string]models.AttributeDefinition, len(defs))
+	for _, d := range defs {
+		byCode[d.Code] = d
+	}
+	for code, v := range attrs {
+		d, ok := byCode[code]
+		if !ok {
+			return fmt.Errorf("%w: %s is not defined for product categories", models.ErrInvalidAttribute, code)
+		}
+		if err := checkValue(d, v); err != nil {
+			return fmt.Errorf("%w: %s %v", models.ErrInvalidAttribute, code, err)
+		}
+	}
+	return nil
+}
+
+func checkValue(d models.AttributeDefinition, v any) error {
+	switch d.Type {
+	case models.AttributeString:
+		if _, ok := v.(string); ok {
+			return nil
+		}
+	case models.AttributeNumber:
+		if n, ok := v.(float64); ok {
+			if math.IsNaN(n) || math.IsInf(n, 0) {
+				return fmt.Errorf("must be a finite number")
+			}
+			return nil
+		}
+	case models.AttributeBoolean:
+		if _, ok := v.(bool); ok {
+			return nil
+		}
+	case models.AttributeEnum:
+		if s, ok := v.(string); ok {
+			if !slices.Contains(d.EnumValues, s) {
+				return fmt.Errorf("must be one of %v", d.EnumValues)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("must be %s", d.Type)
+}
@@ -0,0 +1,22 @@
+package app
+
+import (
+	"context"
+
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+)
+
+// ListAudit листает журнал изменений товара или действий одного пользователя,
+// новые записи первыми. Весь журнал разом не отдаем
+func (a *App) ListAudit(ctx context.Context, f models.AuditFilter) ([]models.AuditEntry, error) {
+	if f.ProductID == "" && f.Actor == "" {
+		return nil, models.ErrAuditFilter
+	}
+	f.Limit, f.Offset = pageSize(f.Limit), max(f.Offset, 0)
+	entries, err := a.r.ListAudit(ctx, f)
+	if err != nil {
+		return nil, log.WrapError(ctx, err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,143 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+)
+
+// MaxBulkItems - больше элементов за раз не принимаем: все они идут одной
+// транзакцией, и блокировки держатся до ее конца
+const MaxBulkItems = 5000
+
+// BulkUpdatePrices меняет базовые цены многих товаров. С atomic применяется
+// все или ничего, иначе - все, что можно, а ошибки остаются в отчете
+func (a *App) BulkUpdatePrices(ctx context.Context, updates []models.PriceUpdate, atomic bool) (models.BulkReport, error) {
+	if len(updates) > MaxBulkItems {
+		return models.BulkReport{}, fmt.Errorf("%w: at most %d", models.ErrTooManyIDs, MaxBulkItems)
+	}
+	results := make([]models.BulkItemResult, len(updates))
+	var valid []models.PriceUpdate
+	var pos []int
+	seen := make(map[string]bool, len(updates))
+	for i, u := range updates {
+		results[i].ID = u.ProductID
+		if err := validPriceUpdate(u); err != nil {
+			results[i].Status, results[i].Error = models.BulkFailed, err.Error()
+			continue
+		}
+		if seen[u.ProductID] {
+			results[i].Status, results[i].Error = models.BulkFailed, "product is listed twice"
+			continue
+		}
+		seen[u.ProductID] = true
+		valid, pos = append(valid, u), append(pos, i)
+	}
+	err := a.bulk(ctx, results, pos, atomic, func() ([]models.BulkItemResult, error) {
+		return a.r.BulkUpdatePrices(ctx, valid, atomic)
+	})
+	if err != nil {
+		return models.BulkReport{}, err
+	}
+	return bulkReport(results, atomic), nil
+}
+
+func validPriceUpdate(u models.PriceUpdate) error {
+	switch {
+	case u.ProductID == "":
+		return errors.New("product id is required")
+	case u.Price.IsZero() == (u.ChangeBP == 0):
+		return errors.New("either price or change must be set")
+	case u.ChangeBP <= -10_000:
+		return errors.New("change must be greater than -100%")
+	case u.ChangeBP == 0 && u.Price.Amount < 0:
+		return errors.New("price must be greater than 0")
+	case u.ChangeBP == 0:
+		return u.Price.Validate()
+	}
+	return nil
+}
+
+// BulkDelete удаляет многие товары вместе с файлами их картинок
+func (a *App) BulkDelete(ctx context.Context, ids []string, atomic bool) (models.BulkReport, error) {
+	if len(ids) > MaxBulkItems {
+		return models.BulkReport{}, fmt.Errorf("%w: at most %d", models.ErrTooManyIDs, MaxBulkItems)
+	}
+	results := make([]models.BulkItemResult, len(ids))
+	var valid []string
+	var pos []int
+	seen := make(map[string]bool, len(ids))
+	for i, id := range ids {
+		results[i].ID = id
+		switch {
+		case id == "":
+			results[i].Status, results[i].Error = models.BulkFailed, "product id is required"
+		case seen[id]:
+			results[i].Status, results[i].Error = models.BulkFailed, "product is listed twice"
+		default:
+			seen[id] = true
+			valid, pos = append(valid, id), append(pos, i)
+		}
+	}
+	var imgs []models.Image
+	err := a.bulk(ctx, results, pos, atomic, func() ([]models.BulkItemResult, error) {
+		res, deleted, err := a.r.BulkDelete(ctx, valid, atomic)
+		imgs = deleted
+		return res, err
+	})
+	if err != nil {
+		return models.BulkReport{}, err
+	}
+	for _, img := range imgs {
+		a.deleteBlobs(ctx, imageKeys(img))
+	}
+	return bulkReport(results, atomic), nil
+}
+
+// bulk применяет прошедшие проверку элементы (их позиции в results - pos)
+// и раскладывает итоги по местам. В атомарном режиме при ошибках проверки
+// в базу не ходим
+func (a *App) bulk(ctx context.Context, results []models.BulkItemResult, pos []int, atomic bool, apply func() ([]models.BulkItemResult, error)) error {
+	if len(pos) == 0 {
+		return nil
+	}
+	if atomic && len(pos) < len(results) {
+		skipValid(results)
+		return nil
+	}
+	res, err := apply()
+	if err != nil {
+		return log.WrapError(ctx, err)
+	}
+	for i, r := range res {
+		results[pos[i]] = r
+	}
+	return nil
+}
+
+// skipValid помечает пропущенными элементы без ошибки проверки
+func skipValid(results []models.BulkItemResult) {
+	for i := range results {
+		if results[i].Status == "" {
+			results[i].Status = models.BulkSkipped
+		}
+	}
+}
+
+func bulkReport(results []models.BulkItemResult, atomic bool) models.BulkReport {
+	report := models.BulkReport{Results: results, Atomic: atomic}
+	for _, r := range results {
+		switch r.Status {
+		case models.BulkApplied:
+			report.Applied++
+		case models.BulkFailed:
+			report.Failed++
+		case models.BulkSkipped:
+			report.Skipped++
+		}
+	}
+	return report
+}
@@ -0,0 +1,98 @@
+package app
+
+import (
+	"context"
+	"slices"
+
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+func (a *App) CreateCategory(ctx context.Context, c models.Category) (string, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	if err = a.r.CreateCategory(ctx, id.String(), c); err != nil {
+		return "", log.WrapError(ctx, err)
+	}
+	return id.String(), nil
+}
+
+func (a *App) GetCategory(ctx context.Context, id string) (models.Category, error) {
+	return a.r.GetCategory(ctx, id)
+}
+
+func (a *App) GetCategoryBySlug(ctx context.Context, slug string) (models.Category, error) {
+	return a.r.GetCategoryBySlug(ctx, slug)
+}
+
+// CategoryTree собирает дерево из плоского списка; порядок детей - по имени,
+// как их вернула база
+func (a *App) CategoryTree(ctx context.Context) ([]*models.CategoryNode, error) {
+	cats, err := a.r.ListCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes := make(map[string]*models.CategoryNode, len(cats))
+	for _, c := range cats {
+		nodes[c.ID] = &models.CategoryNode{Category: c}
+	}
+	var roots []*models.CategoryNode
+	for _, c := range cats {
+		node := nodes[c.ID]
+		if parent, ok := nodes[c.ParentID]; ok {
+			parent.Children = append(parent.Children, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+	return roots, nil
+}
+
+func (a *App) UpdateCategory(ctx context.Context, id string, c models.Category) error {
+	if c.ParentID == id {
+		return models.ErrCategoryCycle
+	}
+	return a.r.UpdateCategory(ctx, id, c)
+}
+
+func (a *App) DeleteCategory(ctx context.Context, id string) error {
+	return a.r.DeleteCategory(ctx, id)
+}
+
+func (a *App) SetProductCategories(ctx context.Context, productID string, categoryIDs []string) error {
+	slices.Sort(categoryIDs)
+	return a.r.SetProductCategories(ctx, productID, slices.Compact(categoryIDs))
+}
+
+func (a *App) GetProductCategories(ctx context.Context, productID string) ([]models.Category, error) {
+	if err := a.checkVisible(ctx, productID); err != nil {
+		return nil, err
+	}
+	return a.r.GetProductCategories(ctx, productID)
+}
+
+func (a *App) ListProductsByCategory(ctx context.Context, categoryID string, descendants bool, limit, offset int) ([]models.ProductDigest, error) {
+	prods, err := a.r.ListProductsByCategory(ctx, categoryID, descendants, pageSize(limit), max(offset, 0))
+	if err != nil {
+		return nil, err
+	}
+	a.applyPromotions(ctx, prods)
+	a.applyImages(ctx, prods)
+	a.applyTranslations(ctx, prods)
+	return prods, nil
+}
+
+func pageSize(limit int) int {
+	if limit <= 0 {
+		return defaultPageSize
+	}
+	return min(limit, maxPageSize)
+}
@@ -0,0 +1,98 @@
+package app
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/glekoz/online-shop_product/pkg/bulk"
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+)
+
+// FeedConfig - витрина магазина для фидов: ссылки на товары нужны Google Merchant,
+// в CSV и NDJSON они идут колонкой link. ProductURL - шаблон с {id} или {slug} вместо
+// id или слага товара
+type FeedConfig struct {
+	Title      string
+	Link       string
+	ProductURL string
+}
+
+func WithFeed(cfg FeedConfig) Option {
+	return func(a *App) {
+		a.feed = cfg
+	}
+}
+
+// Export пишет товары в w, не собирая их в памяти: из базы они приходят пачками
+// через курсор, к каждой пачке применяются акции и картинки, как в списках.
+// Возвращает число выгруженных товаров; при ошибке в w уже может быть часть выгрузки
+func (a *App) Export(ctx context.Context, f models.ExportFilter, format bulk.Format, columns []string, w io.Writer) (int, error) {
+	if f.Currency != "" {
+		if _, err := money.Exponent(f.Currency); err != nil {
+			return 0, err
+		}
+	}
+	bw, err := bulk.NewWriter(format, w, columns, bulk.Feed{Title: a.feed.Title, Link: a.feed.Link})
+	if err != nil {
+		return 0, err
+	}
+	// фид читают покупатели площадки: в нем только то, что видно на витрине
+	if format == bulk.GoogleXML || format == bulk.GoogleTSV {
+		f.PublishedOnly = true
+	}
+	n := 0
+	err = a.r.Export(ctx, f, func(rows []models.ExportRow) error {
+		digests := make([]models.ProductDigest, len(rows))
+		for i, row := range rows {
+			digests[i] = row.ProductDigest
+		}
+		a.applyPromotions(ctx, digests)
+		a.applyImages(ctx, digests)
+		for i, row := range rows {
+			row.ProductDigest = digests[i]
+			if err := bw.Write(a.exportProduct(row)); err != nil {
+				return err
+			}
+		}
+		n += len(rows)
+		return nil
+	})
+	if err != nil {
+		return 0, log.WrapError(ctx, err)
+	}
+	if err := bw.Close(); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+func (a *App) exportProduct(row models.ExportRow) bulk.Product {
+	p := bulk.Product{
+		ID:          row.ID,
+		SKU:         row.SKU,
+		Name:        row.Name,
+		Description: row.Description,
+		Price:       majorUnits(row.Price),
+		Currency:    row.Price.Currency,
+		Available:   row.Available,
+		UpdatedAt:   row.UpdatedAt,
+	}
+	if !row.EffectivePrice.IsZero() && row.EffectivePrice != row.Price {
+		p.SalePrice = majorUnits(row.EffectivePrice)
+	}
+	if row.PrimaryImage != nil {
+		p.ImageURL = row.PrimaryImage.URL
+	}
+	if a.feed.ProductURL != "" {
+		p.Link = strings.NewReplacer("{id}", row.ID, "{slug}", row.Slug).Replace(a.feed.ProductURL)
+	}
+	return p
+}
+
+// majorUnits - сумма без кода валюты, в том виде, в каком ее принимает импорт
+func majorUnits(m money.Money) string {
+	return strings.TrimSuffix(m.String(), " "+m.Currency)
+}
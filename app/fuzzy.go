@@ -0,0 +1,104 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+)
+
+const (
+	// DefaultFuzzyThreshold - сходство запроса с частью названия (word_similarity):
+	// "donutt" и "Glazed Donut" дают около 0.7
+	DefaultFuzzyThreshold float32 = 0.5
+	// DefaultDuplicateThreshold - сходство названий целиком (similarity)
+	DefaultDuplicateThreshold float32 = 0.6
+
+	defaultSuggestions = 5
+	maxSuggestions     = 20
+	// maxSimilarProducts - сколько похожих товаров показывать при создании
+	maxSimilarProducts = 5
+)
+
+// WithFuzzyThresholds задает пороги сходства от 0 до 1: для поиска с опечатками
+// и подсказок и для предупреждения о дублях; 0 оставляет порог по умолчанию
+func WithFuzzyThresholds(search, duplicate float32) Option {
+	return func(a *App) {
+		if search > 0 {
+			a.fuzzyThreshold = search
+		}
+		if duplicate > 0 {
+			a.duplicateThreshold = duplicate
+		}
+	}
+}
+
+func ValidThreshold(t float32) bool {
+	return t > 0 && t <= 1
+}
+
+func (a *App) FuzzySearch(ctx context.Context, q models.FuzzyQuery) (models.SearchResult, error) {
+	if q.Threshold == 0 {
+		q.Threshold = a.fuzzyThreshold
+	}
+	if !ValidThreshold(q.Threshold) {
+		return models.SearchResult{}, models.ErrInvalidThreshold
+	}
+	q.Query = strings.TrimSpace(q.Query)
+	if q.Query == "" {
+		return models.SearchResult{}, models.ErrEmptyQuery
+	}
+	q.Limit = pageSize(q.Limit)
+	q.Offset = max(q.Offset, 0)
+
+	res, err := a.r.FuzzySearch(ctx, q)
+	if err != nil {
+		return models.SearchResult{}, err
+	}
+	digests := make([]models.ProductDigest, len(res.Hits))
+	for i, h := range res.Hits {
+		digests[i] = h.ProductDigest
+	}
+	a.applyPromotions(ctx, digests)
+	a.applyImages(ctx, digests)
+	a.applyTranslations(ctx, digests)
+	for i := range res.Hits {
+		res.Hits[i].ProductDigest = digests[i]
+	}
+	return res, nil
+}
+
+// Suggest - названия товаров, похожие на запрос, самые похожие первыми
+func (a *App) Suggest(ctx context.Context, query string, limit int) ([]string, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, models.ErrEmptyQuery
+	}
+	if limit <= 0 {
+		limit = defaultSuggestions
+	}
+	return a.r.SuggestNames(ctx, query, a.fuzzyThreshold, min(limit, maxSuggestions))
+}
+
+// didYouMean подсказывает, когда полнотекстовый поиск ничего не нашел.
+// Подсказка - дополнение к ответу, поэтому ее ошибка поиск не ломает
+func (a *App) didYouMean(ctx context.Context, query string) []string {
+	names, err := a.r.SuggestNames(ctx, query, a.fuzzyThreshold, defaultSuggestions)
+	if err != nil {
+		slog.WarnContext(log.ErrorContext(ctx, err), "search suggestions are not loaded: "+err.Error())
+		return nil
+	}
+	return names
+}
+
+// similarProducts - как и подсказки, проверка дублей не мешает создать товар
+func (a *App) similarProducts(ctx context.Context, name string) []models.SimilarProduct {
+	similar, err := a.r.SimilarProducts(ctx, name, a.duplicateThreshold, maxSimilarProducts)
+	if err != nil {
+		slog.WarnContext(log.ErrorContext(ctx, err), "duplicate check failed: "+err.Error())
+		return nil
+	}
+	return similar
+}
@@ -0,0 +1,198 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/glekoz/online-shop_product/pkg/blob"
+	"github.com/glekoz/online-shop_product/pkg/imaging"
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/google/uuid"
+)
+
+// DefaultMaxImageSize - предел размера загружаемого файла
+const DefaultMaxImageSize = 10 << 20
+
+type ImageConfig struct {
+	MaxSize   int64 // байт, 0 - DefaultMaxImageSize
+	MaxPixels int   // 0 - imaging.DefaultMaxPixels
+	Sizes     []imaging.Size
+}
+
+// WithImages включает картинки товаров; без этой опции загрузка
+// возвращает ErrImagesDisabled, а в списках нет главной картинки
+func WithImages(storage blob.Storage, cfg ImageConfig) Option {
+	return func(a *App) {
+		if cfg.MaxSize <= 0 {
+			cfg.MaxSize = DefaultMaxImageSize
+		}
+		if cfg.MaxPixels <= 0 {
+			cfg.MaxPixels = imaging.DefaultMaxPixels
+		}
+		if len(cfg.Sizes) == 0 {
+			cfg.Sizes = imaging.DefaultSizes
+		}
+		a.storage, a.imageCfg = storage, cfg
+	}
+}
+
+// UploadImage проверяет картинку, делает превью, складывает файлы в хранилище
+// и добавляет картинку в конец списка товара. Из meta берутся ProductID, Alt
+// и ContentType - заявленный клиентом тип, пустой если не заявлен.
+// Файл читается в память целиком: его все равно нужно декодировать,
+// а размер ограничен MaxSize
+func (a *App) UploadImage(ctx context.Context, meta models.Image, r io.Reader) (models.Image, error) {
+	if a.storage == nil {
+		return models.Image{}, models.ErrImagesDisabled
+	}
+	data, err := io.ReadAll(io.LimitReader(r, a.imageCfg.MaxSize+1))
+	if err != nil {
+		return models.Image{}, err
+	}
+	if int64(len(data)) > a.imageCfg.MaxSize {
+		return models.Image{}, fmt.Errorf("%w: limit is %d bytes", models.ErrImageTooLarge, a.imageCfg.MaxSize)
+	}
+	ct, err := imaging.Sniff(data)
+	if err != nil {
+		return models.Image{}, err
+	}
+	if meta.ContentType != "" && meta.ContentType != ct {
+		return models.Image{}, fmt.Errorf("%w: declared %s, content is %s", imaging.ErrUnsupportedType, meta.ContentType, ct)
+	}
+	// до обработки и записи файлов: на несуществующий товар не тратим ни CPU, ни хранилище
+	if _, err := a.r.Get(ctx, meta.ProductID); err != nil {
+		return models.Image{}, err
+	}
+	orig, thumbs, err := imaging.Process(data, a.imageCfg.Sizes, a.imageCfg.MaxPixels)
+	if err != nil {
+		return models.Image{}, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return models.Image{}, err
+	}
+	dir := "products/" + meta.ProductID + "/" + id.String() + "/"
+	img := models.Image{
+		ID:          id.String(),
+		ProductID:   meta.ProductID,
+		Key:         dir + "original." + imaging.ContentTypes[orig.ContentType],
+		ContentType: orig.ContentType,
+		Size:        int64(len(data)),
+		Width:       orig.Width,
+		Height:      orig.Height,
+		Alt:         meta.Alt,
+		Thumbnails:  make([]models.Thumbnail, len(thumbs)),
+	}
+	if err := a.storage.Put(ctx, img.Key, bytes.NewReader(data), img.Size, img.ContentType); err != nil {
+		return models.Image{}, log.WrapError(ctx, err)
+	}
+	keys := []string{img.Key}
+	for i, th := range thumbs {
+		key := dir + th.Name + "." + imaging.ContentTypes[th.ContentType]
+		if err := a.storage.Put(ctx, key, bytes.NewReader(th.Data), int64(len(th.Data)), th.ContentType); err != nil {
+			a.deleteBlobs(ctx, keys)
+			return models.Image{}, log.WrapError(ctx, err)
+		}
+		keys = append(keys, key)
+		img.Thumbnails[i] = models.Thumbnail{Name: th.Name, Key: key, ContentType: th.ContentType, Width: th.Width, Height: th.Height}
+	}
+
+	if img, err = a.r.AddImage(ctx, img); err != nil {
+		a.deleteBlobs(ctx, keys)
+		return models.Image{}, log.WrapError(ctx, err)
+	}
+	a.fillURLs(&img)
+	return img, nil
+}
+
+func (a *App) ListImages(ctx context.Context, productID string) ([]models.Image, error) {
+	if err := a.checkVisible(ctx, productID); err != nil {
+		return nil, err
+	}
+	imgs, err := a.r.ListImages(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range imgs {
+		a.fillURLs(&imgs[i])
+	}
+	return imgs, nil
+}
+
+// DeleteImage удаляет картинку; файлы удаляются после записи в базе,
+// поэтому при сбое хранилища остаются лишние файлы, а не битые ссылки
+func (a *App) DeleteImage(ctx context.Context, id string) error {
+	img, err := a.r.DeleteImage(ctx, id)
+	if err != nil {
+		return err
+	}
+	a.deleteBlobs(ctx, imageKeys(img))
+	return nil
+}
+
+func (a *App) SetPrimaryImage(ctx context.Context, id string) error {
+	return a.r.SetPrimaryImage(ctx, id)
+}
+
+func (a *App) ReorderImages(ctx context.Context, productID string, imageIDs []string) error {
+	return a.r.ReorderImages(ctx, productID, imageIDs)
+}
+
+// applyImages заполняет PrimaryImage; как и акции, при ошибке
+// не ломает выдачу, товары просто остаются без картинки
+func (a *App) applyImages(ctx context.Context, prods []models.ProductDigest) {
+	if a.storage == nil || len(prods) == 0 {
+		return
+	}
+	ids := make([]string, len(prods))
+	for i, p := range prods {
+		ids[i] = p.ID
+	}
+	imgs, err := a.r.PrimaryImages(ctx, ids)
+	if err != nil {
+		slog.WarnContext(log.ErrorContext(ctx, err), "primary images are not loaded: "+err.Error())
+		return
+	}
+	for i, p := range prods {
+		if img, ok := imgs[p.ID]; ok {
+			a.fillURLs(&img)
+			prods[i].PrimaryImage = &img
+		}
+	}
+}
+
+func (a *App) fillURLs(img *models.Image) {
+	if a.storage == nil {
+		return
+	}
+	img.URL = a.storage.URL(img.Key)
+	for i := range img.Thumbnails {
+		img.Thumbnails[i].URL = a.storage.URL(img.Thumbnails[i].Key)
+	}
+}
+
+// deleteBlobs не отменяется вместе с запросом: клиент мог отвалиться посреди загрузки
+func (a *App) deleteBlobs(ctx context.Context, keys []string) {
+	if a.storage == nil {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	for _, key := range keys {
+		if err := a.storage.Delete(ctx, key); err != nil {
+			slog.WarnContext(log.ErrorContext(ctx, err), "orphaned blob "+key+": "+err.Error())
+		}
+	}
+}
+
+func imageKeys(img models.Image) []string {
+	keys := []string{img.Key}
+	for _, th := range img.Thumbnails {
+		keys = append(keys, th.Key)
+	}
+	return keys
+}
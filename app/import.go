@@ -0,0 +1,168 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/glekoz/online-shop_product/pkg/bulk"
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxImportRows - больше строк за раз не принимаем: все они проверяются
+	// и пишутся одной транзакцией, а отчет уходит одним сообщением
+	MaxImportRows = 10_000
+	MaxImportSize = 32 << 20
+
+	// ограничения колонок products
+	maxNameLength        = 100
+	maxDescriptionLength = 512
+	maxSKULength         = 64
+)
+
+// Import загружает товары из файла поставщика. Каждая строка проверяется
+// отдельно, ошибки попадают в отчет и не мешают остальным строкам.
+// Ошибку целиком Import возвращает, только если файл не читается
+// (битый заголовок, слишком большой) или не удалась запись в базу
+func (a *App) Import(ctx context.Context, format bulk.Format, r io.Reader, dryRun bool) (models.ImportReport, error) {
+	br, err := bulk.NewReader(format, &limitedReader{r: r, n: MaxImportSize})
+	if err != nil {
+		return models.ImportReport{}, err
+	}
+	report := models.ImportReport{DryRun: dryRun}
+	var rows []models.ImportRow
+	// первая строка с тем же артикулом или названием, дубли внутри файла - ошибка
+	skus, names := make(map[string]int), make(map[string]int)
+	for {
+		rec, err := br.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil && !errors.Is(err, bulk.ErrMalformedRow) {
+			return models.ImportReport{}, err
+		}
+		if len(report.Rows)+len(rows) >= MaxImportRows {
+			return models.ImportReport{}, fmt.Errorf("%w: more than %d rows", models.ErrImportTooLarge, MaxImportRows)
+		}
+		var row models.ImportRow
+		if err == nil {
+			row, err = importRow(rec)
+		}
+		if err == nil {
+			if line, ok := skus[row.SKU]; ok && row.SKU != "" {
+				err = fmt.Errorf("sku %q is already used on line %d", row.SKU, line)
+			} else if line, ok := names[row.Name]; ok {
+				err = fmt.Errorf("name %q is already used on line %d", row.Name, line)
+			}
+		}
+		if err != nil {
+			report.Rows = append(report.Rows, models.ImportRowResult{
+				Line:   rec.Line,
+				SKU:    rec.SKU,
+				Name:   rec.Name,
+				Status: models.ImportFailed,
+				Error:  err.Error(),
+			})
+			continue
+		}
+		skus[row.SKU], names[row.Name] = row.Line, row.Line
+		id, err := uuid.NewV7()
+		if err != nil {
+			return models.ImportReport{}, err
+		}
+		row.ID = id.String()
+		rows = append(rows, row)
+	}
+
+	if len(rows) > 0 {
+		results, err := a.r.ImportProducts(ctx, rows, dryRun)
+		if err != nil {
+			return models.ImportReport{}, log.WrapError(ctx, err)
+		}
+		report.Rows = append(report.Rows, results...)
+	}
+	slices.SortFunc(report.Rows, func(x, y models.ImportRowResult) int {
+		return x.Line - y.Line
+	})
+	for i, row := range report.Rows {
+		switch row.Status {
+		case models.ImportCreated:
+			report.Created++
+			if dryRun {
+				// товара нет и не будет, id из отката только сбивал бы с толку
+				report.Rows[i].ID = ""
+			}
+		case models.ImportUpdated:
+			report.Updated++
+		case models.ImportUnchanged:
+			report.Unchanged++
+		case models.ImportFailed:
+			report.Failed++
+		}
+	}
+	return report, nil
+}
+
+// importRow проверяет строку по тем же правилам, что и Create
+func importRow(rec bulk.Record) (models.ImportRow, error) {
+	for _, s := range []string{rec.SKU, rec.Name, rec.Description, rec.Price, rec.Currency} {
+		if !utf8.ValidString(s) {
+			return models.ImportRow{}, errors.New("invalid UTF-8")
+		}
+	}
+	switch {
+	case rec.Name == "" || rec.Description == "" || rec.Price == "":
+		return models.ImportRow{}, errors.New("name, price and description are required")
+	case utf8.RuneCountInString(rec.Name) > maxNameLength:
+		return models.ImportRow{}, fmt.Errorf("name must be up to %d characters", maxNameLength)
+	case utf8.RuneCountInString(rec.Description) > maxDescriptionLength:
+		return models.ImportRow{}, fmt.Errorf("description must be up to %d characters", maxDescriptionLength)
+	case utf8.RuneCountInString(rec.SKU) > maxSKULength:
+		return models.ImportRow{}, fmt.Errorf("sku must be up to %d characters", maxSKULength)
+	}
+	currency := rec.Currency
+	if currency == "" {
+		currency = money.DefaultCurrency
+	}
+	price, err := money.Parse(rec.Price, currency)
+	if err != nil {
+		return models.ImportRow{}, err
+	}
+	if price.Amount <= 0 {
+		return models.ImportRow{}, errors.New("price must be greater than 0")
+	}
+	return models.ImportRow{
+		Line:        rec.Line,
+		SKU:         rec.SKU,
+		Name:        rec.Name,
+		Description: rec.Description,
+		Price:       price,
+	}, nil
+}
+
+// limitedReader в отличие от io.LimitReader сообщает о превышении,
+// а не обрывает файл молча на середине строки
+type limitedReader struct {
+	r io.Reader
+	n int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	// на байт больше лимита, чтобы отличить файл ровно в лимит от большего
+	if int64(len(p)) > l.n+1 {
+		p = p[:l.n+1]
+	}
+	n, err := l.r.Read(p)
+	if int64(n) > l.n {
+		return 0, fmt.Errorf("%w: limit is %d bytes", models.ErrImportTooLarge, MaxImportSize)
+	}
+	l.n -= int64(n)
+	return n, err
+}
@@ -0,0 +1,90 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+)
+
+func (a *App) SetBasePrice(ctx context.Context, productID string, price money.Money) error {
+	return a.r.SetBasePrice(ctx, productID, price)
+}
+
+// SetProductPrice задает цену в конкретной валюте; цена в валюте товара - это его базовая цена
+func (a *App) SetProductPrice(ctx context.Context, productID string, price money.Money) error {
+	p, err := a.r.Get(ctx, productID)
+	if err != nil {
+		return err
+	}
+	if p.Price.Currency == price.Currency {
+		return a.r.SetBasePrice(ctx, productID, price)
+	}
+	return a.r.SetProductPrice(ctx, productID, price)
+}
+
+func (a *App) ListProductPrices(ctx context.Context, productID string) (models.ProductPrices, error) {
+	p, err := a.r.Get(ctx, productID)
+	if err != nil {
+		return models.ProductPrices{}, err
+	}
+	if !visible(ctx, p) {
+		return models.ProductPrices{}, models.ErrNotFound
+	}
+	prices, err := a.r.ListProductPrices(ctx, productID)
+	if err != nil {
+		return models.ProductPrices{}, err
+	}
+	return models.ProductPrices{Base: p.Price, Prices: prices}, nil
+}
+
+func (a *App) DeleteProductPrice(ctx context.Context, productID, currency string) error {
+	return a.r.DeleteProductPrice(ctx, productID, currency)
+}
+
+// QuotePrice возвращает цену товара в валюте currency: базовую, если валюта совпадает,
+// заданную вручную, если она есть, иначе пересчитанную по курсу
+func (a *App) QuotePrice(ctx context.Context, productID, currency string) (models.PriceQuote, error) {
+	p, err := a.r.Get(ctx, productID)
+	if err != nil {
+		return models.PriceQuote{}, err
+	}
+	if !visible(ctx, p) {
+		return models.PriceQuote{}, models.ErrNotFound
+	}
+	if p.Price.Currency == currency {
+		return models.PriceQuote{Price: p.Price, Source: models.PriceBase}, nil
+	}
+	price, err := a.r.GetProductPrice(ctx, productID, currency)
+	if err == nil {
+		return models.PriceQuote{Price: price, Source: models.PriceExplicit}, nil
+	}
+	if !errors.Is(err, models.ErrNotFound) {
+		return models.PriceQuote{}, err
+	}
+	rate, err := a.r.GetCurrencyRate(ctx, p.Price.Currency, currency)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return models.PriceQuote{}, models.ErrNoExchangeRate
+		}
+		return models.PriceQuote{}, err
+	}
+	price, err = money.Convert(p.Price, rate.Rate)
+	if err != nil {
+		return models.PriceQuote{}, err
+	}
+	return models.PriceQuote{Price: price, Source: models.PriceConverted, Rate: rate.Rate.Rate}, nil
+}
+
+func (a *App) SetCurrencyRate(ctx context.Context, rate money.Rate) error {
+	return a.r.SetCurrencyRate(ctx, rate)
+}
+
+func (a *App) ListCurrencyRates(ctx context.Context) ([]models.CurrencyRate, error) {
+	return a.r.ListCurrencyRates(ctx)
+}
+
+func (a *App) DeleteCurrencyRate(ctx context.Context, base, quote string) error {
+	return a.r.DeleteCurrencyRate(ctx, base, quote)
+}
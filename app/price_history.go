@@ -0,0 +1,62 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+)
+
+const priceBatch = 100
+
+// SchedulePrice планирует смену базовой цены на момент at
+func (a *App) SchedulePrice(ctx context.Context, productID string, price money.Money, at time.Time) (int64, error) {
+	if !at.After(time.Now()) {
+		return 0, models.ErrScheduleInPast
+	}
+	return a.r.SchedulePrice(ctx, productID, price, at)
+}
+
+func (a *App) CancelScheduledPrice(ctx context.Context, id int64) error {
+	return a.r.CancelScheduledPrice(ctx, id)
+}
+
+// PriceHistory - история цены вместе с запланированными изменениями, новые сверху
+func (a *App) PriceHistory(ctx context.Context, productID string, limit, offset int) ([]models.PriceChange, error) {
+	return a.r.ListPriceHistory(ctx, productID, pageSize(limit), offset)
+}
+
+func (a *App) PriceAt(ctx context.Context, productID string, at time.Time) (money.Money, error) {
+	return a.r.PriceAt(ctx, productID, at)
+}
+
+// ApplyScheduledPrices раз в interval применяет наступившие изменения цен,
+// пока ctx не отменен
+func (a *App) ApplyScheduledPrices(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		for {
+			n, err := a.r.ApplyDuePrices(ctx, priceBatch)
+			if err != nil {
+				if ctx.Err() == nil {
+					slog.ErrorContext(ctx, "price scheduler: "+err.Error())
+				}
+				break
+			}
+			if n > 0 {
+				slog.InfoContext(ctx, "scheduled prices applied", "count", n)
+			}
+			if n < priceBatch {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/promo"
+	"github.com/google/uuid"
+)
+
+// promoCacheTTL - как долго реплика живет со своим списком акций; изменения,
+// сделанные через другую реплику, станут видны не позже чем через это время
+const promoCacheTTL = 30 * time.Second
+
+// promoCache - списки акций по магазинам
+type promoCache struct {
+	mu      sync.Mutex
+	tenants map[string]promoList
+}
+
+type promoList struct {
+	list     []promo.Promotion
+	loadedAt time.Time
+}
+
+func (a *App) CreatePromotion(ctx context.Context, p promo.Promotion) (string, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	if err = a.r.CreatePromotion(ctx, id.String(), p); err != nil {
+		return "", log.WrapError(ctx, err)
+	}
+	a.invalidatePromotions(ctx)
+	return id.String(), nil
+}
+
+func (a *App) UpdatePromotion(ctx context.Context, id string, p promo.Promotion) error {
+	if err := a.r.UpdatePromotion(ctx, id, p); err != nil {
+		return err
+	}
+	a.invalidatePromotions(ctx)
+	return nil
+}
+
+func (a *App) DeletePromotion(ctx context.Context, id string) error {
+	if err := a.r.DeletePromotion(ctx, id); err != nil {
+		return err
+	}
+	a.invalidatePromotions(ctx)
+	return nil
+}
+
+func (a *App) GetPromotion(ctx context.Context, id string) (promo.Promotion, error) {
+	return a.r.GetPromotion(ctx, id)
+}
+
+func (a *App) ListPromotions(ctx context.Context) ([]promo.Promotion, error) {
+	return a.r.ListPromotions(ctx)
+}
+
+func (a *App) currentPromotions(ctx context.Context) ([]promo.Promotion, error) {
+	tenant := auth.Tenant(ctx)
+	a.promos.mu.Lock()
+	defer a.promos.mu.Unlock()
+	if c, ok := a.promos.tenants[tenant]; ok && time.Since(c.loadedAt) < promoCacheTTL {
+		return c.list, nil
+	}
+	list, err := a.r.ListCurrentPromotions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if a.promos.tenants == nil {
+		a.promos.tenants = make(map[string]promoList)
+	}
+	a.promos.tenants[tenant] = promoList{list: list, loadedAt: time.Now()}
+	return list, nil
+}
+
+func (a *App) invalidatePromotions(ctx context.Context) {
+	a.promos.mu.Lock()
+	delete(a.promos.tenants, auth.Tenant(ctx))
+	a.promos.mu.Unlock()
+}
+
+// applyPromotions заполняет EffectivePrice и Promotions. Если акции не удалось
+// загрузить, товары отдаются по обычной цене: лучше показать цену без скидки,
+// чем не показать каталог вовсе
+func (a *App) applyPromotions(ctx context.Context, prods []models.ProductDigest) {
+	if len(prods) == 0 {
+		return
+	}
+	for i := range prods {
+		prods[i].EffectivePrice = prods[i].Price
+	}
+	promos, err := a.currentPromotions(ctx)
+	if err != nil {
+		slog.WarnContext(log.ErrorContext(ctx, err), "promotions are not applied: "+err.Error())
+		return
+	}
+	if len(promos) == 0 {
+		return
+	}
+	var paths map[string][]string
+	for _, p := range promos {
+		if p.Target == promo.TargetCategory {
+			ids := make([]string, len(prods))
+			for i, prod := range prods {
+				ids[i] = prod.ID
+			}
+			if paths, err = a.r.ProductCategoryPaths(ctx, ids); err != nil {
+				slog.WarnContext(log.ErrorContext(ctx, err), "promotions are not applied: "+err.Error())
+				return
+			}
+			break
+		}
+	}
+	now := time.Now()
+	for i, prod := range prods {
+		res := promo.Apply(promos, promo.Item{ProductID: prod.ID, Categories: paths[prod.ID], Price: prod.Price}, now)
+		prods[i].EffectivePrice, prods[i].Promotions = res.Price, res.Applied
+	}
+}
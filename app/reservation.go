@@ -0,0 +1,85 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/models"
+)
+
+const (
+	DefaultReservationTTL = 15 * time.Minute
+	sweepBatch            = 100
+)
+
+// Reserve приводит позиции к каноническому виду (склад по умолчанию,
+// дубликаты сложены, сортировка), чтобы повтор запроса с теми же позициями
+// в другом порядке считался тем же резервом
+func (a *App) Reserve(ctx context.Context, id string, items []models.ReservationItem, ttl time.Duration) (models.Reservation, error) {
+	if ttl <= 0 {
+		ttl = DefaultReservationTTL
+	}
+	return a.r.Reserve(ctx, models.Reservation{ID: id, Items: normalizeItems(items)}, ttl)
+}
+
+func (a *App) CommitReservation(ctx context.Context, id string) (models.Reservation, error) {
+	return a.r.CommitReservation(ctx, id, actor(ctx))
+}
+
+func (a *App) ReleaseReservation(ctx context.Context, id string) (models.Reservation, error) {
+	return a.r.ReleaseReservation(ctx, id)
+}
+
+func (a *App) GetReservation(ctx context.Context, id string) (models.Reservation, error) {
+	return a.r.GetReservation(ctx, id)
+}
+
+// SweepReservations раз в interval освобождает просроченные резервы,
+// пока ctx не отменен
+func (a *App) SweepReservations(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		for {
+			n, err := a.r.ExpireReservations(ctx, sweepBatch)
+			if err != nil {
+				if ctx.Err() == nil {
+					slog.ErrorContext(ctx, "reservation sweeper: "+err.Error())
+				}
+				break
+			}
+			if n > 0 {
+				slog.InfoContext(ctx, "expired reservations released", "count", n)
+			}
+			if n < sweepBatch {
+				break
+			}
+		}
+	}
+}
+
+func normalizeItems(items []models.ReservationItem) []models.ReservationItem {
+	merged := make(map[[2]string]int, len(items))
+	for _, it := range items {
+		if it.Location == "" {
+			it.Location = models.DefaultLocation
+		}
+		merged[[2]string{it.ProductID, it.Location}] += it.Quantity
+	}
+	res := make([]models.ReservationItem, 0, len(merged))
+	for k, q := range merged {
+		res = append(res, models.ReservationItem{ProductID: k[0], Location: k[1], Quantity: q})
+	}
+	slices.SortFunc(res, func(a, b models.ReservationItem) int {
+		return cmp.Or(cmp.Compare(a.ProductID, b.ProductID), cmp.Compare(a.Location, b.Location))
+	})
+	return res
+}
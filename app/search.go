@@ -0,0 +1,99 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+)
+
+const DefaultSearchLanguage = "russian"
+
+// searchLanguages - словари полнотекстового поиска из стандартной поставки Postgres
+var searchLanguages = map[string]bool{
+	"simple": true, "arabic": true, "armenian": true, "basque": true, "catalan": true,
+	"danish": true, "dutch": true, "english": true, "finnish": true, "french": true,
+	"german": true, "greek": true, "hindi": true, "hungarian": true, "indonesian": true,
+	"irish": true, "italian": true, "lithuanian": true, "nepali": true, "norwegian": true,
+	"portuguese": true, "romanian": true, "russian": true, "serbian": true, "spanish": true,
+	"swedish": true, "tamil": true, "turkish": true, "yiddish": true,
+}
+
+func ValidSearchLanguage(lang string) bool {
+	return searchLanguages[lang]
+}
+
+// WithSearchLanguage задает словарь для запросов, в которых язык не указан
+func WithSearchLanguage(lang string) Option {
+	return func(a *App) {
+		a.searchLang = lang
+	}
+}
+
+func (a *App) Search(ctx context.Context, q models.SearchQuery) (models.SearchResult, error) {
+	if q.Language == "" {
+		q.Language = a.searchLang
+	}
+	if !searchLanguages[q.Language] {
+		return models.SearchResult{}, models.ErrUnknownLanguage
+	}
+	// подсказки ищутся по тому, что ввел пользователь, а не по tsquery
+	typed := strings.TrimSpace(q.Query)
+	if q.Prefix {
+		q.Query = prefixQuery(q.Query)
+	} else {
+		q.Query = strings.TrimSpace(q.Query)
+	}
+	if q.Query == "" {
+		return models.SearchResult{}, models.ErrEmptyQuery
+	}
+	if (q.MinPrice != nil || q.MaxPrice != nil) && q.Currency == "" {
+		q.Currency = money.DefaultCurrency
+	}
+	q.Limit = pageSize(q.Limit)
+	q.Offset = max(q.Offset, 0)
+
+	res, err := a.r.Search(ctx, q)
+	if err != nil {
+		return models.SearchResult{}, err
+	}
+	if res.Total == 0 && q.Offset == 0 {
+		res.DidYouMean = a.didYouMean(ctx, typed)
+	}
+	digests := make([]models.ProductDigest, len(res.Hits))
+	for i, h := range res.Hits {
+		digests[i] = h.ProductDigest
+	}
+	a.applyPromotions(ctx, digests)
+	a.applyImages(ctx, digests)
+	a.applyTranslations(ctx, digests)
+	for i := range res.Hits {
+		res.Hits[i].ProductDigest = digests[i]
+	}
+	return res, nil
+}
+
+func (a *App) SetProductSearchLanguage(ctx context.Context, productID, lang string) error {
+	if !searchLanguages[lang] {
+		return models.ErrUnknownLanguage
+	}
+	return a.r.SetProductSearchLanguage(ctx, productID, lang)
+}
+
+// prefixQuery превращает ввод пользователя в tsquery для поиска по мере ввода:
+// "шоколадный пон" -> 'шоколадный' & 'пон':*. Из слов остаются только буквы
+// и цифры, поэтому синтаксис tsquery из ввода не просочится
+func prefixQuery(s string) string {
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return ""
+	}
+	for i, w := range words {
+		words[i] = "'" + w + "'"
+	}
+	return strings.Join(words, " & ") + ":*"
+}
@@ -0,0 +1,25 @@
+package app
+
+import (
+	"context"
+
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/slug"
+)
+
+// GetBySlug находит товар и по старому слагу: тогда Slug товара отличается
+// от запрошенного, и витрине нужно ответить редиректом на текущий
+func (a *App) GetBySlug(ctx context.Context, s string) (string, models.Product, error) {
+	if !slug.Valid(s) {
+		return "", models.Product{}, models.ErrInvalidSlug
+	}
+	id, p, err := a.r.GetBySlug(ctx, s)
+	if err != nil {
+		return "", models.Product{}, err
+	}
+	if !visible(ctx, p) {
+		return "", models.Product{}, models.ErrNotFound
+	}
+	a.decorateProduct(ctx, id, &p)
+	return id, p, nil
+}
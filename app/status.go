@@ -0,0 +1,109 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/models"
+)
+
+const statusBatch = 100
+
+// transitions - куда можно перевести товар из каждого статуса. Снятый с продажи
+// можно вернуть сразу в published: проверку он уже проходил, а для правок есть draft
+var transitions = map[models.ProductStatus][]models.ProductStatus{
+	models.StatusDraft:        {models.StatusInReview},
+	models.StatusInReview:     {models.StatusDraft, models.StatusPublished},
+	models.StatusPublished:    {models.StatusDiscontinued},
+	models.StatusDiscontinued: {models.StatusDraft, models.StatusPublished},
+}
+
+func (a *App) SetStatus(ctx context.Context, id string, to models.ProductStatus) error {
+	if _, ok := transitions[to]; !ok {
+		return models.ErrInvalidStatus
+	}
+	return a.r.SetStatus(ctx, id, to, func(from models.ProductStatus) error {
+		if !slices.Contains(transitions[from], to) {
+			return fmt.Errorf("%w: %s -> %s", models.ErrStatusTransition, from, to)
+		}
+		return nil
+	})
+}
+
+// SetSchedule заменяет расписание целиком, нулевое время отменяет запланированное.
+// Назначить публикацию - это одобрить с датой, поэтому только товару на проверке;
+// снятие - еще и опубликованному. Отменить расписание можно в любом статусе
+func (a *App) SetSchedule(ctx context.Context, id string, s models.Schedule) error {
+	now := time.Now()
+	for _, t := range []time.Time{s.PublishAt, s.UnpublishAt} {
+		if !t.IsZero() && !t.After(now) {
+			return models.ErrScheduleInPast
+		}
+	}
+	if !s.PublishAt.IsZero() && !s.UnpublishAt.IsZero() && !s.UnpublishAt.After(s.PublishAt) {
+		return models.ErrScheduleOrder
+	}
+	return a.r.SetSchedule(ctx, id, s, func(status models.ProductStatus) error {
+		switch {
+		case !s.PublishAt.IsZero() && status != models.StatusInReview,
+			!s.UnpublishAt.IsZero() && status != models.StatusInReview && status != models.StatusPublished:
+			return fmt.Errorf("%w, product is %s", models.ErrScheduleStatus, status)
+		}
+		return nil
+	})
+}
+
+// visible - товар можно показать: витрине только опубликованный,
+// администратору каталога - любой
+func visible(ctx context.Context, p models.Product) bool {
+	return p.Status == models.StatusPublished || auth.CatalogAdmin(ctx)
+}
+
+// checkVisible - для чтений, которые отдают части товара (варианты, остатки,
+// картинки): неопубликованный товар для витрины как будто не существует
+func (a *App) checkVisible(ctx context.Context, productID string) error {
+	if auth.CatalogAdmin(ctx) {
+		return nil
+	}
+	p, err := a.r.Get(ctx, productID)
+	if err != nil {
+		return err
+	}
+	if !visible(ctx, p) {
+		return models.ErrNotFound
+	}
+	return nil
+}
+
+// ApplyScheduledStatuses раз в interval публикует и снимает с продажи товары
+// по расписанию, пока ctx не отменен
+func (a *App) ApplyScheduledStatuses(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		for {
+			n, err := a.r.ApplyDueStatuses(ctx, statusBatch)
+			if err != nil {
+				if ctx.Err() == nil {
+					slog.ErrorContext(ctx, "status scheduler: "+err.Error())
+				}
+				break
+			}
+			if n > 0 {
+				slog.InfoContext(ctx, "scheduled status changes applied", "count", n)
+			}
+			if n < statusBatch {
+				break
+			}
+		}
+	}
+}
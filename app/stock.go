@@ -0,0 +1,56 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+)
+
+// AdjustStock возвращает новый остаток на складе location; если суммарный
+// остаток опустился до порога, пишет предупреждение в лог
+func (a *App) AdjustStock(ctx context.Context, productID, location string, delta int, reason string) (int, error) {
+	if location == "" {
+		location = models.DefaultLocation
+	}
+	qty, err := a.r.AdjustStock(ctx, productID, location, delta, reason, actor(ctx))
+	if err != nil {
+		return 0, err
+	}
+	if delta < 0 {
+		ctx = log.WithProductID(ctx, productID)
+		stock, err := a.r.GetStock(ctx, productID)
+		if err != nil {
+			slog.WarnContext(ctx, "low stock check: "+err.Error())
+		} else if stock.LowStock() {
+			slog.WarnContext(ctx, "low stock", "total", stock.Total, "threshold", stock.LowStockThreshold)
+		}
+	}
+	return qty, nil
+}
+
+func (a *App) GetStock(ctx context.Context, productID string) (models.Stock, error) {
+	if err := a.checkVisible(ctx, productID); err != nil {
+		return models.Stock{}, err
+	}
+	return a.r.GetStock(ctx, productID)
+}
+
+func (a *App) SetLowStockThreshold(ctx context.Context, productID string, threshold int) error {
+	return a.r.SetLowStockThreshold(ctx, productID, threshold)
+}
+
+func (a *App) ListLowStock(ctx context.Context, limit, offset int) ([]models.Stock, error) {
+	return a.r.ListLowStock(ctx, pageSize(limit), max(offset, 0))
+}
+
+func (a *App) ListStockMovements(ctx context.Context, productID string, limit, offset int) ([]models.StockMovement, error) {
+	return a.r.ListStockMovements(ctx, productID, pageSize(limit), max(offset, 0))
+}
+
+// actor - кто выполняет операцию, для журналов; пустая строка, если запрос анонимный
+func actor(ctx context.Context) string {
+	return auth.Actor(ctx)
+}
@@ -0,0 +1,116 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/glekoz/online-shop_product/pkg/i18n"
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+)
+
+// WithDefaultLocale - язык, на котором заведены Name и Description товаров
+func WithDefaultLocale(locale string) Option {
+	return func(a *App) {
+		a.defaultLocale = locale
+	}
+}
+
+func (a *App) SetTranslation(ctx context.Context, productID string, t models.Translation) error {
+	locale, err := a.translationLocale(t.Locale)
+	if err != nil {
+		return err
+	}
+	t.Locale = locale
+	return a.r.SetTranslation(ctx, productID, t)
+}
+
+func (a *App) DeleteTranslation(ctx context.Context, productID, locale string) error {
+	locale, err := a.translationLocale(locale)
+	if err != nil {
+		return err
+	}
+	return a.r.DeleteTranslation(ctx, productID, locale)
+}
+
+func (a *App) ListTranslations(ctx context.Context, productID string) ([]models.Translation, error) {
+	return a.r.ListTranslations(ctx, productID)
+}
+
+// ListMissingTranslations - товары без перевода в locale, по названию
+func (a *App) ListMissingTranslations(ctx context.Context, locale string, limit, offset int) ([]models.UntranslatedProduct, error) {
+	locale, err := a.translationLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	prods, err := a.r.ListMissingTranslations(ctx, locale, pageSize(limit), max(offset, 0))
+	if err != nil {
+		return nil, log.WrapError(ctx, err)
+	}
+	return prods, nil
+}
+
+func (a *App) translationLocale(locale string) (string, error) {
+	locale, err := i18n.Canonical(locale)
+	if err != nil {
+		return "", err
+	}
+	if locale == a.defaultLocale {
+		return "", models.ErrDefaultLocale
+	}
+	return locale, nil
+}
+
+// translate выбирает для каждого товара перевод по цепочке локалей клиента.
+// Товара нет в ответе - отдаем как есть, на языке по умолчанию
+func (a *App) translate(ctx context.Context, ids []string) map[string]models.Translation {
+	chain := i18n.Fallbacks(i18n.Preferences(ctx), a.defaultLocale)
+	// дальше языка по умолчанию не идем: он есть у каждого товара
+	for i, l := range chain {
+		if l == a.defaultLocale {
+			chain = chain[:i]
+			break
+		}
+	}
+	if len(chain) == 0 || len(ids) == 0 {
+		return nil
+	}
+	found, err := a.r.FindTranslations(ctx, ids, chain)
+	if err != nil {
+		// как и с акциями: лучше каталог на языке по умолчанию, чем никакого
+		slog.WarnContext(log.ErrorContext(ctx, err), "translations are not applied: "+err.Error())
+		return nil
+	}
+	res := make(map[string]models.Translation, len(found))
+	for id, byLocale := range found {
+		for _, l := range chain {
+			if t, ok := byLocale[l]; ok {
+				res[id] = t
+				break
+			}
+		}
+	}
+	return res
+}
+
+// applyTranslations подменяет Name и заполняет Locale
+func (a *App) applyTranslations(ctx context.Context, prods []models.ProductDigest) {
+	ids := make([]string, len(prods))
+	for i, p := range prods {
+		ids[i] = p.ID
+	}
+	tr := a.translate(ctx, ids)
+	for i := range prods {
+		prods[i].Locale = a.defaultLocale
+		if t, ok := tr[prods[i].ID]; ok {
+			prods[i].Name, prods[i].Locale = t.Name, t.Locale
+		}
+	}
+}
+
+func (a *App) translateProduct(p *models.Product, tr map[string]models.Translation, id string) {
+	p.Locale = a.defaultLocale
+	if t, ok := tr[id]; ok {
+		p.Name, p.Description, p.Locale = t.Name, t.Description, t.Locale
+	}
+}
@@ -0,0 +1,57 @@
+package app
+
+import (
+	"context"
+
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/google/uuid"
+)
+
+func (a *App) CreateVariant(ctx context.Context, v models.Variant) (string, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	if err = a.r.CreateVariant(ctx, id.String(), v); err != nil {
+		return "", log.WrapError(ctx, err)
+	}
+	return id.String(), nil
+}
+
+func (a *App) GetVariant(ctx context.Context, id string) (models.Variant, error) {
+	v, err := a.r.GetVariant(ctx, id)
+	if err != nil {
+		return models.Variant{}, err
+	}
+	if err := a.checkVisible(ctx, v.ProductID); err != nil {
+		return models.Variant{}, err
+	}
+	return v, nil
+}
+
+func (a *App) GetVariantBySKU(ctx context.Context, sku string) (models.Variant, error) {
+	v, err := a.r.GetVariantBySKU(ctx, sku)
+	if err != nil {
+		return models.Variant{}, err
+	}
+	if err := a.checkVisible(ctx, v.ProductID); err != nil {
+		return models.Variant{}, err
+	}
+	return v, nil
+}
+
+func (a *App) ListVariants(ctx context.Context, productID string) ([]models.Variant, error) {
+	if err := a.checkVisible(ctx, productID); err != nil {
+		return nil, err
+	}
+	return a.r.ListVariants(ctx, productID)
+}
+
+func (a *App) UpdateVariant(ctx context.Context, id string, v models.Variant) error {
+	return a.r.UpdateVariant(ctx, id, v)
+}
+
+func (a *App) DeleteVariant(ctx context.Context, id string) error {
+	return a.r.DeleteVariant(ctx, id)
+}
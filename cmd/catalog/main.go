@@ -0,0 +1,321 @@
+// catalog - консольный клиент для административных операций каталога,
+// которые неудобно делать через grpcurl: загрузка файлов поставщиков и выгрузка каталога.
+//
+//	catalog import -addr localhost:8000 -dry-run supplier.csv
+//	catalog export -format google-xml -in-stock feed.xml
+//
+// Токен берется из PRODUCT_TOKEN, нужна роль catalog-admin
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/pb/catalog"
+	"github.com/glekoz/online-shop_product/pkg/tlsutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const usage = `usage: catalog <command> [flags] <file>
+
+commands:
+  import   upload products from a CSV or NDJSON file
+  export   download products as CSV, NDJSON or a Google Merchant feed
+
+run "catalog <command> -h" for command flags
+`
+
+// connFlags - как подключаться к сервису, общие для всех команд
+type connFlags struct {
+	addr    *string
+	tlsCA   *string
+	timeout *time.Duration
+	tenant  *string
+}
+
+func addConnFlags(fs *flag.FlagSet) connFlags {
+	return connFlags{
+		addr:    fs.String("addr", "localhost:8000", "product service gRPC address"),
+		tlsCA:   fs.String("tls-ca", "", "CA bundle to verify the server (empty - plaintext)"),
+		timeout: fs.Duration("timeout", 10*time.Minute, "how long to wait for the whole command"),
+		tenant:  fs.String("tenant", os.Getenv("PRODUCT_TENANT"), "tenant whose catalog to work with (empty - the token's or the default one)"),
+	}
+}
+
+func (c connFlags) dial() (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	if *c.tlsCA != "" {
+		pool, err := tlsutil.LoadCertPool(*c.tlsCA)
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(&tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12})
+	}
+	return grpc.NewClient(*c.addr, grpc.WithTransportCredentials(creds))
+}
+
+// context с токеном, магазином и таймаутом, отменяется по Ctrl+C
+func (c connFlags) context() (context.Context, context.CancelFunc) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	ctx, cancel := context.WithTimeout(ctx, *c.timeout)
+	if token := os.Getenv("PRODUCT_TOKEN"); token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+	}
+	if *c.tenant != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-tenant-id", *c.tenant)
+	}
+	return ctx, func() { cancel(); stop() }
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	var err error
+	switch os.Args[1] {
+	case "import":
+		err = runImport(os.Args[2:])
+	case "export":
+		err = runExport(os.Args[2:])
+	case "-h", "-help", "--help", "help":
+		fmt.Print(usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "catalog: "+err.Error())
+		os.Exit(1)
+	}
+}
+
+// errRowsFailed - импорт прошел, но часть строк отклонена; код выхода 1 для скриптов
+var errRowsFailed = errors.New("some rows were rejected")
+
+func runImport(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	conn := addConnFlags(fs)
+	format := fs.String("format", "", "csv or ndjson (default - by file extension)")
+	dryRun := fs.Bool("dry-run", false, "validate and report without writing anything")
+	verbose := fs.Bool("v", false, "print every row, not only rejected ones")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		return errors.New("import needs exactly one file, - for stdin")
+	}
+	path := fs.Arg(0)
+
+	f, err := importFormat(*format, path)
+	if err != nil {
+		return err
+	}
+	var in io.Reader = os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		in = file
+	}
+
+	cc, err := conn.dial()
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	ctx, cancel := conn.context()
+	defer cancel()
+
+	stream, err := catalog.NewGRPCImportClient(cc).Import(ctx)
+	if err != nil {
+		return err
+	}
+	err = stream.Send(&catalog.ImportChunk{Data: &catalog.ImportChunk_Options{
+		Options: &catalog.ImportOptions{Format: f, DryRun: *dryRun},
+	}})
+	buf := make([]byte, 64<<10)
+	for err == nil {
+		var n int
+		n, err = in.Read(buf)
+		if n > 0 {
+			if serr := stream.Send(&catalog.ImportChunk{Data: &catalog.ImportChunk_Chunk{Chunk: buf[:n]}}); serr != nil {
+				err = serr
+			}
+		}
+	}
+	// при io.EOF от Send настоящую ошибку сервер вернет в CloseAndRecv
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	report, err := stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+
+	for _, row := range report.GetRows() {
+		if !*verbose && row.GetStatus() != catalog.ImportStatus_IMPORT_STATUS_FAILED {
+			continue
+		}
+		status := strings.ToLower(strings.TrimPrefix(row.GetStatus().String(), "IMPORT_STATUS_"))
+		detail := row.GetId()
+		if row.GetError() != "" {
+			detail = row.GetError()
+		}
+		fmt.Printf("line %d\t%s\t%s\t%s\t%s\n", row.GetLine(), status, row.GetSku(), row.GetName(), detail)
+	}
+	mode := ""
+	if report.GetDryRun() {
+		mode = " (dry run, nothing written)"
+	}
+	fmt.Printf("created %d, updated %d, unchanged %d, failed %d%s\n",
+		report.GetCreated(), report.GetUpdated(), report.GetUnchanged(), report.GetFailed(), mode)
+	if report.GetFailed() > 0 {
+		return errRowsFailed
+	}
+	return nil
+}
+
+func importFormat(format, path string) (catalog.ImportFormat, error) {
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".csv":
+			format = "csv"
+		case ".ndjson", ".jsonl":
+			format = "ndjson"
+		default:
+			return 0, fmt.Errorf("cannot guess format of %q, set -format", path)
+		}
+	}
+	switch format {
+	case "csv":
+		return catalog.ImportFormat_IMPORT_FORMAT_CSV, nil
+	case "ndjson":
+		return catalog.ImportFormat_IMPORT_FORMAT_NDJSON, nil
+	}
+	return 0, fmt.Errorf("unknown format %q, allowed: csv, ndjson", format)
+}
+
+func runExport(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	conn := addConnFlags(fs)
+	format := fs.String("format", "", "csv, ndjson, google-xml or google-tsv (default - by file extension)")
+	columns := fs.String("columns", "", "comma-separated csv/ndjson columns (default - all)")
+	category := fs.String("category", "", "export only this category and its subcategories")
+	currency := fs.String("currency", "", "export only products priced in this currency")
+	inStock := fs.Bool("in-stock", false, "export only products in stock")
+	since := fs.String("updated-since", "", "export only products updated since the time, RFC 3339 or YYYY-MM-DD")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		return errors.New("export needs exactly one file, - for stdout")
+	}
+	path := fs.Arg(0)
+
+	req := &catalog.ExportRequest{CategoryId: *category, Currency: *currency, InStockOnly: *inStock}
+	var err error
+	if req.Format, err = exportFormat(*format, path); err != nil {
+		return err
+	}
+	if *columns != "" {
+		for _, c := range strings.Split(*columns, ",") {
+			req.Columns = append(req.Columns, strings.TrimSpace(c))
+		}
+	}
+	if *since != "" {
+		t, err := time.Parse(time.RFC3339, *since)
+		if err != nil {
+			if t, err = time.Parse(time.DateOnly, *since); err != nil {
+				return fmt.Errorf("bad -updated-since %q, want RFC 3339 or YYYY-MM-DD", *since)
+			}
+		}
+		req.UpdatedSince = timestamppb.New(t)
+	}
+
+	cc, err := conn.dial()
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	ctx, cancel := conn.context()
+	defer cancel()
+
+	stream, err := catalog.NewGRPCExportClient(cc).Export(ctx, req)
+	if err != nil {
+		return err
+	}
+	// в файл пишем через временный рядом с ним: оборванная выгрузка
+	// не должна затереть предыдущий фид, который, возможно, уже забирает Google
+	var out io.Writer = os.Stdout
+	var tmp *os.File
+	if path != "-" {
+		tmp, err = os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+		if err != nil {
+			return err
+		}
+		defer os.Remove(tmp.Name())
+		defer tmp.Close()
+		out = tmp
+	}
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := out.Write(chunk.GetData()); err != nil {
+			return err
+		}
+	}
+	if tmp == nil {
+		return nil
+	}
+	if err := tmp.Chmod(0o644); err != nil {
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func exportFormat(format, path string) (catalog.ExportFormat, error) {
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".ndjson", ".jsonl":
+			format = "ndjson"
+		case ".xml":
+			format = "google-xml"
+		case ".tsv", ".txt":
+			format = "google-tsv"
+		default:
+			format = "csv"
+		}
+	}
+	switch format {
+	case "csv":
+		return catalog.ExportFormat_EXPORT_FORMAT_CSV, nil
+	case "ndjson":
+		return catalog.ExportFormat_EXPORT_FORMAT_NDJSON, nil
+	case "google-xml":
+		return catalog.ExportFormat_EXPORT_FORMAT_GOOGLE_XML, nil
+	case "google-tsv":
+		return catalog.ExportFormat_EXPORT_FORMAT_GOOGLE_TSV, nil
+	}
+	return 0, fmt.Errorf("unknown format %q, allowed: csv, ndjson, google-xml, google-tsv", format)
+}
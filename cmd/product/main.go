@@ -0,0 +1,267 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/glekoz/online-shop_product/app"
+	"github.com/glekoz/online-shop_product/handler"
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/blob"
+	"github.com/glekoz/online-shop_product/pkg/i18n"
+	"github.com/glekoz/online-shop_product/pkg/imaging"
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/tlsutil"
+	"github.com/glekoz/online-shop_product/repository"
+	"github.com/glekoz/online-shop_proto/product"
+)
+
+func main() {
+	var (
+		port       = flag.Int("port", 8000, "gRPC port")
+		healthPort = flag.Int("health-port", 8081, "HTTP port for /healthz and /readyz (0 - disabled)")
+		restPort   = flag.Int("rest-port", 8080, "HTTP port for the REST/JSON API (0 - disabled)")
+		dsn        = flag.String("dsn", os.Getenv("PRODUCT_DSN"), "Postgres DSN")
+		reflect    = flag.Bool("reflection", false, "enable gRPC server reflection")
+		drain      = flag.Duration("drain-timeout", 5*time.Second, "how long to stay NOT_SERVING before stopping")
+		jwtSecret  = flag.String("jwt-secret", os.Getenv("PRODUCT_JWT_SECRET"), "HS256 secret (at least 32 bytes)")
+		jwtKeys    = flag.String("jwt-public-keys", "", "comma-separated RS256 public key PEM files")
+		jwksFile   = flag.String("jwks-file", "", "JWKS file with RS256 keys")
+		jwtIssuer  = flag.String("jwt-issuer", "", "expected iss claim")
+		jwtAud     = flag.String("jwt-audience", "", "expected aud claim")
+		tlsCert    = flag.String("tls-cert", "", "TLS certificate file (empty - plaintext)")
+		tlsKey     = flag.String("tls-key", "", "TLS private key file")
+		tlsCA      = flag.String("tls-client-ca", "", "CA bundle to verify client certificates (enables mTLS on gRPC)")
+		restMTLS   = flag.Bool("rest-client-cert", false, "require client certificates on the REST port too (needs -tls-client-ca)")
+		tlsReload  = flag.Duration("tls-reload-interval", 10*time.Second, "how often to check certificate files for rotation")
+		readRPS    = flag.Float64("read-rps", 50, "per-client read requests per second (0 - unlimited)")
+		readBurst  = flag.Int("read-burst", 100, "per-client read burst")
+		writeRPS   = flag.Float64("write-rps", 5, "per-client write requests per second (0 - unlimited)")
+		writeBurst = flag.Int("write-burst", 10, "per-client write burst")
+		getAllRPS  = flag.Float64("getall-rps", 1, "per-client GetAll requests per second, it scans the whole table")
+		maxFlight  = flag.Int("max-in-flight", 0, "max concurrent requests before shedding (0 - 3/4 of Postgres pool size)")
+		sweepEvery = flag.Duration("reservation-sweep-interval", 30*time.Second, "how often to release expired stock reservations")
+		priceEvery = flag.Duration("price-schedule-interval", 10*time.Second, "how often to apply scheduled price changes")
+		pubEvery   = flag.Duration("status-schedule-interval", 30*time.Second, "how often to publish and unpublish products by schedule")
+		imgStorage = flag.String("image-storage", "", "where to store product images: fs, s3 or empty (images disabled)")
+		imgDir     = flag.String("image-dir", "./images", "directory for -image-storage=fs")
+		imgBaseURL = flag.String("image-base-url", "", "public URL the images are served from (required for fs, CDN for s3)")
+		imgMaxSize = flag.Int64("image-max-size", app.DefaultMaxImageSize, "max upload size in bytes")
+		imgSizes   = flag.String("image-sizes", "small:160,medium:480,large:1024", "thumbnail sizes as name:max-side pairs")
+		s3Endpoint = flag.String("s3-endpoint", "", "S3-compatible endpoint, e.g. https://s3.eu-central-1.amazonaws.com")
+		s3Region   = flag.String("s3-region", "us-east-1", "S3 region")
+		s3Bucket   = flag.String("s3-bucket", "", "S3 bucket for images")
+		s3VHost    = flag.Bool("s3-virtual-host", false, "address the bucket as a subdomain instead of a path")
+		searchLang = flag.String("search-language", app.DefaultSearchLanguage, "text search config for queries without a language; products keep their own, russian unless set via SetProductLanguage")
+		fuzzyMin   = flag.Float64("fuzzy-threshold", float64(app.DefaultFuzzyThreshold), "min word similarity for typo-tolerant search and suggestions")
+		dupMin     = flag.Float64("duplicate-threshold", float64(app.DefaultDuplicateThreshold), "min name similarity to warn about a possible duplicate on create")
+		feedTitle  = flag.String("feed-title", "", "shop name in Google Merchant feeds")
+		feedLink   = flag.String("feed-link", "", "shop URL in Google Merchant feeds")
+		locale     = flag.String("default-locale", i18n.DefaultLocale, "language of product names and descriptions; other languages are stored as translations")
+		rls        = flag.Bool("row-level-security", false, "set app.tenant_id on every connection so Postgres RLS policies isolate tenants")
+		productURL = flag.String("product-url", "", "product page URL template for exports, {id} and {slug} are replaced with the product id and slug")
+	)
+	flag.Parse()
+
+	slog.SetDefault(slog.New(log.NewMyJSONLogHandler(slog.NewJSONHandler(os.Stdout, nil))))
+
+	var repoOpts []repository.Option
+	if *rls {
+		repoOpts = append(repoOpts, repository.WithRowLevelSecurity())
+	}
+	repo, err := repository.New(*dsn, repoOpts...)
+	if err != nil {
+		slog.Error("repository init: " + err.Error())
+		os.Exit(1)
+	}
+	defer repo.Close()
+
+	opts := []handler.Option{
+		handler.WithReflection(*reflect),
+		handler.WithHealthPort(*healthPort),
+		handler.WithRESTPort(*restPort),
+		handler.WithHealthCheck("postgres", repo.Ping),
+		handler.WithHealthCheck("cache", repo.PingCache),
+		handler.WithDrainTimeout(*drain),
+	}
+
+	inFlight := *maxFlight
+	if inFlight == 0 {
+		// четверть пула остается фоновым задачам и запросам, которые уже ждут соединение
+		inFlight = max(repo.MaxConns()*3/4, 1)
+	}
+	opts = append(opts, handler.WithRateLimit(handler.Limits{
+		Read:  handler.RateLimit{Rate: *readRPS, Burst: *readBurst},
+		Write: handler.RateLimit{Rate: *writeRPS, Burst: *writeBurst},
+		Methods: map[string]handler.RateLimit{
+			product.GRPCProduct_GetAll_FullMethodName: {Rate: *getAllRPS, Burst: 2},
+		},
+		MaxInFlight: inFlight,
+	}))
+
+	var authOpts []auth.Option
+	if *jwtSecret != "" {
+		authOpts = append(authOpts, auth.WithHMACSecret([]byte(*jwtSecret)))
+	}
+	for _, path := range strings.Split(*jwtKeys, ",") {
+		if path = strings.TrimSpace(path); path != "" {
+			authOpts = append(authOpts, auth.WithRSAPublicKeyFile(path))
+		}
+	}
+	if *jwksFile != "" {
+		authOpts = append(authOpts, auth.WithJWKSFile(*jwksFile))
+	}
+	if len(authOpts) > 0 {
+		authOpts = append(authOpts, auth.WithIssuer(*jwtIssuer), auth.WithAudience(*jwtAud))
+		verifier, err := auth.NewVerifier(authOpts...)
+		if err != nil {
+			slog.Error("auth init: " + err.Error())
+			os.Exit(1)
+		}
+		opts = append(opts, handler.WithAuth(verifier, handler.DefaultPolicy()))
+	} else {
+		slog.Warn("no JWT keys configured, authentication is disabled")
+	}
+
+	if *tlsCert != "" {
+		reloader, err := tlsutil.NewReloader(tlsutil.Config{
+			CertFile:      *tlsCert,
+			KeyFile:       *tlsKey,
+			ClientCAFile:  *tlsCA,
+			CheckInterval: *tlsReload,
+		})
+		if err != nil {
+			slog.Error("tls init: " + err.Error())
+			os.Exit(1)
+		}
+		opts = append(opts, handler.WithTLS(reloader.ServerConfig()), handler.WithRESTTLS(reloader.HTTPConfig(*restMTLS)))
+	}
+
+	if !app.ValidSearchLanguage(*searchLang) {
+		slog.Error("unknown search language " + *searchLang)
+		os.Exit(1)
+	}
+	if !app.ValidThreshold(float32(*fuzzyMin)) || !app.ValidThreshold(float32(*dupMin)) {
+		slog.Error("similarity thresholds must be in (0, 1]")
+		os.Exit(1)
+	}
+	defLocale, err := i18n.Canonical(*locale)
+	if err != nil {
+		slog.Error("default locale: " + err.Error())
+		os.Exit(1)
+	}
+	appOpts := []app.Option{
+		app.WithSearchLanguage(*searchLang),
+		app.WithDefaultLocale(defLocale),
+		app.WithFuzzyThresholds(float32(*fuzzyMin), float32(*dupMin)),
+		app.WithFeed(app.FeedConfig{Title: *feedTitle, Link: *feedLink, ProductURL: *productURL}),
+	}
+	if *imgStorage != "" {
+		storage, err := newBlobStorage(*imgStorage, *imgDir, *imgBaseURL, blob.S3Config{
+			Endpoint:    *s3Endpoint,
+			Region:      *s3Region,
+			Bucket:      *s3Bucket,
+			AccessKey:   os.Getenv("PRODUCT_S3_ACCESS_KEY"),
+			SecretKey:   os.Getenv("PRODUCT_S3_SECRET_KEY"),
+			VirtualHost: *s3VHost,
+			PublicURL:   *imgBaseURL,
+		})
+		if err != nil {
+			slog.Error("image storage init: " + err.Error())
+			os.Exit(1)
+		}
+		sizes, err := parseSizes(*imgSizes)
+		if err != nil {
+			slog.Error("image sizes: " + err.Error())
+			os.Exit(1)
+		}
+		appOpts = append(appOpts, app.WithImages(storage, app.ImageConfig{MaxSize: *imgMaxSize, Sizes: sizes}))
+	}
+
+	a := app.New(repo, appOpts...)
+	opts = append(opts, handler.WithCategories(a), handler.WithAttributes(a), handler.WithVariants(a), handler.WithInventory(a), handler.WithReservations(a), handler.WithPricing(a), handler.WithPromotions(a), handler.WithImages(a), handler.WithSearch(a), handler.WithImport(a), handler.WithExport(a), handler.WithProducts(a), handler.WithAudit(a), handler.WithTranslations(a))
+
+	srv := handler.NewServer(a, opts...)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	go a.SweepReservations(ctx, *sweepEvery)
+	go a.ApplyScheduledPrices(ctx, *priceEvery)
+	go a.ApplyScheduledStatuses(ctx, *pubEvery)
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- srv.RunServer(*port)
+	}()
+	slog.Info("product service started", "port", *port, "health_port", *healthPort, "rest_port", *restPort)
+
+	select {
+	case err := <-errs:
+		if err != nil {
+			slog.Error("server: " + err.Error())
+			os.Exit(1)
+		}
+		return
+	case <-ctx.Done():
+	}
+
+	slog.Info("shutting down")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), *drain+10*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		slog.Error("shutdown: " + err.Error())
+	}
+	if err := <-errs; err != nil {
+		slog.Error("server: " + err.Error())
+	}
+}
+
+func newBlobStorage(kind, dir, baseURL string, s3 blob.S3Config) (blob.Storage, error) {
+	switch kind {
+	case "fs":
+		if baseURL == "" {
+			return nil, errors.New("-image-base-url is required for fs storage")
+		}
+		return blob.NewFS(dir, baseURL)
+	case "s3":
+		return blob.NewS3(s3, nil)
+	}
+	return nil, fmt.Errorf("unknown image storage %q", kind)
+}
+
+// parseSizes разбирает "small:160,medium:480"
+func parseSizes(s string) ([]imaging.Size, error) {
+	var sizes []imaging.Size
+	for _, part := range strings.Split(s, ",") {
+		name, side, ok := strings.Cut(strings.TrimSpace(part), ":")
+		n, err := strconv.Atoi(side)
+		if !ok || err != nil || n <= 0 || !validSizeName(name) {
+			return nil, fmt.Errorf("bad size %q, want name:max-side", part)
+		}
+		sizes = append(sizes, imaging.Size{Name: name, Max: n})
+	}
+	return sizes, nil
+}
+
+// имя превью попадает в ключ хранилища рядом с original.<ext>
+func validSizeName(name string) bool {
+	if name == "" || name == "original" {
+		return false
+	}
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"regexp"
+
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/pb/catalog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type AttributeService struct {
+	app AttributeAppAPI
+	catalog.UnimplementedGRPCAttributeServer
+}
+
+type AttributeAppAPI interface {
+	DefineAttribute(ctx context.Context, d models.AttributeDefinition) (string, error)
+	DeleteAttribute(ctx context.Context, id string) error
+	ListAttributeDefinitions(ctx context.Context, categoryID string) ([]models.AttributeDefinition, error)
+	SetProductAttributes(ctx context.Context, productID string, attrs models.Attributes) error
+	GetProductAttributes(ctx context.Context, productID string) (models.Attributes, error)
+	FilterProducts(ctx context.Context, f models.ProductFilter) (models.FilterResult, error)
+}
+
+var attrCodeRe = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+var attrTypes = map[catalog.AttributeType]models.AttributeType{
+	catalog.AttributeType_ATTRIBUTE_TYPE_STRING:  models.AttributeString,
+	catalog.AttributeType_ATTRIBUTE_TYPE_NUMBER:  models.AttributeNumber,
+	catalog.AttributeType_ATTRIBUTE_TYPE_BOOLEAN: models.AttributeBoolean,
+	catalog.AttributeType_ATTRIBUTE_TYPE_ENUM:    models.AttributeEnum,
+}
+
+func (s *AttributeService) Define(ctx context.Context, req *catalog.AttributeDefinition) (*catalog.AttributeID, error) {
+	if req.GetCategoryId() == "" || req.GetCode() == "" || req.GetName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "category_id, code and name are required")
+	}
+	if !attrCodeRe.MatchString(req.GetCode()) {
+		return nil, status.Errorf(codes.InvalidArgument, "code must start with a lowercase latin letter and contain only lowercase latin letters, digits and underscores")
+	}
+	typ, ok := attrTypes[req.GetType()]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "type is required")
+	}
+	if (typ == models.AttributeEnum) != (len(req.GetEnumValues()) > 0) {
+		return nil, status.Errorf(codes.InvalidArgument, "enum_values are required for enum attributes and not allowed for others")
+	}
+	id, err := s.app.DefineAttribute(ctx, models.AttributeDefinition{
+		CategoryID: req.GetCategoryId(),
+		Code:       req.GetCode(),
+		Name:       req.GetName(),
+		Type:       typ,
+		EnumValues: req.GetEnumValues(),
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "attribute %s is already defined for the category", req.GetCode())
+		}
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "category %s not found", req.GetCategoryId())
+		}
+		slog.ErrorContext(log.ErrorContext(ctx, err), "attribute definition: "+err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &catalog.AttributeID{Id: id}, nil
+}
+
+func (s *AttributeService) Delete(ctx context.Context, req *catalog.AttributeID) (*emptypb.Empty, error) {
+	if req.GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	if err := s.app.DeleteAttribute(ctx, req.GetId()); err != nil {
+		return nil, attributeStatus(err, req.GetId())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AttributeService) List(ctx context.Context, req *catalog.CategoryID) (*catalog.AttributeDefinitionList, error) {
+	if req.GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	defs, err := s.app.ListAttributeDefinitions(ctx, req.GetId())
+	if err != nil {
+		return nil, attributeStatus(err, req.GetId())
+	}
+	resp := &catalog.AttributeDefinitionList{Definitions: make([]*catalog.AttributeDefinition, len(defs))}
+	for i, d := range defs {
+		resp.Definitions[i] = definitionToPB(d)
+	}
+	return resp, nil
+}
+
+func (s *AttributeService) SetProductAttributes(ctx context.Context, req *catalog.ProductAttributes) (*emptypb.Empty, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	attrs := make(models.Attributes, len(req.GetValues()))
+	for code, v := range req.GetValues() {
+		val, err := valueFromPB(v)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s: %v", code, err)
+		}
+		attrs[code] = val
+	}
+	if err := s.app.SetProductAttributes(ctx, req.GetProductId(), attrs); err != nil {
+		return nil, attributeStatus(err, req.GetProductId())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AttributeService) GetProductAttributes(ctx context.Context, req *catalog.ProductRef) (*catalog.ProductAttributes, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	attrs, err := s.app.GetProductAttributes(ctx, req.GetProductId())
+	if err != nil {
+		return nil, attributeStatus(err, req.GetProductId())
+	}
+	resp := &catalog.ProductAttributes{ProductId: req.GetProductId(), Values: make(map[string]*catalog.AttributeValue, len(attrs))}
+	for code, v := range attrs {
+		resp.Values[code] = valueToPB(v)
+	}
+	return resp, nil
+}
+
+func (s *AttributeService) Filter(ctx context.Context, req *catalog.FilterRequest) (*catalog.FilterResponse, error) {
+	if req.GetLimit() < 0 || req.GetOffset() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+	f := models.ProductFilter{
+		CategoryID:         req.GetCategoryId(),
+		IncludeDescendants: req.GetIncludeDescendants(),
+		Limit:              int(req.GetLimit()),
+		Offset:             int(req.GetOffset()),
+	}
+	for _, af := range req.GetFilters() {
+		if af.GetCode() == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "filter code is required")
+		}
+		if len(af.GetAnyOf()) == 0 && af.Min == nil && af.Max == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "filter %s must have any_of or min/max", af.GetCode())
+		}
+		mf := models.AttributeFilter{Code: af.GetCode(), Min: af.Min, Max: af.Max}
+		for _, v := range af.GetAnyOf() {
+			val, err := valueFromPB(v)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "filter %s: %v", af.GetCode(), err)
+			}
+			mf.AnyOf = append(mf.AnyOf, val)
+		}
+		f.Attributes = append(f.Attributes, mf)
+	}
+	res, err := s.app.FilterProducts(ctx, f)
+	if err != nil {
+		return nil, attributeStatus(err, req.GetCategoryId())
+	}
+	resp := &catalog.FilterResponse{
+		Products: digestsToPB(res.Products).GetProducts(),
+		Facets:   make([]*catalog.Facet, len(res.Facets)),
+	}
+	for i, fc := range res.Facets {
+		pf := &catalog.Facet{Code: fc.Code, Min: fc.Min, Max: fc.Max}
+		for _, v := range fc.Values {
+			pf.Values = append(pf.Values, &catalog.FacetValue{Value: valueToPB(v.Value), Count: int64(v.Count)})
+		}
+		resp.Facets[i] = pf
+	}
+	return resp, nil
+}
+
+func attributeStatus(err error, key string) error {
+	switch {
+	case errors.Is(err, models.ErrNotFound):
+		return status.Errorf(codes.NotFound, "%s not found", key)
+	case errors.Is(err, models.ErrInvalidAttribute):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func valueFromPB(v *catalog.AttributeValue) (any, error) {
+	switch k := v.GetKind().(type) {
+	case *catalog.AttributeValue_StringValue:
+		return k.StringValue, nil
+	case *catalog.AttributeValue_NumberValue:
+		if math.IsNaN(k.NumberValue) || math.IsInf(k.NumberValue, 0) {
+			return nil, errors.New("number must be finite")
+		}
+		return k.NumberValue, nil
+	case *catalog.AttributeValue_BoolValue:
+		return k.BoolValue, nil
+	}
+	return nil, errors.New("value is empty")
+}
+
+func valueToPB(v any) *catalog.AttributeValue {
+	switch v := v.(type) {
+	case string:
+		return &catalog.AttributeValue{Kind: &catalog.AttributeValue_StringValue{StringValue: v}}
+	case float64:
+		return &catalog.AttributeValue{Kind: &catalog.AttributeValue_NumberValue{NumberValue: v}}
+	case bool:
+		return &catalog.AttributeValue{Kind: &catalog.AttributeValue_BoolValue{BoolValue: v}}
+	}
+	return &catalog.AttributeValue{}
+}
+
+func definitionToPB(d models.AttributeDefinition) *catalog.AttributeDefinition {
+	pd := &catalog.AttributeDefinition{
+		Id:         d.ID,
+		CategoryId: d.CategoryID,
+		Code:       d.Code,
+		Name:       d.Name,
+		EnumValues: d.EnumValues,
+	}
+	for t, mt := range attrTypes {
+		if mt == d.Type {
+			pd.Type = t
+		}
+	}
+	return pd
+}
@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/pb/catalog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AuditService struct {
+	app AuditAppAPI
+	catalog.UnimplementedGRPCAuditServer
+}
+
+type AuditAppAPI interface {
+	ListAudit(ctx context.Context, f models.AuditFilter) ([]models.AuditEntry, error)
+}
+
+func (s *AuditService) List(ctx context.Context, req *catalog.ListAuditRequest) (*catalog.AuditEntries, error) {
+	if req.GetLimit() < 0 || req.GetOffset() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+	entries, err := s.app.ListAudit(ctx, models.AuditFilter{
+		ProductID: req.GetProductId(),
+		Actor:     req.GetActor(),
+		Limit:     int(req.GetLimit()),
+		Offset:    int(req.GetOffset()),
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrAuditFilter) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &catalog.AuditEntries{Entries: make([]*catalog.AuditEntry, len(entries))}
+	for i, e := range entries {
+		resp.Entries[i] = &catalog.AuditEntry{
+			Id:        e.ID,
+			ProductId: e.ProductID,
+			Action:    string(e.Action),
+			Actor:     e.Actor,
+			RequestId: e.RequestID,
+			Diff:      string(e.Diff),
+			CreatedAt: timestamppb.New(e.CreatedAt),
+		}
+	}
+	return resp, nil
+}
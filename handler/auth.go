@@ -0,0 +1,202 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/pb/catalog"
+	"github.com/glekoz/online-shop_proto/product"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultPolicy - чтение каталога открыто всем, изменение только для catalog-admin.
+// Health и reflection тоже публичные, иначе кубер не сможет проверять под.
+func DefaultPolicy() auth.Policy {
+	return auth.Policy{
+		product.GRPCProduct_Get_FullMethodName:    {auth.RolePublic},
+		product.GRPCProduct_GetAll_FullMethodName: {auth.RolePublic},
+		product.GRPCProduct_Create_FullMethodName: {auth.RoleCatalogAdmin},
+		product.GRPCProduct_Update_FullMethodName: {auth.RoleCatalogAdmin},
+		product.GRPCProduct_Delete_FullMethodName: {auth.RoleCatalogAdmin},
+
+		catalog.GRPCCategory_Get_FullMethodName:                  {auth.RolePublic},
+		catalog.GRPCCategory_GetBySlug_FullMethodName:            {auth.RolePublic},
+		catalog.GRPCCategory_Tree_FullMethodName:                 {auth.RolePublic},
+		catalog.GRPCCategory_GetProductCategories_FullMethodName: {auth.RolePublic},
+		catalog.GRPCCategory_ListProducts_FullMethodName:         {auth.RolePublic},
+		catalog.GRPCCategory_Create_FullMethodName:               {auth.RoleCatalogAdmin},
+		catalog.GRPCCategory_Update_FullMethodName:               {auth.RoleCatalogAdmin},
+		catalog.GRPCCategory_Delete_FullMethodName:               {auth.RoleCatalogAdmin},
+		catalog.GRPCCategory_SetProductCategories_FullMethodName: {auth.RoleCatalogAdmin},
+
+		catalog.GRPCAttribute_List_FullMethodName:                 {auth.RolePublic},
+		catalog.GRPCAttribute_GetProductAttributes_FullMethodName: {auth.RolePublic},
+		catalog.GRPCAttribute_Filter_FullMethodName:               {auth.RolePublic},
+		catalog.GRPCAttribute_Define_FullMethodName:               {auth.RoleCatalogAdmin},
+		catalog.GRPCAttribute_Delete_FullMethodName:               {auth.RoleCatalogAdmin},
+		catalog.GRPCAttribute_SetProductAttributes_FullMethodName: {auth.RoleCatalogAdmin},
+
+		catalog.GRPCVariant_Get_FullMethodName:      {auth.RolePublic},
+		catalog.GRPCVariant_GetBySKU_FullMethodName: {auth.RolePublic},
+		catalog.GRPCVariant_List_FullMethodName:     {auth.RolePublic},
+		catalog.GRPCVariant_Create_FullMethodName:   {auth.RoleCatalogAdmin},
+		catalog.GRPCVariant_Update_FullMethodName:   {auth.RoleCatalogAdmin},
+		catalog.GRPCVariant_Delete_FullMethodName:   {auth.RoleCatalogAdmin},
+
+		catalog.GRPCInventory_Get_FullMethodName:                  {auth.RolePublic},
+		catalog.GRPCInventory_Adjust_FullMethodName:               {auth.RoleCatalogAdmin},
+		catalog.GRPCInventory_SetLowStockThreshold_FullMethodName: {auth.RoleCatalogAdmin},
+		catalog.GRPCInventory_ListLowStock_FullMethodName:         {auth.RoleCatalogAdmin},
+		catalog.GRPCInventory_Ledger_FullMethodName:               {auth.RoleCatalogAdmin},
+
+		catalog.GRPCReservation_Reserve_FullMethodName: {auth.RoleOrderService, auth.RoleCatalogAdmin},
+		catalog.GRPCReservation_Commit_FullMethodName:  {auth.RoleOrderService, auth.RoleCatalogAdmin},
+		catalog.GRPCReservation_Release_FullMethodName: {auth.RoleOrderService, auth.RoleCatalogAdmin},
+		catalog.GRPCReservation_Get_FullMethodName:     {auth.RoleOrderService, auth.RoleCatalogAdmin},
+
+		catalog.GRPCPricing_ListPrices_FullMethodName:   {auth.RolePublic},
+		catalog.GRPCPricing_Quote_FullMethodName:        {auth.RolePublic},
+		catalog.GRPCPricing_ListRates_FullMethodName:    {auth.RolePublic},
+		catalog.GRPCPricing_SetBasePrice_FullMethodName: {auth.RoleCatalogAdmin},
+		catalog.GRPCPricing_SetPrice_FullMethodName:     {auth.RoleCatalogAdmin},
+		catalog.GRPCPricing_DeletePrice_FullMethodName:  {auth.RoleCatalogAdmin},
+		catalog.GRPCPricing_SetRate_FullMethodName:      {auth.RoleCatalogAdmin},
+		catalog.GRPCPricing_DeleteRate_FullMethodName:   {auth.RoleCatalogAdmin},
+
+		catalog.GRPCPricing_SchedulePrice_FullMethodName:        {auth.RoleCatalogAdmin},
+		catalog.GRPCPricing_CancelScheduledPrice_FullMethodName: {auth.RoleCatalogAdmin},
+		catalog.GRPCPricing_History_FullMethodName:              {auth.RoleCatalogAdmin},
+		catalog.GRPCPricing_PriceAt_FullMethodName:              {auth.RoleCatalogAdmin},
+
+		catalog.GRPCPromotion_GetPrice_FullMethodName: {auth.RolePublic},
+		catalog.GRPCPromotion_Get_FullMethodName:      {auth.RoleCatalogAdmin},
+		catalog.GRPCPromotion_List_FullMethodName:     {auth.RoleCatalogAdmin},
+		catalog.GRPCPromotion_Create_FullMethodName:   {auth.RoleCatalogAdmin},
+		catalog.GRPCPromotion_Update_FullMethodName:   {auth.RoleCatalogAdmin},
+		catalog.GRPCPromotion_Delete_FullMethodName:   {auth.RoleCatalogAdmin},
+
+		catalog.GRPCImage_List_FullMethodName:       {auth.RolePublic},
+		catalog.GRPCImage_Upload_FullMethodName:     {auth.RoleCatalogAdmin},
+		catalog.GRPCImage_Delete_FullMethodName:     {auth.RoleCatalogAdmin},
+		catalog.GRPCImage_SetPrimary_FullMethodName: {auth.RoleCatalogAdmin},
+		catalog.GRPCImage_Reorder_FullMethodName:    {auth.RoleCatalogAdmin},
+
+		catalog.GRPCSearch_Search_FullMethodName:             {auth.RolePublic},
+		catalog.GRPCSearch_Fuzzy_FullMethodName:              {auth.RolePublic},
+		catalog.GRPCSearch_Suggest_FullMethodName:            {auth.RolePublic},
+		catalog.GRPCSearch_SetProductLanguage_FullMethodName: {auth.RoleCatalogAdmin},
+
+		catalog.GRPCImport_Import_FullMethodName: {auth.RoleCatalogAdmin},
+		catalog.GRPCExport_Export_FullMethodName: {auth.RoleCatalogAdmin},
+
+		catalog.GRPCProducts_BatchGet_FullMethodName:         {auth.RolePublic},
+		catalog.GRPCProducts_BulkUpdatePrices_FullMethodName: {auth.RoleCatalogAdmin},
+		catalog.GRPCProducts_BulkDelete_FullMethodName:       {auth.RoleCatalogAdmin},
+		catalog.GRPCProducts_GetBySlug_FullMethodName:        {auth.RolePublic},
+		catalog.GRPCProducts_SetStatus_FullMethodName:        {auth.RoleCatalogAdmin},
+		catalog.GRPCProducts_SetSchedule_FullMethodName:      {auth.RoleCatalogAdmin},
+
+		catalog.GRPCAudit_List_FullMethodName: {auth.RoleCatalogAdmin},
+
+		catalog.GRPCTranslation_Set_FullMethodName:         {auth.RoleCatalogAdmin},
+		catalog.GRPCTranslation_Delete_FullMethodName:      {auth.RoleCatalogAdmin},
+		catalog.GRPCTranslation_List_FullMethodName:        {auth.RoleCatalogAdmin},
+		catalog.GRPCTranslation_ListMissing_FullMethodName: {auth.RoleCatalogAdmin},
+
+		"/grpc.health.v1.Health/*":                    {auth.RolePublic},
+		"/grpc.reflection.v1.ServerReflection/*":      {auth.RolePublic},
+		"/grpc.reflection.v1alpha.ServerReflection/*": {auth.RolePublic},
+	}
+}
+
+type authenticator struct {
+	verifier *auth.Verifier
+	policy   auth.Policy
+}
+
+func (a authenticator) unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (a authenticator) stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authorize проверяет токен из metadata и роли для метода.
+// Для публичных методов токен не обязателен, но если он передан - он должен быть валидным.
+func (a authenticator) authorize(ctx context.Context, method string) (context.Context, error) {
+	roles, ok := a.policy.Roles(method)
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "method %s is not allowed", method)
+	}
+	public := slices.Contains(roles, auth.RolePublic)
+
+	token := bearerToken(ctx)
+	if token == "" {
+		if public {
+			return ctx, nil
+		}
+		return nil, status.Error(codes.Unauthenticated, auth.ErrNoToken.Error())
+	}
+	claims, err := a.verifier.Verify(token)
+	if err != nil {
+		if errors.Is(err, auth.ErrNoToken) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return nil, status.Error(codes.Unauthenticated, auth.ErrInvalidToken.Error())
+	}
+	// иначе один админский токен без магазина правил бы любой каталог,
+	// просто меняя заголовок; публичное чтение магазин по-прежнему выбирает сам
+	if claims.Tenant == "" && !public && !claims.HasRole(auth.RoleAllTenants) {
+		claims.Tenant = models.DefaultTenant
+	}
+	ctx = auth.WithClaims(ctx, claims)
+	ctx = log.WithUserID(ctx, claims.Subject)
+	if public || slices.ContainsFunc(roles, claims.HasRole) {
+		return ctx, nil
+	}
+	return nil, status.Errorf(codes.PermissionDenied, "%s requires one of roles: %s", method, strings.Join(roles, ", "))
+}
+
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, v := range md.Get("authorization") {
+		if len(v) > 7 && strings.EqualFold(v[:7], "bearer ") {
+			return strings.TrimSpace(v[7:])
+		}
+	}
+	return ""
+}
+
+// wrappedStream нужен, чтобы прокинуть обогащенный контекст в stream-хендлер
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (w *wrappedStream) Context() context.Context {
+	return w.ctx
+}
@@ -0,0 +1,228 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"regexp"
+
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/pb/catalog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type CategoryService struct {
+	app CategoryAppAPI
+	catalog.UnimplementedGRPCCategoryServer
+}
+
+type CategoryAppAPI interface {
+	CreateCategory(ctx context.Context, c models.Category) (string, error)
+	GetCategory(ctx context.Context, id string) (models.Category, error)
+	GetCategoryBySlug(ctx context.Context, slug string) (models.Category, error)
+	CategoryTree(ctx context.Context) ([]*models.CategoryNode, error)
+	UpdateCategory(ctx context.Context, id string, c models.Category) error
+	DeleteCategory(ctx context.Context, id string) error
+	SetProductCategories(ctx context.Context, productID string, categoryIDs []string) error
+	GetProductCategories(ctx context.Context, productID string) ([]models.Category, error)
+	ListProductsByCategory(ctx context.Context, categoryID string, descendants bool, limit, offset int) ([]models.ProductDigest, error)
+}
+
+var slugRe = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+func (s *CategoryService) Create(ctx context.Context, req *catalog.Category) (*catalog.CategoryID, error) {
+	c := categoryFromPB(req)
+	if err := validateCategory(c); err != nil {
+		return nil, err
+	}
+	id, err := s.app.CreateCategory(ctx, c)
+	if err != nil {
+		if errors.Is(err, models.ErrAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "category with the same slug already exists: %v", c.Slug)
+		}
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "parent category %s not found", c.ParentID)
+		}
+		slog.ErrorContext(log.ErrorContext(ctx, err), "category creation: "+err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &catalog.CategoryID{Id: id}, nil
+}
+
+func (s *CategoryService) Get(ctx context.Context, req *catalog.CategoryID) (*catalog.Category, error) {
+	if req.GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	c, err := s.app.GetCategory(ctx, req.GetId())
+	if err != nil {
+		return nil, categoryStatus(err, req.GetId())
+	}
+	return categoryToPB(c), nil
+}
+
+func (s *CategoryService) GetBySlug(ctx context.Context, req *catalog.CategorySlug) (*catalog.Category, error) {
+	if req.GetSlug() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "slug is required")
+	}
+	c, err := s.app.GetCategoryBySlug(ctx, req.GetSlug())
+	if err != nil {
+		return nil, categoryStatus(err, req.GetSlug())
+	}
+	return categoryToPB(c), nil
+}
+
+func (s *CategoryService) Tree(ctx context.Context, _ *emptypb.Empty) (*catalog.CategoryTree, error) {
+	roots, err := s.app.CategoryTree(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &catalog.CategoryTree{Roots: nodesToPB(roots)}, nil
+}
+
+func (s *CategoryService) Update(ctx context.Context, req *catalog.Category) (*emptypb.Empty, error) {
+	c := categoryFromPB(req)
+	if req.GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	if err := validateCategory(c); err != nil {
+		return nil, err
+	}
+	if err := s.app.UpdateCategory(ctx, req.GetId(), c); err != nil {
+		return nil, categoryStatus(err, req.GetId())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *CategoryService) Delete(ctx context.Context, req *catalog.CategoryID) (*emptypb.Empty, error) {
+	if req.GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	if err := s.app.DeleteCategory(ctx, req.GetId()); err != nil {
+		return nil, categoryStatus(err, req.GetId())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *CategoryService) SetProductCategories(ctx context.Context, req *catalog.ProductCategories) (*emptypb.Empty, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	if err := s.app.SetProductCategories(ctx, req.GetProductId(), req.GetCategoryIds()); err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "product or one of the categories not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *CategoryService) GetProductCategories(ctx context.Context, req *catalog.ProductRef) (*catalog.CategoryList, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	cats, err := s.app.GetProductCategories(ctx, req.GetProductId())
+	if err != nil {
+		return nil, categoryStatus(err, req.GetProductId())
+	}
+	resp := &catalog.CategoryList{Categories: make([]*catalog.Category, len(cats))}
+	for i, c := range cats {
+		resp.Categories[i] = categoryToPB(c)
+	}
+	return resp, nil
+}
+
+func (s *CategoryService) ListProducts(ctx context.Context, req *catalog.ListProductsRequest) (*catalog.ProductList, error) {
+	if req.GetCategoryId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "category_id is required")
+	}
+	if req.GetLimit() < 0 || req.GetOffset() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+	prods, err := s.app.ListProductsByCategory(ctx, req.GetCategoryId(), req.GetIncludeDescendants(), int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		return nil, categoryStatus(err, req.GetCategoryId())
+	}
+	return digestsToPB(prods), nil
+}
+
+func validateCategory(c models.Category) error {
+	if c.Name == "" || c.Slug == "" {
+		return status.Errorf(codes.InvalidArgument, "name and slug are required")
+	}
+	if !slugRe.MatchString(c.Slug) {
+		return status.Errorf(codes.InvalidArgument, "slug must contain only lowercase latin letters, digits and single dashes")
+	}
+	return nil
+}
+
+func categoryStatus(err error, key string) error {
+	switch {
+	case errors.Is(err, models.ErrNotFound):
+		return status.Errorf(codes.NotFound, "%s not found", key)
+	case errors.Is(err, models.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, "category with the same slug already exists")
+	case errors.Is(err, models.ErrCategoryCycle):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, models.ErrHasChildren):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func categoryFromPB(c *catalog.Category) models.Category {
+	return models.Category{
+		ID:       c.GetId(),
+		ParentID: c.GetParentId(),
+		Name:     c.GetName(),
+		Slug:     c.GetSlug(),
+	}
+}
+
+func categoryToPB(c models.Category) *catalog.Category {
+	return &catalog.Category{
+		Id:       c.ID,
+		ParentId: c.ParentID,
+		Name:     c.Name,
+		Slug:     c.Slug,
+	}
+}
+
+func nodesToPB(nodes []*models.CategoryNode) []*catalog.CategoryNode {
+	res := make([]*catalog.CategoryNode, len(nodes))
+	for i, n := range nodes {
+		res[i] = &catalog.CategoryNode{
+			Category: categoryToPB(n.Category),
+			Children: nodesToPB(n.Children),
+		}
+	}
+	return res
+}
+
+func digestsToPB(prods []models.ProductDigest) *catalog.ProductList {
+	res := &catalog.ProductList{Products: make([]*catalog.ProductDigest, len(prods))}
+	for i, p := range prods {
+		res.Products[i] = digestToPB(p)
+	}
+	return res
+}
+
+func digestToPB(p models.ProductDigest) *catalog.ProductDigest {
+	pd := &catalog.ProductDigest{
+		Id:             p.ID,
+		Name:           p.Name,
+		Price:          p.Price.Amount,
+		Currency:       p.Price.Currency,
+		Available:      p.Available,
+		EffectivePrice: p.EffectivePrice.Amount,
+		PromotionIds:   p.Promotions,
+		Locale:         p.Locale,
+		Slug:           p.Slug,
+	}
+	if p.PrimaryImage != nil {
+		pd.PrimaryImage = imageToPB(*p.PrimaryImage)
+	}
+	return pd
+}
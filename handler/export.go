@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/glekoz/online-shop_product/pkg/bulk"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+	"github.com/glekoz/online-shop_product/pkg/pb/catalog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportChunkSize - размер сообщения потока: мелкие куски - лишние накладные
+// расходы gRPC, крупные упираются в лимит размера сообщения клиента
+const exportChunkSize = 64 << 10
+
+type ExportService struct {
+	app ExportAppAPI
+	catalog.UnimplementedGRPCExportServer
+}
+
+type ExportAppAPI interface {
+	Export(ctx context.Context, f models.ExportFilter, format bulk.Format, columns []string, w io.Writer) (int, error)
+}
+
+var exportFormats = map[catalog.ExportFormat]bulk.Format{
+	catalog.ExportFormat_EXPORT_FORMAT_CSV:        bulk.CSV,
+	catalog.ExportFormat_EXPORT_FORMAT_NDJSON:     bulk.NDJSON,
+	catalog.ExportFormat_EXPORT_FORMAT_GOOGLE_XML: bulk.GoogleXML,
+	catalog.ExportFormat_EXPORT_FORMAT_GOOGLE_TSV: bulk.GoogleTSV,
+}
+
+func (s *ExportService) Export(req *catalog.ExportRequest, stream catalog.GRPCExport_ExportServer) error {
+	format, ok := exportFormats[req.GetFormat()]
+	if !ok {
+		return status.Error(codes.InvalidArgument, "unknown export format")
+	}
+	if (format == bulk.GoogleXML || format == bulk.GoogleTSV) && len(req.GetColumns()) > 0 {
+		return status.Error(codes.InvalidArgument, "columns cannot be chosen for Google Merchant feeds")
+	}
+	f := models.ExportFilter{
+		CategoryID:  req.GetCategoryId(),
+		Currency:    req.GetCurrency(),
+		InStockOnly: req.GetInStockOnly(),
+	}
+	if req.UpdatedSince != nil {
+		if err := req.GetUpdatedSince().CheckValid(); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		f.UpdatedSince = req.GetUpdatedSince().AsTime()
+	}
+
+	w := bufio.NewWriterSize(&streamWriter{stream: stream}, exportChunkSize)
+	if _, err := s.app.Export(stream.Context(), f, format, req.GetColumns(), w); err != nil {
+		return exportStatus(err)
+	}
+	if err := w.Flush(); err != nil {
+		return exportStatus(err)
+	}
+	return nil
+}
+
+// streamWriter отправляет каждый Write отдельным сообщением; Send сериализует
+// сообщение сразу, поэтому буфер можно переиспользовать
+type streamWriter struct {
+	stream catalog.GRPCExport_ExportServer
+}
+
+func (w *streamWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&catalog.ExportChunk{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func exportStatus(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, models.ErrNotFound):
+		return status.Error(codes.NotFound, "category not found")
+	case errors.Is(err, bulk.ErrUnknownColumn), errors.Is(err, bulk.ErrUnknownFormat),
+		errors.Is(err, money.ErrUnknownCurrency):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
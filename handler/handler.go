@@ -3,39 +3,80 @@ package handler
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
 
 	"github.com/glekoz/online-shop_product/pkg/log"
 	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
 	"github.com/glekoz/online-shop_proto/product"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type ProductService struct {
-	app AppAPI
+	app  AppAPI
+	opts options
+
+	limiter *rateLimiter
+
+	mu        sync.Mutex
+	serv      *grpc.Server
+	httpServs []*http.Server
+
+	health       *health.Server
+	ready        atomic.Bool
+	shuttingDown atomic.Bool
+	done         chan struct{}
+	stopOnce     sync.Once
+
 	product.UnimplementedGRPCProductServer
 }
 
 type AppAPI interface {
-	Create(ctx context.Context, prod models.Product) (string, error)
+	Create(ctx context.Context, prod models.Product) (string, []models.SimilarProduct, error)
 	Get(ctx context.Context, id string) (models.Product, error)
 	GetAll(ctx context.Context) ([]models.ProductDigest, error)
 	Delete(ctx context.Context, id string) error
 	Update(ctx context.Context, id string, prod models.Product) error
 }
 
+// similarProductsHeader - id товаров с похожими названиями. Legacy-ответ Create
+// состоит только из id, поэтому предупреждение о возможном дубле уходит в заголовок
+const similarProductsHeader = "x-similar-products"
+
 func (s *ProductService) Create(ctx context.Context, req *product.Product) (*product.ID, error) {
+	id, similar, err := s.create(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(similar) > 0 {
+		ids := make([]string, len(similar))
+		for i, p := range similar {
+			ids[i] = p.ID
+		}
+		grpc.SetHeader(ctx, metadata.MD{similarProductsHeader: ids})
+	}
+	return &product.ID{Id: id}, nil
+}
+
+func (s *ProductService) create(ctx context.Context, req *product.Product) (string, []models.SimilarProduct, error) {
 	prod := models.Product{
 		Name:        req.GetName(),
-		Price:       int(req.GetPrice()),
+		Price:       money.New(int64(req.GetPrice()), money.DefaultCurrency),
 		Description: req.GetDescription(),
 	}
 
 	// позже эту валидацию нужно будет вынести в шлюз
-	if prod.Name == "" || prod.Price <= 0 || prod.Description == "" {
-		return nil, status.Errorf(
+	if prod.Name == "" || prod.Price.Amount <= 0 || prod.Description == "" {
+		return "", nil, status.Errorf(
 			codes.InvalidArgument,
 			"name, price and description are required, price must be greater than 0",
 		)
@@ -48,38 +89,55 @@ func (s *ProductService) Create(ctx context.Context, req *product.Product) (*pro
 	// благодаря методу Handle в моем MyJSONLogHandler вся информация из контекста будет выведена в лог
 	slog.InfoContext(ctx, "product creation started")
 
-	id, err := s.app.Create(ctx, prod)
+	id, similar, err := s.app.Create(ctx, prod)
 	if err != nil {
 		if errors.Is(err, models.ErrAlreadyExists) {
-			return nil, status.Errorf(
+			return "", nil, status.Errorf(
 				codes.AlreadyExists,
 				"product with the same name already exists: %v", prod.Name,
 			)
 		}
 		slog.ErrorContext(log.ErrorContext(ctx, err), "product creation: "+err.Error())
-		return nil, status.Error(codes.Internal, err.Error())
+		return "", nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, p := range similar {
+		slog.WarnContext(ctx, fmt.Sprintf("possible duplicate of %s %q, similarity %.2f", p.ID, p.Name, p.Similarity))
 	}
 	slog.InfoContext(ctx, "product creation ended")
-	return &product.ID{Id: id}, nil
+	return id, similar, nil
 }
 
+// Get - в legacy-ответе нет поля для языка, поэтому он уходит в заголовок
 func (s *ProductService) Get(ctx context.Context, req *product.ID) (*product.Product, error) {
+	p, locale, err := s.get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	grpc.SetHeader(ctx, metadata.Pairs(contentLanguageHeader, locale))
+	return p, nil
+}
+
+func (s *ProductService) get(ctx context.Context, req *product.ID) (*product.Product, string, error) {
 	id := req.GetId()
 	if id == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+		return nil, "", status.Errorf(codes.InvalidArgument, "id is required")
 	}
 	p, err := s.app.Get(ctx, id)
 	if err != nil {
 		if errors.Is(err, models.ErrNotFound) {
-			return nil, status.Errorf(codes.NotFound, "%s not found", id)
+			return nil, "", status.Errorf(codes.NotFound, "%s not found", id)
 		}
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, "", status.Error(codes.Internal, err.Error())
+	}
+	price, err := legacyPrice(p.Price)
+	if err != nil {
+		return nil, "", err
 	}
 	return &product.Product{
 		Name:        p.Name,
-		Price:       int32(p.Price),
+		Price:       price,
 		Description: p.Description,
-	}, nil
+	}, p.Locale, nil
 }
 
 func (s *ProductService) GetAll(ctx context.Context, _ *emptypb.Empty) (*product.GetAllResponse, error) {
@@ -90,13 +148,19 @@ func (s *ProductService) GetAll(ctx context.Context, _ *emptypb.Empty) (*product
 		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	prods := make([]*product.ProductDigest, len(ress))
-	for i, res := range ress {
-		prods[i] = &product.ProductDigest{
+	prods := make([]*product.ProductDigest, 0, len(ress))
+	for _, res := range ress {
+		price, err := legacyPrice(res.Price)
+		if err != nil {
+			// старый контракт не умеет в валюты: такие товары видны только через catalog
+			slog.WarnContext(ctx, "product skipped in legacy listing", "id", res.ID, "price", res.Price.String())
+			continue
+		}
+		prods = append(prods, &product.ProductDigest{
 			Id:    res.ID,
 			Name:  res.Name,
-			Price: int32(res.Price),
-		}
+			Price: price,
+		})
 	}
 	return &product.GetAllResponse{Products: prods}, nil
 }
@@ -126,13 +190,28 @@ func (s *ProductService) Update(ctx context.Context, req *product.UpdateRequest)
 
 	if err := s.app.Update(ctx, id, models.Product{
 		Name:        prod.Name,
-		Price:       int(prod.Price),
+		Price:       money.New(int64(prod.Price), money.DefaultCurrency),
 		Description: prod.Description,
 	}); err != nil {
 		if errors.Is(err, models.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, err.Error())
 		}
+		if errors.Is(err, models.ErrLegacyCurrency) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	return nil, nil
 }
+
+// legacyPrice переводит цену в int32 старого контракта, который знает только рубли.
+// Старый контракт отдает цену по прайсу, цена с учетом акций есть только в catalog
+func legacyPrice(m money.Money) (int32, error) {
+	if m.Currency != money.DefaultCurrency {
+		return 0, status.Errorf(codes.FailedPrecondition, "price is set in %s, use catalog.GRPCPricing to read it", m.Currency)
+	}
+	if !m.FitsInt32() {
+		return 0, status.Errorf(codes.OutOfRange, "price %s does not fit into int32, use catalog.GRPCPricing to read it", m)
+	}
+	return int32(m.Amount), nil
+}
@@ -1,19 +1,44 @@
 package handler
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"log"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/bulk"
+	"github.com/glekoz/online-shop_product/pkg/i18n"
 	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+	"github.com/glekoz/online-shop_product/pkg/pb/catalog"
+	"github.com/glekoz/online-shop_product/pkg/promo"
+	"github.com/glekoz/online-shop_product/pkg/tlsutil"
+	"github.com/glekoz/online-shop_product/pkg/tlsutil/tlstest"
 	"github.com/glekoz/online-shop_proto/product"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ----------------------------------------------------------------
@@ -32,16 +57,30 @@ func TestServerSuite(t *testing.T) {
 }
 
 func (s *ServerSuite) SetupSuite() {
+	// сервер поднимается с mTLS на одноразовых сертификатах
+	dir := s.T().TempDir()
+	ca := tlstest.NewCA(s.T())
+	certFile, keyFile := ca.WriteFiles(s.T(), dir, "product")
+	reloader, err := tlsutil.NewReloader(tlsutil.Config{
+		CertFile:     certFile,
+		KeyFile:      keyFile,
+		ClientCAFile: ca.WriteCA(s.T(), dir),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
-		go NewServer(&AppMock{}).RunServer(8000)
+		go NewServer(&AppMock{}, WithTLS(reloader.ServerConfig())).RunServer(8000)
 		time.Sleep(100 * time.Millisecond)
 		wg.Done()
 	}()
 	wg.Wait()
 	ctx := context.Background()
-	conn, err := grpc.NewClient("127.0.0.1:8000", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient("127.0.0.1:8000",
+		grpc.WithTransportCredentials(credentials.NewTLS(ca.ClientConfig(s.T(), "gateway"))))
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -62,13 +101,15 @@ func (s *ServerSuite) TearDownSuite() {
 type AppMock struct {
 }
 
-func (a *AppMock) Create(ctx context.Context, prod models.Product) (string, error) {
+func (a *AppMock) Create(ctx context.Context, prod models.Product) (string, []models.SimilarProduct, error) {
 	if prod.Name == "Donut" {
-		return "", models.ErrAlreadyExists
+		return "", nil, models.ErrAlreadyExists
 	} else if prod.Name == "Unknown" {
-		return "", models.ErrInternal
+		return "", nil, models.ErrInternal
+	} else if prod.Name == "Glazed Donuts" {
+		return "10", []models.SimilarProduct{{ID: "1", Name: "Glazed Donut", Similarity: 0.8}}, nil
 	}
-	return "10", nil
+	return "10", nil, nil
 }
 
 func (a *AppMock) Get(ctx context.Context, id string) (models.Product, error) {
@@ -76,15 +117,24 @@ func (a *AppMock) Get(ctx context.Context, id string) (models.Product, error) {
 		return models.Product{}, models.ErrInternal
 	} else if id == "404" {
 		return models.Product{}, models.ErrNotFound
+	} else if id == "usd" {
+		return models.Product{Name: "Donut", Price: money.New(1000, "USD"), Description: "Delicious"}, nil
+	} else if id == "locale" {
+		if slices.Contains(i18n.Preferences(ctx), "en") {
+			return models.Product{Name: "Donut", Price: money.New(1000, money.DefaultCurrency), Description: "Delicious", Locale: "en"}, nil
+		}
+		return models.Product{Name: "Пончик", Price: money.New(1000, money.DefaultCurrency), Description: "Вкусный", Locale: "ru"}, nil
+	} else if id == "tenant" {
+		return models.Product{Name: auth.Tenant(ctx), Price: money.New(1000, money.DefaultCurrency), Description: "Delicious"}, nil
 	}
-	return models.Product{Name: "Donut", Price: 1000, Description: "Delicious"}, nil
+	return models.Product{Name: "Donut", Price: money.New(1000, money.DefaultCurrency), Description: "Delicious"}, nil
 }
 
 func (a *AppMock) GetAll(ctx context.Context) ([]models.ProductDigest, error) {
 	return []models.ProductDigest{
-		{ID: "1", Name: "Donut", Price: 1000},
-		{ID: "2", Name: "Another Donut", Price: 1200},
-		{ID: "3", Name: "Another Another Donut", Price: 1500},
+		{ID: "1", Name: "Donut", Price: money.New(1000, money.DefaultCurrency)},
+		{ID: "2", Name: "Another Donut", Price: money.New(1200, money.DefaultCurrency)},
+		{ID: "3", Name: "Another Another Donut", Price: money.New(1500, money.DefaultCurrency)},
 	}, nil
 }
 
@@ -102,10 +152,552 @@ func (a *AppMock) Update(ctx context.Context, id string, prod models.Product) er
 		return models.ErrInternal
 	} else if id == "404" {
 		return models.ErrNotFound
+	} else if id == "usd" {
+		return models.ErrLegacyCurrency
+	}
+	return nil
+}
+
+type CategoryMock struct {
+}
+
+func (c *CategoryMock) CreateCategory(ctx context.Context, cat models.Category) (string, error) {
+	switch cat.Slug {
+	case "donuts":
+		return "", models.ErrAlreadyExists
+	case "orphan":
+		return "", models.ErrNotFound
+	}
+	return "c1", nil
+}
+
+func (c *CategoryMock) GetCategory(ctx context.Context, id string) (models.Category, error) {
+	if id == "404" {
+		return models.Category{}, models.ErrNotFound
+	}
+	return models.Category{ID: id, Name: "Donuts", Slug: "donuts"}, nil
+}
+
+func (c *CategoryMock) GetCategoryBySlug(ctx context.Context, slug string) (models.Category, error) {
+	return c.GetCategory(ctx, slug)
+}
+
+func (c *CategoryMock) CategoryTree(ctx context.Context) ([]*models.CategoryNode, error) {
+	return []*models.CategoryNode{{
+		Category: models.Category{ID: "1", Name: "Bakery", Slug: "bakery"},
+		Children: []*models.CategoryNode{{Category: models.Category{ID: "2", ParentID: "1", Name: "Donuts", Slug: "donuts"}}},
+	}}, nil
+}
+
+func (c *CategoryMock) UpdateCategory(ctx context.Context, id string, cat models.Category) error {
+	if cat.ParentID == "child" {
+		return models.ErrCategoryCycle
+	}
+	return nil
+}
+
+func (c *CategoryMock) DeleteCategory(ctx context.Context, id string) error {
+	if id == "parent" {
+		return models.ErrHasChildren
+	}
+	return nil
+}
+
+func (c *CategoryMock) SetProductCategories(ctx context.Context, productID string, categoryIDs []string) error {
+	return nil
+}
+
+func (c *CategoryMock) GetProductCategories(ctx context.Context, productID string) ([]models.Category, error) {
+	return []models.Category{{ID: "2", ParentID: "1", Name: "Donuts", Slug: "donuts"}}, nil
+}
+
+func (c *CategoryMock) ListProductsByCategory(ctx context.Context, categoryID string, descendants bool, limit, offset int) ([]models.ProductDigest, error) {
+	if categoryID == "404" {
+		return nil, models.ErrNotFound
+	}
+	return []models.ProductDigest{{ID: "1", Name: "Donut", Price: money.New(1000, money.DefaultCurrency)}}, nil
+}
+
+type AttributeMock struct {
+	filter models.ProductFilter
+}
+
+func (a *AttributeMock) DefineAttribute(ctx context.Context, d models.AttributeDefinition) (string, error) {
+	if d.Code == "color" {
+		return "", models.ErrAlreadyExists
+	}
+	return "a1", nil
+}
+
+func (a *AttributeMock) DeleteAttribute(ctx context.Context, id string) error {
+	return nil
+}
+
+func (a *AttributeMock) ListAttributeDefinitions(ctx context.Context, categoryID string) ([]models.AttributeDefinition, error) {
+	return []models.AttributeDefinition{{ID: "a1", CategoryID: categoryID, Code: "color", Name: "Color", Type: models.AttributeEnum, EnumValues: []string{"red"}}}, nil
+}
+
+func (a *AttributeMock) SetProductAttributes(ctx context.Context, productID string, attrs models.Attributes) error {
+	if _, ok := attrs["size"]; ok {
+		return fmt.Errorf("%w: size is not defined for product categories", models.ErrInvalidAttribute)
+	}
+	return nil
+}
+
+func (a *AttributeMock) GetProductAttributes(ctx context.Context, productID string) (models.Attributes, error) {
+	return models.Attributes{"color": "red", "weight": 1.5, "vegan": true}, nil
+}
+
+func (a *AttributeMock) FilterProducts(ctx context.Context, f models.ProductFilter) (models.FilterResult, error) {
+	a.filter = f
+	lo, hi := 0.5, 2.0
+	return models.FilterResult{
+		Products: []models.ProductDigest{{ID: "1", Name: "Donut", Price: money.New(1000, money.DefaultCurrency)}},
+		Facets: []models.Facet{
+			{Code: "color", Values: []models.FacetValue{{Value: "red", Count: 1}}},
+			{Code: "weight", Min: &lo, Max: &hi},
+		},
+	}, nil
+}
+
+type VariantMock struct {
+}
+
+func (v *VariantMock) CreateVariant(ctx context.Context, variant models.Variant) (string, error) {
+	if variant.SKU == "TS-RED-M" {
+		return "", models.ErrAlreadyExists
+	}
+	return "v1", nil
+}
+
+func (v *VariantMock) GetVariant(ctx context.Context, id string) (models.Variant, error) {
+	if id == "404" {
+		return models.Variant{}, models.ErrNotFound
+	}
+	return models.Variant{ID: id, ProductID: "1", SKU: "TS-RED-M", Price: money.New(1500, money.DefaultCurrency), Options: map[string]string{"size": "M", "color": "red"}}, nil
+}
+
+func (v *VariantMock) GetVariantBySKU(ctx context.Context, sku string) (models.Variant, error) {
+	if sku != "TS-RED-M" {
+		return models.Variant{}, models.ErrNotFound
+	}
+	return v.GetVariant(ctx, "v1")
+}
+
+func (v *VariantMock) ListVariants(ctx context.Context, productID string) ([]models.Variant, error) {
+	return nil, nil
+}
+
+func (v *VariantMock) UpdateVariant(ctx context.Context, id string, variant models.Variant) error {
+	return nil
+}
+
+func (v *VariantMock) DeleteVariant(ctx context.Context, id string) error {
+	return nil
+}
+
+type InventoryMock struct {
+	stock map[string]int
+	mu    sync.Mutex
+}
+
+func (m *InventoryMock) AdjustStock(ctx context.Context, productID, location string, delta int, reason string) (int, error) {
+	if productID == "404" {
+		return 0, models.ErrNotFound
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.stock[location]+delta < 0 {
+		return 0, models.ErrInsufficientStock
+	}
+	m.stock[location] += delta
+	return m.stock[location], nil
+}
+
+func (m *InventoryMock) GetStock(ctx context.Context, productID string) (models.Stock, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	st := models.Stock{ProductID: productID, LowStockThreshold: 5}
+	for loc, q := range m.stock {
+		st.Levels = append(st.Levels, models.StockLevel{Location: loc, Quantity: q})
+		st.Total += q
+	}
+	return st, nil
+}
+
+func (m *InventoryMock) SetLowStockThreshold(ctx context.Context, productID string, threshold int) error {
+	return nil
+}
+
+func (m *InventoryMock) ListLowStock(ctx context.Context, limit, offset int) ([]models.Stock, error) {
+	return nil, nil
+}
+
+func (m *InventoryMock) ListStockMovements(ctx context.Context, productID string, limit, offset int) ([]models.StockMovement, error) {
+	return []models.StockMovement{{ID: 1, ProductID: productID, Location: "main", Delta: 3, QuantityAfter: 3, CreatedAt: time.Now()}}, nil
+}
+
+type ReservationMock struct {
+}
+
+func (m *ReservationMock) Reserve(ctx context.Context, id string, items []models.ReservationItem, ttl time.Duration) (models.Reservation, error) {
+	switch id {
+	case "oversell":
+		return models.Reservation{}, models.ErrInsufficientStock
+	case "reused":
+		return models.Reservation{}, models.ErrReservationConflict
+	}
+	return models.Reservation{ID: id, Status: models.ReservationActive, Items: items, ExpiresAt: time.Now().Add(time.Minute)}, nil
+}
+
+func (m *ReservationMock) CommitReservation(ctx context.Context, id string) (models.Reservation, error) {
+	if id == "late" {
+		return models.Reservation{}, models.ErrReservationExpired
+	}
+	return models.Reservation{ID: id, Status: models.ReservationCommitted}, nil
+}
+
+func (m *ReservationMock) ReleaseReservation(ctx context.Context, id string) (models.Reservation, error) {
+	if id == "shipped" {
+		return models.Reservation{}, models.ErrReservationClosed
+	}
+	return models.Reservation{ID: id, Status: models.ReservationReleased}, nil
+}
+
+func (m *ReservationMock) GetReservation(ctx context.Context, id string) (models.Reservation, error) {
+	return models.Reservation{}, models.ErrNotFound
+}
+
+type PricingMock struct {
+}
+
+func (m *PricingMock) SetBasePrice(ctx context.Context, productID string, price money.Money) error {
+	return nil
+}
+
+func (m *PricingMock) SetProductPrice(ctx context.Context, productID string, price money.Money) error {
+	if productID == "404" {
+		return models.ErrNotFound
+	}
+	return nil
+}
+
+func (m *PricingMock) DeleteProductPrice(ctx context.Context, productID, currency string) error {
+	return models.ErrNotFound
+}
+
+func (m *PricingMock) ListProductPrices(ctx context.Context, productID string) (models.ProductPrices, error) {
+	return models.ProductPrices{Base: money.New(1000, "RUB"), Prices: []money.Money{money.New(15, "USD")}}, nil
+}
+
+func (m *PricingMock) QuotePrice(ctx context.Context, productID, currency string) (models.PriceQuote, error) {
+	if currency == "JPY" {
+		return models.PriceQuote{}, models.ErrNoExchangeRate
+	}
+	return models.PriceQuote{Price: money.New(13, currency), Source: models.PriceConverted, Rate: "0.0125"}, nil
+}
+
+func (m *PricingMock) SetCurrencyRate(ctx context.Context, rate money.Rate) error {
+	return nil
+}
+
+func (m *PricingMock) DeleteCurrencyRate(ctx context.Context, base, quote string) error {
+	return nil
+}
+
+func (m *PricingMock) ListCurrencyRates(ctx context.Context) ([]models.CurrencyRate, error) {
+	return []models.CurrencyRate{{Rate: money.Rate{Base: "RUB", Quote: "USD", Rate: "0.0125", Rounding: money.RoundHalfEven, Increment: 1}}}, nil
+}
+
+func (m *PricingMock) SchedulePrice(ctx context.Context, productID string, price money.Money, at time.Time) (int64, error) {
+	if !at.After(time.Now()) {
+		return 0, models.ErrScheduleInPast
+	}
+	return 7, nil
+}
+
+func (m *PricingMock) CancelScheduledPrice(ctx context.Context, id int64) error {
+	return models.ErrNotFound
+}
+
+func (m *PricingMock) PriceHistory(ctx context.Context, productID string, limit, offset int) ([]models.PriceChange, error) {
+	now := time.Now()
+	return []models.PriceChange{
+		{ID: 3, Price: money.New(900, "RUB"), EffectiveFrom: now.Add(time.Hour)},
+		{ID: 2, Price: money.New(1000, "RUB"), EffectiveFrom: now, Applied: true},
+		{ID: 1, Price: money.New(1200, "RUB"), EffectiveFrom: now.Add(-time.Hour), EffectiveTo: now, Applied: true},
+	}, nil
+}
+
+func (m *PricingMock) PriceAt(ctx context.Context, productID string, at time.Time) (money.Money, error) {
+	return money.New(1200, "RUB"), nil
+}
+
+type PromotionMock struct {
+	AppMock
+}
+
+func (m *PromotionMock) CreatePromotion(ctx context.Context, p promo.Promotion) (string, error) {
+	return "p1", nil
+}
+
+func (m *PromotionMock) UpdatePromotion(ctx context.Context, id string, p promo.Promotion) error {
+	return models.ErrNotFound
+}
+
+func (m *PromotionMock) DeletePromotion(ctx context.Context, id string) error {
+	return nil
+}
+
+func (m *PromotionMock) GetPromotion(ctx context.Context, id string) (promo.Promotion, error) {
+	return promo.Promotion{ID: id, Name: "Black Friday", Kind: promo.Fixed, Amount: money.New(500, "RUB"), Target: promo.TargetAll}, nil
+}
+
+func (m *PromotionMock) ListPromotions(ctx context.Context) ([]promo.Promotion, error) {
+	return nil, nil
+}
+
+func (m *PromotionMock) Get(ctx context.Context, id string) (models.Product, error) {
+	p, err := m.AppMock.Get(ctx, id)
+	p.EffectivePrice, p.Promotions = money.New(900, p.Price.Currency), []string{"p1"}
+	return p, err
+}
+
+type ImageMock struct {
+	mu       sync.Mutex
+	received []byte
+}
+
+func (m *ImageMock) UploadImage(ctx context.Context, meta models.Image, r io.Reader) (models.Image, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return models.Image{}, err
+	}
+	if meta.ProductID != "1" {
+		return models.Image{}, models.ErrNotFound
+	}
+	m.mu.Lock()
+	m.received = data
+	m.mu.Unlock()
+	return models.Image{ID: "img1", ProductID: meta.ProductID, ContentType: "image/png", Size: int64(len(data)), Primary: true,
+		Thumbnails: []models.Thumbnail{{Name: "small", URL: "https://cdn/small.png", Width: 160, Height: 80}}}, nil
+}
+
+func (m *ImageMock) ListImages(ctx context.Context, productID string) ([]models.Image, error) {
+	return []models.Image{{ID: "img1", ProductID: productID, Primary: true}, {ID: "img2", ProductID: productID, Position: 1}}, nil
+}
+
+func (m *ImageMock) DeleteImage(ctx context.Context, id string) error {
+	return models.ErrNotFound
+}
+
+func (m *ImageMock) SetPrimaryImage(ctx context.Context, id string) error {
+	return nil
+}
+
+func (m *ImageMock) ReorderImages(ctx context.Context, productID string, imageIDs []string) error {
+	if len(imageIDs) != 2 {
+		return models.ErrInvalidImageOrder
+	}
+	return nil
+}
+
+type SearchMock struct{}
+
+func (m *SearchMock) Search(ctx context.Context, q models.SearchQuery) (models.SearchResult, error) {
+	if q.Language == "klingon" {
+		return models.SearchResult{}, models.ErrUnknownLanguage
+	}
+	return models.SearchResult{Total: 7, Hits: []models.SearchHit{{
+		ProductDigest: models.ProductDigest{ID: "1", Name: "Glazed Donut", Price: money.New(1000, "RUB"), EffectivePrice: money.New(900, "RUB")},
+		Rank:          0.6,
+		NameHighlight: "Glazed <mark>Donut</mark>",
+	}}}, nil
+}
+
+func (m *SearchMock) FuzzySearch(ctx context.Context, q models.FuzzyQuery) (models.SearchResult, error) {
+	return models.SearchResult{Total: 1, Hits: []models.SearchHit{{
+		ProductDigest: models.ProductDigest{ID: "1", Name: "Glazed Donut", Price: money.New(1000, "RUB")},
+		Rank:          0.7,
+	}}}, nil
+}
+
+func (m *SearchMock) Suggest(ctx context.Context, query string, limit int) ([]string, error) {
+	return []string{"Glazed Donut"}, nil
+}
+
+func (m *SearchMock) SetProductSearchLanguage(ctx context.Context, productID, lang string) error {
+	return models.ErrNotFound
+}
+
+type ImportMock struct{}
+
+func (m *ImportMock) Import(ctx context.Context, format bulk.Format, r io.Reader, dryRun bool) (models.ImportReport, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return models.ImportReport{}, err
+	}
+	if format == bulk.CSV && !bytes.HasPrefix(data, []byte("name,")) {
+		return models.ImportReport{}, bulk.ErrBadHeader
+	}
+	lines := strings.Count(string(data), "\n")
+	return models.ImportReport{
+		Rows:    []models.ImportRowResult{{Line: 2, Name: "Glazed Donut", Status: models.ImportFailed, Error: "price must be greater than 0"}},
+		Created: lines - 2,
+		Failed:  1,
+		DryRun:  dryRun,
+	}, nil
+}
+
+// ExportMock пишет больше одного сообщения потока
+type ExportMock struct{}
+
+func (m *ExportMock) Export(ctx context.Context, f models.ExportFilter, format bulk.Format, columns []string, w io.Writer) (int, error) {
+	if f.CategoryID == "missing" {
+		return 0, models.ErrNotFound
+	}
+	if f.Currency == "XXX" {
+		return 0, fmt.Errorf("%w: %q", money.ErrUnknownCurrency, f.Currency)
+	}
+	bw, err := bulk.NewWriter(format, w, columns, bulk.Feed{})
+	if err != nil {
+		return 0, err
+	}
+	const n = 10000
+	for i := range n {
+		if err := bw.Write(bulk.Product{ID: strconv.Itoa(i), Name: "Donut " + strconv.Itoa(i), Description: strings.Repeat("tasty ", 10), Price: "1.00", Currency: "RUB"}); err != nil {
+			return 0, err
+		}
+	}
+	return n, bw.Close()
+}
+
+type ProductsMock struct{}
+
+func (m *ProductsMock) BatchGet(ctx context.Context, ids []string) ([]models.BatchItem, error) {
+	if len(ids) > 100 {
+		return nil, models.ErrTooManyIDs
+	}
+	items := make([]models.BatchItem, len(ids))
+	for i, id := range ids {
+		items[i] = models.BatchItem{ID: id}
+		if id != "missing" {
+			items[i].Found = true
+			items[i].Product = models.Product{Name: "Donut " + id, Price: money.New(1000, "RUB"), EffectivePrice: money.New(900, "RUB")}
+		}
+	}
+	return items, nil
+}
+
+func (m *ProductsMock) BulkUpdatePrices(ctx context.Context, updates []models.PriceUpdate, atomic bool) (models.BulkReport, error) {
+	report := models.BulkReport{Atomic: atomic}
+	for _, u := range updates {
+		res := models.BulkItemResult{ID: u.ProductID, Status: models.BulkApplied, OldPrice: money.New(1000, "RUB")}
+		switch {
+		case u.ChangeBP != 0:
+			res.NewPrice, _ = res.OldPrice.ChangeBy(u.ChangeBP)
+		case !u.Price.IsZero():
+			res.NewPrice = u.Price
+		default:
+			res.Status, res.Error = models.BulkFailed, "either price or change must be set"
+		}
+		report.Results = append(report.Results, res)
+	}
+	return report, nil
+}
+
+func (m *ProductsMock) BulkDelete(ctx context.Context, ids []string, atomic bool) (models.BulkReport, error) {
+	report := models.BulkReport{Atomic: atomic}
+	for _, id := range ids {
+		st := models.BulkApplied
+		if atomic {
+			st = models.BulkSkipped
+		}
+		report.Results = append(report.Results, models.BulkItemResult{ID: id, Status: st})
+	}
+	return report, nil
+}
+
+// GetBySlug: "ponchik" - старый слаг товара "shokoladnyi-ponchik"
+func (m *ProductsMock) GetBySlug(ctx context.Context, s string) (string, models.Product, error) {
+	switch s {
+	case "shokoladnyi-ponchik", "ponchik":
+		return "1", models.Product{
+			Name:           "Шоколадный пончик",
+			Slug:           "shokoladnyi-ponchik",
+			Price:          money.New(1000, "RUB"),
+			EffectivePrice: money.New(900, "RUB"),
+			Locale:         "ru",
+		}, nil
+	case "Ponchik":
+		return "", models.Product{}, models.ErrInvalidSlug
+	}
+	return "", models.Product{}, models.ErrNotFound
+}
+
+// SetStatus: товар "missing" не существует, остальные - черновики
+func (m *ProductsMock) SetStatus(ctx context.Context, id string, to models.ProductStatus) error {
+	switch {
+	case id == "missing":
+		return models.ErrNotFound
+	case to != models.StatusInReview:
+		return fmt.Errorf("%w: draft -> %s", models.ErrStatusTransition, to)
+	}
+	return nil
+}
+
+func (m *ProductsMock) SetSchedule(ctx context.Context, id string, s models.Schedule) error {
+	if !s.PublishAt.IsZero() && s.PublishAt.Before(time.Now()) {
+		return models.ErrScheduleInPast
+	}
+	return nil
+}
+
+type AuditMock struct{}
+
+func (m *AuditMock) ListAudit(ctx context.Context, f models.AuditFilter) ([]models.AuditEntry, error) {
+	if f.ProductID == "" && f.Actor == "" {
+		return nil, models.ErrAuditFilter
+	}
+	return []models.AuditEntry{{
+		ID:        7,
+		ProductID: f.ProductID,
+		Action:    models.AuditUpdate,
+		Actor:     "admin",
+		RequestID: "req-1",
+		Diff:      []byte(`{"name":{"old":"A","new":"B"}}`),
+		CreatedAt: time.Now(),
+	}}, nil
+}
+
+type TranslationMock struct{}
+
+func (m *TranslationMock) SetTranslation(ctx context.Context, productID string, t models.Translation) error {
+	if productID == "404" {
+		return models.ErrNotFound
+	}
+	if t.Locale == "ru" {
+		return models.ErrDefaultLocale
+	}
+	_, err := i18n.Canonical(t.Locale)
+	return err
+}
+
+func (m *TranslationMock) DeleteTranslation(ctx context.Context, productID, locale string) error {
+	if locale != "en" {
+		return models.ErrNotFound
 	}
 	return nil
 }
 
+func (m *TranslationMock) ListTranslations(ctx context.Context, productID string) ([]models.Translation, error) {
+	return []models.Translation{{Locale: "en", Name: "Donut", Description: "Delicious", UpdatedAt: time.Now()}}, nil
+}
+
+func (m *TranslationMock) ListMissingTranslations(ctx context.Context, locale string, limit, offset int) ([]models.UntranslatedProduct, error) {
+	return []models.UntranslatedProduct{{ID: "2", Name: "Эклер"}}, nil
+}
+
 // ----------------------------------------------------------------
 // 							TEST SECTION
 // ----------------------------------------------------------------
@@ -210,6 +802,15 @@ func (s *ServerSuite) TestGet() {
 			errCode:             codes.Internal,
 			errMsg:              models.ErrInternal.Error(),
 		},
+		{
+			name:                "Foreign Currency",
+			id:                  "usd",
+			expectedName:        "",
+			expectedPrice:       0,
+			expectedDescription: "",
+			errCode:             codes.FailedPrecondition,
+			errMsg:              "price is set in USD, use catalog.GRPCPricing to read it",
+		},
 	}
 	for _, tt := range tests {
 		s.T().Run(tt.name, func(t *testing.T) {
@@ -319,7 +920,7 @@ func (s *ServerSuite) TestUpdate() {
 		{
 			name:    "Happy",
 			id:      "1",
-			prod:    models.Product{Name: "Donut", Price: 1000, Description: "Tasty"},
+			prod:    models.Product{Name: "Donut", Price: money.New(1000, money.DefaultCurrency), Description: "Tasty"},
 			errCode: codes.OK,
 			errMsg:  "",
 		},
@@ -333,14 +934,22 @@ func (s *ServerSuite) TestUpdate() {
 		{
 			name:    "Not Found",
 			id:      "404",
-			prod:    models.Product{Name: "Donut", Price: 1000, Description: "Tasty"},
+			prod:    models.Product{Name: "Donut", Price: money.New(1000, money.DefaultCurrency), Description: "Tasty"},
 			errCode: codes.NotFound,
 			errMsg:  models.ErrNotFound.Error(),
 		},
+		{
+			// цена товара в долларах: рубли старого контракта ее не заменят
+			name:    "Foreign Currency",
+			id:      "usd",
+			prod:    models.Product{Name: "Donut", Price: money.New(1999, money.DefaultCurrency), Description: "Tasty"},
+			errCode: codes.FailedPrecondition,
+			errMsg:  models.ErrLegacyCurrency.Error(),
+		},
 		{
 			name:    "Internal",
 			id:      "500",
-			prod:    models.Product{Name: "Donut", Price: 1000, Description: "Tasty"},
+			prod:    models.Product{Name: "Donut", Price: money.New(1000, money.DefaultCurrency), Description: "Tasty"},
 			errCode: codes.Internal,
 			errMsg:  models.ErrInternal.Error(),
 		},
@@ -351,7 +960,7 @@ func (s *ServerSuite) TestUpdate() {
 				Id: tt.id,
 				Product: &product.Product{
 					Name:        tt.prod.Name,
-					Price:       int32(tt.prod.Price),
+					Price:       int32(tt.prod.Price.Amount),
 					Description: tt.prod.Description,
 				}})
 			er, _ := status.FromError(err)
@@ -360,3 +969,1498 @@ func (s *ServerSuite) TestUpdate() {
 		})
 	}
 }
+
+func (s *ServerSuite) TestHealth() {
+	client := healthpb.NewHealthClient(s.conn)
+	for _, service := range []string{"", product.GRPCProduct_ServiceDesc.ServiceName} {
+		resp, err := client.Check(s.ctx, &healthpb.HealthCheckRequest{Service: service})
+		s.Require().NoError(err)
+		s.Assert().Equal(healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+	}
+	_, err := client.Check(s.ctx, &healthpb.HealthCheckRequest{Service: "unknown"})
+	er, _ := status.FromError(err)
+	s.Assert().Equal(codes.NotFound, er.Code())
+}
+
+func TestReadyzFlipsOnShutdown(t *testing.T) {
+	var dbDown atomic.Bool
+	dbDown.Store(true)
+	srv := NewServer(&AppMock{},
+		WithHealthPort(8082),
+		WithHealthCheck("postgres", func(ctx context.Context) error {
+			if dbDown.Load() {
+				return models.ErrInternal
+			}
+			return nil
+		}),
+		WithCheckInterval(50*time.Millisecond),
+		WithDrainTimeout(300*time.Millisecond),
+	)
+	errs := make(chan error, 1)
+	go func() { errs <- srv.RunServer(8001) }()
+	time.Sleep(100 * time.Millisecond)
+
+	readyz := func() int {
+		resp, err := http.Get("http://127.0.0.1:8082/readyz")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	if code := readyz(); code != http.StatusServiceUnavailable {
+		t.Fatalf("readyz with failing dependency: got %d", code)
+	}
+	dbDown.Store(false)
+	time.Sleep(150 * time.Millisecond)
+	if code := readyz(); code != http.StatusOK {
+		t.Fatalf("readyz with healthy dependency: got %d", code)
+	}
+
+	shutdown := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		shutdown <- srv.Shutdown(ctx)
+	}()
+	time.Sleep(100 * time.Millisecond)
+	if code := readyz(); code != http.StatusServiceUnavailable {
+		t.Fatalf("readyz during drain: got %d", code)
+	}
+	if err := <-shutdown; err != nil {
+		t.Fatal(err)
+	}
+	if err := <-errs; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAuth(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	v, err := auth.NewVerifier(auth.WithHMACSecret(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	go NewServer(&AppMock{}, WithAuth(v, DefaultPolicy())).RunServer(8003)
+	time.Sleep(100 * time.Millisecond)
+	conn, err := grpc.NewClient("127.0.0.1:8003", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := product.NewGRPCProductClient(conn)
+
+	token := func(roles ...string) string {
+		s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, auth.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject:   "user-1",
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			},
+			Roles: roles,
+		}).SignedString(secret)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	withToken := func(tok string) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+tok)
+	}
+	donut := &product.Product{Name: "Tasty Donut", Price: 1000, Description: "Tasty"}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		call    func(ctx context.Context) error
+		errCode codes.Code
+	}{
+		{
+			name:    "Public Read Without Token",
+			ctx:     context.Background(),
+			call:    func(ctx context.Context) error { _, err := client.Get(ctx, &product.ID{Id: "1"}); return err },
+			errCode: codes.OK,
+		},
+		{
+			name:    "Public Read With Invalid Token",
+			ctx:     withToken("garbage"),
+			call:    func(ctx context.Context) error { _, err := client.Get(ctx, &product.ID{Id: "1"}); return err },
+			errCode: codes.Unauthenticated,
+		},
+		{
+			name:    "Write Without Token",
+			ctx:     context.Background(),
+			call:    func(ctx context.Context) error { _, err := client.Create(ctx, donut); return err },
+			errCode: codes.Unauthenticated,
+		},
+		{
+			name:    "Write Without Role",
+			ctx:     withToken(token("customer")),
+			call:    func(ctx context.Context) error { _, err := client.Create(ctx, donut); return err },
+			errCode: codes.PermissionDenied,
+		},
+		{
+			name:    "Write As Admin",
+			ctx:     withToken(token(auth.RoleCatalogAdmin)),
+			call:    func(ctx context.Context) error { _, err := client.Create(ctx, donut); return err },
+			errCode: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			er, _ := status.FromError(tt.call(tt.ctx))
+			if er.Code() != tt.errCode {
+				t.Fatalf("got %v, want %v", er.Code(), tt.errCode)
+			}
+		})
+	}
+}
+
+func TestMTLSRejectsClientWithoutCertificate(t *testing.T) {
+	dir := t.TempDir()
+	ca := tlstest.NewCA(t)
+	certFile, keyFile := ca.WriteFiles(t, dir, "product")
+	reloader, err := tlsutil.NewReloader(tlsutil.Config{
+		CertFile:     certFile,
+		KeyFile:      keyFile,
+		ClientCAFile: ca.WriteCA(t, dir),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go NewServer(&AppMock{}, WithTLS(reloader.ServerConfig())).RunServer(8004)
+	time.Sleep(100 * time.Millisecond)
+
+	conn, err := grpc.NewClient("127.0.0.1:8004",
+		grpc.WithTransportCredentials(credentials.NewTLS(ca.ClientConfig(t, ""))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	_, err = product.NewGRPCProductClient(conn).Get(context.Background(), &product.ID{Id: "1"})
+	if er, _ := status.FromError(err); er.Code() != codes.Unavailable {
+		t.Fatalf("got %v, want Unavailable", er.Code())
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	go NewServer(&AppMock{}, WithRateLimit(Limits{
+		Read:  RateLimit{Rate: 100, Burst: 100},
+		Write: RateLimit{Rate: 0.001, Burst: 1},
+		Methods: map[string]RateLimit{
+			product.GRPCProduct_GetAll_FullMethodName: {Rate: 0.5, Burst: 2},
+		},
+	})).RunServer(8005)
+	time.Sleep(100 * time.Millisecond)
+	conn, err := grpc.NewClient("127.0.0.1:8005", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := product.NewGRPCProductClient(conn)
+	ctx := context.Background()
+
+	for i := range 2 {
+		if _, err := client.GetAll(ctx, nil); err != nil {
+			t.Fatalf("GetAll #%d within burst: %v", i, err)
+		}
+	}
+	var header metadata.MD
+	_, err = client.GetAll(ctx, nil, grpc.Header(&header))
+	if er, _ := status.FromError(err); er.Code() != codes.ResourceExhausted {
+		t.Fatalf("GetAll over limit: got %v", er.Code())
+	}
+	if got := header.Get("retry-after"); len(got) != 1 || got[0] != "2" {
+		t.Fatalf("retry-after: got %v", got)
+	}
+
+	// у Get свой бакет (лимит чтения по умолчанию), GetAll его не расходует
+	if _, err := client.Get(ctx, &product.ID{Id: "1"}); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	donut := &product.Product{Name: "Tasty Donut", Price: 1000, Description: "Tasty"}
+	if _, err := client.Create(ctx, donut); err != nil {
+		t.Fatalf("first Create: %v", err)
+	}
+	_, err = client.Create(ctx, donut)
+	if er, _ := status.FromError(err); er.Code() != codes.ResourceExhausted {
+		t.Fatalf("second Create: got %v", er.Code())
+	}
+}
+
+func TestLoadShedding(t *testing.T) {
+	rl := newRateLimiter(Limits{MaxInFlight: 1})
+	interceptor := rl.unary()
+	info := &grpc.UnaryServerInfo{FullMethod: product.GRPCProduct_GetAll_FullMethodName}
+
+	started, finish := make(chan struct{}), make(chan struct{})
+	go interceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		close(started)
+		<-finish
+		return nil, nil
+	})
+	<-started
+
+	_, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		return nil, nil
+	})
+	if er, _ := status.FromError(err); er.Code() != codes.ResourceExhausted {
+		t.Fatalf("second concurrent request: got %v", er.Code())
+	}
+
+	// health-проба проходит даже при занятых слотах
+	probe := &grpc.UnaryServerInfo{FullMethod: healthpb.Health_Check_FullMethodName}
+	if _, err := interceptor(context.Background(), nil, probe, func(ctx context.Context, req any) (any, error) {
+		return nil, nil
+	}); err != nil {
+		t.Fatalf("health check under load: %v", err)
+	}
+	close(finish)
+}
+
+func TestREST(t *testing.T) {
+	go NewServer(&AppMock{}, WithRESTPort(8086)).RunServer(8006)
+	time.Sleep(100 * time.Millisecond)
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		body        string
+		code        int
+		contentType string
+		detail      string
+	}{
+		{"List", http.MethodGet, "/products", "", http.StatusOK, "application/json", ""},
+		{"Get", http.MethodGet, "/products/1", "", http.StatusOK, "application/json", ""},
+		{"Get Not Found", http.MethodGet, "/products/404", "", http.StatusNotFound, "application/problem+json", "404 not found"},
+		{"Get Internal", http.MethodGet, "/products/500", "", http.StatusInternalServerError, "application/problem+json", models.ErrInternal.Error()},
+		{"Create", http.MethodPost, "/products", `{"name":"Tasty Donut","description":"Tasty","price":1000}`, http.StatusCreated, "application/json", ""},
+		{"Create Invalid", http.MethodPost, "/products", `{"name":"Tasty Donut"}`, http.StatusBadRequest, "application/problem+json", "name, price and description are required, price must be greater than 0"},
+		{"Create Conflict", http.MethodPost, "/products", `{"name":"Donut","description":"Tasty","price":1000}`, http.StatusConflict, "application/problem+json", "product with the same name already exists: Donut"},
+		{"Create Bad JSON", http.MethodPost, "/products", `{"name":`, http.StatusBadRequest, "application/problem+json", ""},
+		{"Put", http.MethodPut, "/products/1", `{"name":"Donut","description":"Tasty","price":1000}`, http.StatusNoContent, "", ""},
+		{"Put Foreign Currency", http.MethodPut, "/products/usd", `{"name":"Donut","description":"Tasty","price":1999}`, http.StatusBadRequest, "application/problem+json", models.ErrLegacyCurrency.Error()},
+		{"Patch", http.MethodPatch, "/products/1", `{"price":1200}`, http.StatusNoContent, "", ""},
+		{"Patch Invalid", http.MethodPatch, "/products/1", `{"price":-1}`, http.StatusBadRequest, "application/problem+json", ""},
+		{"Delete", http.MethodDelete, "/products/1", "", http.StatusNoContent, "", ""},
+		{"Delete Not Found", http.MethodDelete, "/products/404", "", http.StatusNotFound, "application/problem+json", models.ErrNotFound.Error()},
+		{"OpenAPI", http.MethodGet, "/openapi.json", "", http.StatusOK, "application/json", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, "http://127.0.0.1:8086"+tt.path, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tt.code {
+				t.Fatalf("status: got %d, want %d", resp.StatusCode, tt.code)
+			}
+			if ct := resp.Header.Get("Content-Type"); ct != tt.contentType {
+				t.Fatalf("content type: got %q, want %q", ct, tt.contentType)
+			}
+			if tt.contentType != "application/problem+json" {
+				return
+			}
+			var p problem
+			if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
+				t.Fatal(err)
+			}
+			if p.Status != tt.code || p.Instance != tt.path {
+				t.Fatalf("unexpected problem %+v", p)
+			}
+			if tt.detail != "" && p.Detail != tt.detail {
+				t.Fatalf("detail: got %q, want %q", p.Detail, tt.detail)
+			}
+		})
+	}
+}
+
+func TestCategories(t *testing.T) {
+	go NewServer(&AppMock{}, WithCategories(&CategoryMock{})).RunServer(8007)
+	time.Sleep(100 * time.Millisecond)
+	conn, err := grpc.NewClient("127.0.0.1:8007", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := catalog.NewGRPCCategoryClient(conn)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		call    func() error
+		errCode codes.Code
+	}{
+		{"Create", func() error {
+			_, err := client.Create(ctx, &catalog.Category{Name: "Eclairs", Slug: "eclairs"})
+			return err
+		}, codes.OK},
+		{"Create Bad Slug", func() error {
+			_, err := client.Create(ctx, &catalog.Category{Name: "Eclairs", Slug: "Éclairs!"})
+			return err
+		}, codes.InvalidArgument},
+		{"Create Duplicate Slug", func() error {
+			_, err := client.Create(ctx, &catalog.Category{Name: "Donuts", Slug: "donuts"})
+			return err
+		}, codes.AlreadyExists},
+		{"Create Missing Parent", func() error {
+			_, err := client.Create(ctx, &catalog.Category{ParentId: "x", Name: "Orphan", Slug: "orphan"})
+			return err
+		}, codes.NotFound},
+		{"Get Not Found", func() error {
+			_, err := client.Get(ctx, &catalog.CategoryID{Id: "404"})
+			return err
+		}, codes.NotFound},
+		{"Update Cycle", func() error {
+			_, err := client.Update(ctx, &catalog.Category{Id: "1", ParentId: "child", Name: "Bakery", Slug: "bakery"})
+			return err
+		}, codes.InvalidArgument},
+		{"Delete With Children", func() error {
+			_, err := client.Delete(ctx, &catalog.CategoryID{Id: "parent"})
+			return err
+		}, codes.FailedPrecondition},
+		{"List Products Not Found", func() error {
+			_, err := client.ListProducts(ctx, &catalog.ListProductsRequest{CategoryId: "404"})
+			return err
+		}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			er, _ := status.FromError(tt.call())
+			if er.Code() != tt.errCode {
+				t.Fatalf("got %v (%s), want %v", er.Code(), er.Message(), tt.errCode)
+			}
+		})
+	}
+
+	tree, err := client.Tree(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tree.GetRoots()) != 1 || tree.GetRoots()[0].GetChildren()[0].GetCategory().GetSlug() != "donuts" {
+		t.Fatalf("unexpected tree %v", tree)
+	}
+	prods, err := client.ListProducts(ctx, &catalog.ListProductsRequest{CategoryId: "1", IncludeDescendants: true})
+	if err != nil || len(prods.GetProducts()) != 1 {
+		t.Fatalf("list products: %v %v", prods, err)
+	}
+}
+
+func TestAttributes(t *testing.T) {
+	mock := &AttributeMock{}
+	go NewServer(&AppMock{}, WithAttributes(mock)).RunServer(8008)
+	time.Sleep(100 * time.Millisecond)
+	conn, err := grpc.NewClient("127.0.0.1:8008", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := catalog.NewGRPCAttributeClient(conn)
+	ctx := context.Background()
+	str := func(v string) *catalog.AttributeValue {
+		return &catalog.AttributeValue{Kind: &catalog.AttributeValue_StringValue{StringValue: v}}
+	}
+
+	tests := []struct {
+		name    string
+		call    func() error
+		errCode codes.Code
+	}{
+		{"Define", func() error {
+			_, err := client.Define(ctx, &catalog.AttributeDefinition{CategoryId: "1", Code: "weight", Name: "Weight", Type: catalog.AttributeType_ATTRIBUTE_TYPE_NUMBER})
+			return err
+		}, codes.OK},
+		{"Define Bad Code", func() error {
+			_, err := client.Define(ctx, &catalog.AttributeDefinition{CategoryId: "1", Code: "Weight!", Name: "Weight", Type: catalog.AttributeType_ATTRIBUTE_TYPE_NUMBER})
+			return err
+		}, codes.InvalidArgument},
+		{"Define No Type", func() error {
+			_, err := client.Define(ctx, &catalog.AttributeDefinition{CategoryId: "1", Code: "weight", Name: "Weight"})
+			return err
+		}, codes.InvalidArgument},
+		{"Define Enum Without Values", func() error {
+			_, err := client.Define(ctx, &catalog.AttributeDefinition{CategoryId: "1", Code: "size", Name: "Size", Type: catalog.AttributeType_ATTRIBUTE_TYPE_ENUM})
+			return err
+		}, codes.InvalidArgument},
+		{"Define Duplicate", func() error {
+			_, err := client.Define(ctx, &catalog.AttributeDefinition{CategoryId: "1", Code: "color", Name: "Color", Type: catalog.AttributeType_ATTRIBUTE_TYPE_ENUM, EnumValues: []string{"red"}})
+			return err
+		}, codes.AlreadyExists},
+		{"Set Undefined Attribute", func() error {
+			_, err := client.SetProductAttributes(ctx, &catalog.ProductAttributes{ProductId: "1", Values: map[string]*catalog.AttributeValue{"size": str("XL")}})
+			return err
+		}, codes.InvalidArgument},
+		{"Set Empty Value", func() error {
+			_, err := client.SetProductAttributes(ctx, &catalog.ProductAttributes{ProductId: "1", Values: map[string]*catalog.AttributeValue{"color": {}}})
+			return err
+		}, codes.InvalidArgument},
+		{"Filter Without Condition", func() error {
+			_, err := client.Filter(ctx, &catalog.FilterRequest{Filters: []*catalog.AttributeFilter{{Code: "color"}}})
+			return err
+		}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			er, _ := status.FromError(tt.call())
+			if er.Code() != tt.errCode {
+				t.Fatalf("got %v (%s), want %v", er.Code(), er.Message(), tt.errCode)
+			}
+		})
+	}
+
+	list, err := client.List(ctx, &catalog.CategoryID{Id: "2"})
+	if err != nil || list.GetDefinitions()[0].GetType() != catalog.AttributeType_ATTRIBUTE_TYPE_ENUM {
+		t.Fatalf("list: %v %v", list, err)
+	}
+	attrs, err := client.GetProductAttributes(ctx, &catalog.ProductRef{ProductId: "1"})
+	if err != nil || attrs.GetValues()["weight"].GetNumberValue() != 1.5 || !attrs.GetValues()["vegan"].GetBoolValue() {
+		t.Fatalf("get attributes: %v %v", attrs, err)
+	}
+
+	lo := 1.0
+	resp, err := client.Filter(ctx, &catalog.FilterRequest{
+		CategoryId: "1",
+		Filters: []*catalog.AttributeFilter{
+			{Code: "color", AnyOf: []*catalog.AttributeValue{str("red"), str("green")}},
+			{Code: "weight", Min: &lo},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.GetProducts()) != 1 || len(resp.GetFacets()) != 2 {
+		t.Fatalf("unexpected filter response %v", resp)
+	}
+	if resp.GetFacets()[0].GetValues()[0].GetCount() != 1 || resp.GetFacets()[1].GetMax() != 2 {
+		t.Fatalf("unexpected facets %v", resp.GetFacets())
+	}
+	f := mock.filter
+	if len(f.Attributes) != 2 || len(f.Attributes[0].AnyOf) != 2 || *f.Attributes[1].Min != 1 || f.Attributes[1].Max != nil {
+		t.Fatalf("unexpected filter passed to app %+v", f)
+	}
+}
+
+func TestVariants(t *testing.T) {
+	go NewServer(&AppMock{}, WithVariants(&VariantMock{})).RunServer(8009)
+	time.Sleep(100 * time.Millisecond)
+	conn, err := grpc.NewClient("127.0.0.1:8009", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := catalog.NewGRPCVariantClient(conn)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		variant *catalog.Variant
+		errCode codes.Code
+	}{
+		{"Valid", &catalog.Variant{ProductId: "1", Sku: "TS-RED-L", Barcode: "4006381333931", Options: map[string]string{"size": "L"}}, codes.OK},
+		{"No Product", &catalog.Variant{Sku: "TS-RED-L"}, codes.InvalidArgument},
+		{"Bad SKU", &catalog.Variant{ProductId: "1", Sku: "ts red l"}, codes.InvalidArgument},
+		{"Bad Barcode Checksum", &catalog.Variant{ProductId: "1", Sku: "TS-RED-L", Barcode: "4006381333932"}, codes.InvalidArgument},
+		{"Negative Price", &catalog.Variant{ProductId: "1", Sku: "TS-RED-L", PriceOverride: -1}, codes.InvalidArgument},
+		{"Duplicate SKU", &catalog.Variant{ProductId: "1", Sku: "TS-RED-M"}, codes.AlreadyExists},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.Create(ctx, tt.variant)
+			er, _ := status.FromError(err)
+			if er.Code() != tt.errCode {
+				t.Fatalf("got %v (%s), want %v", er.Code(), er.Message(), tt.errCode)
+			}
+		})
+	}
+
+	v, err := client.GetBySKU(ctx, &catalog.VariantSKU{Sku: "TS-RED-M"})
+	if err != nil || v.GetPrice() != 1500 || v.GetOptions()["size"] != "M" {
+		t.Fatalf("get by sku: %v %v", v, err)
+	}
+	_, err = client.GetBySKU(ctx, &catalog.VariantSKU{Sku: "NOPE"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("got %v, want NotFound", err)
+	}
+}
+
+func TestInventory(t *testing.T) {
+	go NewServer(&AppMock{}, WithInventory(&InventoryMock{stock: map[string]int{}})).RunServer(8010)
+	time.Sleep(100 * time.Millisecond)
+	conn, err := grpc.NewClient("127.0.0.1:8010", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := catalog.NewGRPCInventoryClient(conn)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		req     *catalog.StockAdjustment
+		want    int32
+		errCode codes.Code
+	}{
+		{"Receive", &catalog.StockAdjustment{ProductId: "1", Location: "main", Delta: 3}, 3, codes.OK},
+		{"Ship", &catalog.StockAdjustment{ProductId: "1", Location: "main", Delta: -2}, 1, codes.OK},
+		{"Oversell", &catalog.StockAdjustment{ProductId: "1", Location: "main", Delta: -2}, 0, codes.FailedPrecondition},
+		{"Zero Delta", &catalog.StockAdjustment{ProductId: "1", Delta: 0}, 0, codes.InvalidArgument},
+		{"Unknown Product", &catalog.StockAdjustment{ProductId: "404", Delta: 1}, 0, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lvl, err := client.Adjust(ctx, tt.req)
+			er, _ := status.FromError(err)
+			if er.Code() != tt.errCode {
+				t.Fatalf("got %v (%s), want %v", er.Code(), er.Message(), tt.errCode)
+			}
+			if lvl.GetQuantity() != tt.want {
+				t.Fatalf("got quantity %d, want %d", lvl.GetQuantity(), tt.want)
+			}
+		})
+	}
+
+	stock, err := client.Get(ctx, &catalog.ProductRef{ProductId: "1"})
+	if err != nil || stock.GetTotal() != 1 || !stock.GetAvailable() || !stock.GetLowStock() {
+		t.Fatalf("get stock: %v %v", stock, err)
+	}
+	ledger, err := client.Ledger(ctx, &catalog.LedgerRequest{ProductId: "1"})
+	if err != nil || len(ledger.GetEntries()) != 1 || ledger.GetEntries()[0].GetCreatedAt() == nil {
+		t.Fatalf("ledger: %v %v", ledger, err)
+	}
+}
+
+func TestReservations(t *testing.T) {
+	go NewServer(&AppMock{}, WithReservations(&ReservationMock{})).RunServer(8011)
+	time.Sleep(100 * time.Millisecond)
+	conn, err := grpc.NewClient("127.0.0.1:8011", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := catalog.NewGRPCReservationClient(conn)
+	ctx := context.Background()
+	items := []*catalog.ReservationItem{{ProductId: "1", Quantity: 2}}
+
+	tests := []struct {
+		name    string
+		call    func() error
+		errCode codes.Code
+	}{
+		{"Reserve", func() error {
+			_, err := client.Reserve(ctx, &catalog.ReserveRequest{ReservationId: "order-1", Items: items, Ttl: durationpb.New(5 * time.Minute)})
+			return err
+		}, codes.OK},
+		{"Reserve Without Items", func() error {
+			_, err := client.Reserve(ctx, &catalog.ReserveRequest{ReservationId: "order-1"})
+			return err
+		}, codes.InvalidArgument},
+		{"Reserve Zero Quantity", func() error {
+			_, err := client.Reserve(ctx, &catalog.ReserveRequest{ReservationId: "order-1", Items: []*catalog.ReservationItem{{ProductId: "1"}}})
+			return err
+		}, codes.InvalidArgument},
+		{"Reserve Too Long", func() error {
+			_, err := client.Reserve(ctx, &catalog.ReserveRequest{ReservationId: "order-1", Items: items, Ttl: durationpb.New(48 * time.Hour)})
+			return err
+		}, codes.InvalidArgument},
+		{"Reserve Oversell", func() error {
+			_, err := client.Reserve(ctx, &catalog.ReserveRequest{ReservationId: "oversell", Items: items})
+			return err
+		}, codes.FailedPrecondition},
+		{"Reserve Reused ID", func() error {
+			_, err := client.Reserve(ctx, &catalog.ReserveRequest{ReservationId: "reused", Items: items})
+			return err
+		}, codes.AlreadyExists},
+		{"Commit Expired", func() error {
+			_, err := client.Commit(ctx, &catalog.ReservationID{Id: "late"})
+			return err
+		}, codes.FailedPrecondition},
+		{"Release Committed", func() error {
+			_, err := client.Release(ctx, &catalog.ReservationID{Id: "shipped"})
+			return err
+		}, codes.FailedPrecondition},
+		{"Get Unknown", func() error {
+			_, err := client.Get(ctx, &catalog.ReservationID{Id: "nope"})
+			return err
+		}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			er, _ := status.FromError(tt.call())
+			if er.Code() != tt.errCode {
+				t.Fatalf("got %v (%s), want %v", er.Code(), er.Message(), tt.errCode)
+			}
+		})
+	}
+
+	res, err := client.Commit(ctx, &catalog.ReservationID{Id: "order-1"})
+	if err != nil || res.GetStatus() != catalog.ReservationStatus_RESERVATION_STATUS_COMMITTED {
+		t.Fatalf("commit: %v %v", res, err)
+	}
+}
+
+func TestPricing(t *testing.T) {
+	go NewServer(&AppMock{}, WithPricing(&PricingMock{})).RunServer(8012)
+	time.Sleep(100 * time.Millisecond)
+	conn, err := grpc.NewClient("127.0.0.1:8012", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := catalog.NewGRPCPricingClient(conn)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		call    func() error
+		errCode codes.Code
+	}{
+		{"Set Price", func() error {
+			_, err := client.SetPrice(ctx, &catalog.ProductPrice{ProductId: "1", Price: &catalog.Money{Amount: 1500, Currency: "USD"}})
+			return err
+		}, codes.OK},
+		{"Set Price Unknown Currency", func() error {
+			_, err := client.SetPrice(ctx, &catalog.ProductPrice{ProductId: "1", Price: &catalog.Money{Amount: 1500, Currency: "usd"}})
+			return err
+		}, codes.InvalidArgument},
+		{"Set Zero Price", func() error {
+			_, err := client.SetBasePrice(ctx, &catalog.ProductPrice{ProductId: "1", Price: &catalog.Money{Currency: "EUR"}})
+			return err
+		}, codes.InvalidArgument},
+		{"Set Price Unknown Product", func() error {
+			_, err := client.SetPrice(ctx, &catalog.ProductPrice{ProductId: "404", Price: &catalog.Money{Amount: 1500, Currency: "USD"}})
+			return err
+		}, codes.NotFound},
+		{"Delete Missing Price", func() error {
+			_, err := client.DeletePrice(ctx, &catalog.ProductPriceRef{ProductId: "1", Currency: "EUR"})
+			return err
+		}, codes.NotFound},
+		{"Quote Without Rate", func() error {
+			_, err := client.Quote(ctx, &catalog.ProductPriceRef{ProductId: "1", Currency: "JPY"})
+			return err
+		}, codes.FailedPrecondition},
+		{"Set Rate", func() error {
+			_, err := client.SetRate(ctx, &catalog.CurrencyRate{Base: "RUB", Quote: "USD", Rate: "0.0125", Rounding: catalog.Rounding_ROUNDING_HALF_EVEN})
+			return err
+		}, codes.OK},
+		{"Set Rate Not A Number", func() error {
+			_, err := client.SetRate(ctx, &catalog.CurrencyRate{Base: "RUB", Quote: "USD", Rate: "cheap"})
+			return err
+		}, codes.InvalidArgument},
+		{"Set Rate Same Currency", func() error {
+			_, err := client.SetRate(ctx, &catalog.CurrencyRate{Base: "RUB", Quote: "RUB", Rate: "1"})
+			return err
+		}, codes.InvalidArgument},
+		{"Schedule Price", func() error {
+			_, err := client.SchedulePrice(ctx, &catalog.ScheduledPrice{ProductId: "1", Price: &catalog.Money{Amount: 900, Currency: "RUB"}, EffectiveFrom: timestamppb.New(time.Now().Add(time.Hour))})
+			return err
+		}, codes.OK},
+		{"Schedule Price In Past", func() error {
+			_, err := client.SchedulePrice(ctx, &catalog.ScheduledPrice{ProductId: "1", Price: &catalog.Money{Amount: 900, Currency: "RUB"}, EffectiveFrom: timestamppb.New(time.Now().Add(-time.Hour))})
+			return err
+		}, codes.InvalidArgument},
+		{"Schedule Price Without Time", func() error {
+			_, err := client.SchedulePrice(ctx, &catalog.ScheduledPrice{ProductId: "1", Price: &catalog.Money{Amount: 900, Currency: "RUB"}})
+			return err
+		}, codes.InvalidArgument},
+		{"Cancel Applied Price", func() error {
+			_, err := client.CancelScheduledPrice(ctx, &catalog.PriceChangeID{Id: 2})
+			return err
+		}, codes.NotFound},
+		{"Price At Without Time", func() error {
+			_, err := client.PriceAt(ctx, &catalog.PriceAtRequest{ProductId: "1"})
+			return err
+		}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			er, _ := status.FromError(tt.call())
+			if er.Code() != tt.errCode {
+				t.Fatalf("got %v (%s), want %v", er.Code(), er.Message(), tt.errCode)
+			}
+		})
+	}
+
+	q, err := client.Quote(ctx, &catalog.ProductPriceRef{ProductId: "1", Currency: "USD"})
+	if err != nil || q.GetPrice().GetAmount() != 13 || q.GetSource() != catalog.PriceSource_PRICE_SOURCE_CONVERTED || q.GetRate() != "0.0125" {
+		t.Fatalf("quote: %v %v", q, err)
+	}
+	rates, err := client.ListRates(ctx, &emptypb.Empty{})
+	if err != nil || len(rates.GetRates()) != 1 || rates.GetRates()[0].GetRounding() != catalog.Rounding_ROUNDING_HALF_EVEN {
+		t.Fatalf("rates: %v %v", rates, err)
+	}
+	hist, err := client.History(ctx, &catalog.PriceHistoryRequest{ProductId: "1"})
+	if err != nil || len(hist.GetChanges()) != 3 {
+		t.Fatalf("history: %v %v", hist, err)
+	}
+	if ch := hist.GetChanges(); !ch[0].GetScheduled() || ch[1].GetScheduled() || ch[1].EffectiveTo != nil || ch[2].EffectiveTo == nil {
+		t.Fatalf("unexpected history: %v", ch)
+	}
+}
+
+func TestPromotions(t *testing.T) {
+	go NewServer(&AppMock{}, WithPromotions(&PromotionMock{})).RunServer(8013)
+	time.Sleep(100 * time.Millisecond)
+	conn, err := grpc.NewClient("127.0.0.1:8013", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := catalog.NewGRPCPromotionClient(conn)
+	ctx := context.Background()
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		promo   *catalog.Promotion
+		errCode codes.Code
+	}{
+		{"Percent On Category", &catalog.Promotion{Name: "Donut week", Kind: catalog.PromotionKind_PROMOTION_KIND_PERCENT, Percent: 1500, Target: catalog.PromotionTarget_PROMOTION_TARGET_CATEGORY, TargetId: "donuts", StartsAt: timestamppb.New(now), EndsAt: timestamppb.New(now.Add(7 * 24 * time.Hour))}, codes.OK},
+		{"Fixed On All", &catalog.Promotion{Name: "Black Friday", Kind: catalog.PromotionKind_PROMOTION_KIND_FIXED, Amount: &catalog.Money{Amount: 500, Currency: "RUB"}, Target: catalog.PromotionTarget_PROMOTION_TARGET_ALL}, codes.OK},
+		{"Without Kind", &catalog.Promotion{Name: "?", Percent: 1500, Target: catalog.PromotionTarget_PROMOTION_TARGET_ALL}, codes.InvalidArgument},
+		{"Percent Over 100", &catalog.Promotion{Name: "Free", Kind: catalog.PromotionKind_PROMOTION_KIND_PERCENT, Percent: 10001, Target: catalog.PromotionTarget_PROMOTION_TARGET_ALL}, codes.InvalidArgument},
+		{"Fixed With Percent", &catalog.Promotion{Name: "Mixed", Kind: catalog.PromotionKind_PROMOTION_KIND_FIXED, Percent: 10, Amount: &catalog.Money{Amount: 500, Currency: "RUB"}, Target: catalog.PromotionTarget_PROMOTION_TARGET_ALL}, codes.InvalidArgument},
+		{"Product Without ID", &catalog.Promotion{Name: "Donut", Kind: catalog.PromotionKind_PROMOTION_KIND_PERCENT, Percent: 10, Target: catalog.PromotionTarget_PROMOTION_TARGET_PRODUCT}, codes.InvalidArgument},
+		{"Ends Before Start", &catalog.Promotion{Name: "Late", Kind: catalog.PromotionKind_PROMOTION_KIND_PERCENT, Percent: 10, Target: catalog.PromotionTarget_PROMOTION_TARGET_ALL, StartsAt: timestamppb.New(now), EndsAt: timestamppb.New(now.Add(-time.Hour))}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.Create(ctx, tt.promo)
+			if er, _ := status.FromError(err); er.Code() != tt.errCode {
+				t.Fatalf("got %v (%s), want %v", er.Code(), er.Message(), tt.errCode)
+			}
+		})
+	}
+
+	_, err = client.Update(ctx, &catalog.Promotion{Id: "nope", Name: "Black Friday", Kind: catalog.PromotionKind_PROMOTION_KIND_PERCENT, Percent: 10, Target: catalog.PromotionTarget_PROMOTION_TARGET_ALL})
+	if er, _ := status.FromError(err); er.Code() != codes.NotFound {
+		t.Fatalf("update: got %v, want %v", er.Code(), codes.NotFound)
+	}
+	p, err := client.Get(ctx, &catalog.PromotionID{Id: "p1"})
+	if err != nil || p.GetKind() != catalog.PromotionKind_PROMOTION_KIND_FIXED || p.GetAmount().GetAmount() != 500 || p.GetStartsAt() != nil {
+		t.Fatalf("get: %v %v", p, err)
+	}
+	price, err := client.GetPrice(ctx, &catalog.ProductRef{ProductId: "1"})
+	if err != nil || price.GetListPrice().GetAmount() != 1000 || price.GetPrice().GetAmount() != 900 || len(price.GetPromotionIds()) != 1 {
+		t.Fatalf("price: %v %v", price, err)
+	}
+}
+
+func TestImages(t *testing.T) {
+	mock := &ImageMock{}
+	go NewServer(&AppMock{}, WithImages(mock)).RunServer(8014)
+	time.Sleep(100 * time.Millisecond)
+	conn, err := grpc.NewClient("127.0.0.1:8014", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := catalog.NewGRPCImageClient(conn)
+	ctx := context.Background()
+
+	info := func(productID, contentType string) *catalog.ImageUploadChunk {
+		return &catalog.ImageUploadChunk{Data: &catalog.ImageUploadChunk_Info{Info: &catalog.ImageUploadInfo{ProductId: productID, ContentType: contentType}}}
+	}
+	chunk := func(b string) *catalog.ImageUploadChunk {
+		return &catalog.ImageUploadChunk{Data: &catalog.ImageUploadChunk_Chunk{Chunk: []byte(b)}}
+	}
+	tests := []struct {
+		name    string
+		msgs    []*catalog.ImageUploadChunk
+		errCode codes.Code
+	}{
+		{"Chunked", []*catalog.ImageUploadChunk{info("1", "image/png"), chunk("ab"), chunk(""), chunk("cde")}, codes.OK},
+		{"Unknown Product", []*catalog.ImageUploadChunk{info("2", ""), chunk("ab")}, codes.NotFound},
+		{"Without Info", []*catalog.ImageUploadChunk{chunk("ab")}, codes.InvalidArgument},
+		{"Without Product", []*catalog.ImageUploadChunk{info("", ""), chunk("ab")}, codes.InvalidArgument},
+		{"Unsupported Type", []*catalog.ImageUploadChunk{info("1", "image/webp"), chunk("ab")}, codes.InvalidArgument},
+		{"Info Twice", []*catalog.ImageUploadChunk{info("1", ""), chunk("ab"), info("1", "")}, codes.InvalidArgument},
+		{"Empty Stream", nil, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, err := client.Upload(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, m := range tt.msgs {
+				if err := stream.Send(m); err != nil {
+					break // сервер уже ответил ошибкой, ее вернет CloseAndRecv
+				}
+			}
+			img, err := stream.CloseAndRecv()
+			if er, _ := status.FromError(err); er.Code() != tt.errCode {
+				t.Fatalf("got %v (%s), want %v", er.Code(), er.Message(), tt.errCode)
+			}
+			if tt.errCode == codes.OK && (img.GetSize() != 5 || !img.GetPrimary() || len(img.GetThumbnails()) != 1) {
+				t.Fatalf("unexpected image: %v", img)
+			}
+		})
+	}
+	mock.mu.Lock()
+	if string(mock.received) != "abcde" {
+		t.Fatalf("received %q", mock.received)
+	}
+	mock.mu.Unlock()
+
+	list, err := client.List(ctx, &catalog.ProductRef{ProductId: "1"})
+	if err != nil || len(list.GetImages()) != 2 || list.GetImages()[1].GetPosition() != 1 {
+		t.Fatalf("list: %v %v", list, err)
+	}
+	_, err = client.Reorder(ctx, &catalog.ImageOrder{ProductId: "1", ImageIds: []string{"img2"}})
+	if er, _ := status.FromError(err); er.Code() != codes.InvalidArgument {
+		t.Fatalf("reorder: got %v, want %v", er.Code(), codes.InvalidArgument)
+	}
+	_, err = client.Delete(ctx, &catalog.ImageID{Id: "nope"})
+	if er, _ := status.FromError(err); er.Code() != codes.NotFound {
+		t.Fatalf("delete: got %v, want %v", er.Code(), codes.NotFound)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	go NewServer(&AppMock{}, WithSearch(&SearchMock{})).RunServer(8015)
+	time.Sleep(100 * time.Millisecond)
+	conn, err := grpc.NewClient("127.0.0.1:8015", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := catalog.NewGRPCSearchClient(conn)
+	ctx := context.Background()
+	price := func(n int64) *int64 { return &n }
+
+	tests := []struct {
+		name    string
+		req     *catalog.SearchRequest
+		errCode codes.Code
+	}{
+		{"Valid", &catalog.SearchRequest{Query: "donut", Prefix: true, MinPrice: price(0), MaxPrice: price(5000), Currency: "RUB"}, codes.OK},
+		{"Empty Query", &catalog.SearchRequest{}, codes.InvalidArgument},
+		{"Too Long Query", &catalog.SearchRequest{Query: strings.Repeat("a", maxQueryLength+1)}, codes.InvalidArgument},
+		{"Min Above Max", &catalog.SearchRequest{Query: "donut", MinPrice: price(500), MaxPrice: price(100)}, codes.InvalidArgument},
+		{"Negative Price", &catalog.SearchRequest{Query: "donut", MinPrice: price(-1)}, codes.InvalidArgument},
+		{"Unknown Currency", &catalog.SearchRequest{Query: "donut", Currency: "XXX"}, codes.InvalidArgument},
+		{"Unknown Language", &catalog.SearchRequest{Query: "donut", Language: "klingon"}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := client.Search(ctx, tt.req)
+			if er, _ := status.FromError(err); er.Code() != tt.errCode {
+				t.Fatalf("got %v (%s), want %v", er.Code(), er.Message(), tt.errCode)
+			}
+			if tt.errCode != codes.OK {
+				return
+			}
+			if resp.GetTotal() != 7 || len(resp.GetHits()) != 1 {
+				t.Fatalf("unexpected response: %v", resp)
+			}
+			hit := resp.GetHits()[0]
+			if hit.GetProduct().GetEffectivePrice() != 900 || hit.GetNameHighlight() != "Glazed <mark>Donut</mark>" {
+				t.Fatalf("unexpected hit: %v", hit)
+			}
+		})
+	}
+
+	_, err = client.SetProductLanguage(ctx, &catalog.ProductLanguage{ProductId: "nope", Language: "english"})
+	if er, _ := status.FromError(err); er.Code() != codes.NotFound {
+		t.Fatalf("set language: got %v, want %v", er.Code(), codes.NotFound)
+	}
+
+	resp, err := client.Fuzzy(ctx, &catalog.FuzzySearchRequest{Query: "donutt"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.GetHits()) != 1 || resp.GetHits()[0].GetRank() != 0.7 {
+		t.Fatalf("unexpected fuzzy response: %v", resp)
+	}
+	_, err = client.Fuzzy(ctx, &catalog.FuzzySearchRequest{Query: "donutt", Threshold: 2})
+	if er, _ := status.FromError(err); er.Code() != codes.InvalidArgument {
+		t.Fatalf("fuzzy threshold: got %v, want %v", er.Code(), codes.InvalidArgument)
+	}
+	sugg, err := client.Suggest(ctx, &catalog.SuggestRequest{Query: "glazd"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sugg.GetNames()) != 1 || sugg.GetNames()[0] != "Glazed Donut" {
+		t.Fatalf("unexpected suggestions: %v", sugg)
+	}
+
+	// похожее название не мешает созданию, но возвращается в заголовке
+	var header metadata.MD
+	_, err = product.NewGRPCProductClient(conn).Create(ctx, &product.Product{Name: "Glazed Donuts", Price: 100, Description: "Tasty"}, grpc.Header(&header))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := header.Get(similarProductsHeader); len(ids) != 1 || ids[0] != "1" {
+		t.Fatalf("similar products header: %v", ids)
+	}
+}
+
+func TestImport(t *testing.T) {
+	go NewServer(&AppMock{}, WithImport(&ImportMock{})).RunServer(8016)
+	time.Sleep(100 * time.Millisecond)
+	conn, err := grpc.NewClient("127.0.0.1:8016", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := catalog.NewGRPCImportClient(conn)
+	ctx := context.Background()
+
+	upload := func(msgs ...*catalog.ImportChunk) (*catalog.ImportReport, error) {
+		stream, err := client.Import(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range msgs {
+			if err := stream.Send(m); err != nil {
+				break
+			}
+		}
+		return stream.CloseAndRecv()
+	}
+	options := func(f catalog.ImportFormat) *catalog.ImportChunk {
+		return &catalog.ImportChunk{Data: &catalog.ImportChunk_Options{Options: &catalog.ImportOptions{Format: f, DryRun: true}}}
+	}
+	chunk := func(s string) *catalog.ImportChunk {
+		return &catalog.ImportChunk{Data: &catalog.ImportChunk_Chunk{Chunk: []byte(s)}}
+	}
+
+	report, err := upload(options(catalog.ImportFormat_IMPORT_FORMAT_CSV), chunk("name,price\nGlazed Donut,0\n"), chunk("Eclair,1\nPie,2\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.GetCreated() != 2 || report.GetFailed() != 1 || !report.GetDryRun() ||
+		report.GetRows()[0].GetStatus() != catalog.ImportStatus_IMPORT_STATUS_FAILED {
+		t.Fatalf("unexpected report: %v", report)
+	}
+
+	tests := []struct {
+		name string
+		msgs []*catalog.ImportChunk
+		code codes.Code
+	}{
+		{"Empty Stream", nil, codes.InvalidArgument},
+		{"No Options", []*catalog.ImportChunk{chunk("name,price\n")}, codes.InvalidArgument},
+		{"No Format", []*catalog.ImportChunk{options(catalog.ImportFormat_IMPORT_FORMAT_UNSPECIFIED)}, codes.InvalidArgument},
+		{"Options Twice", []*catalog.ImportChunk{options(catalog.ImportFormat_IMPORT_FORMAT_CSV), options(catalog.ImportFormat_IMPORT_FORMAT_CSV)}, codes.InvalidArgument},
+		{"Bad Header", []*catalog.ImportChunk{options(catalog.ImportFormat_IMPORT_FORMAT_CSV), chunk("weight\n")}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := upload(tt.msgs...)
+			if er, _ := status.FromError(err); er.Code() != tt.code {
+				t.Fatalf("got %v (%s), want %v", er.Code(), er.Message(), tt.code)
+			}
+		})
+	}
+}
+
+func TestExport(t *testing.T) {
+	go NewServer(&AppMock{}, WithExport(&ExportMock{})).RunServer(8017)
+	time.Sleep(100 * time.Millisecond)
+	conn, err := grpc.NewClient("127.0.0.1:8017", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := catalog.NewGRPCExportClient(conn)
+	ctx := context.Background()
+
+	download := func(req *catalog.ExportRequest) ([]byte, int, error) {
+		stream, err := client.Export(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		var data []byte
+		chunks := 0
+		for {
+			chunk, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return data, chunks, nil
+			}
+			if err != nil {
+				return nil, 0, err
+			}
+			data = append(data, chunk.GetData()...)
+			chunks++
+		}
+	}
+
+	data, chunks, err := download(&catalog.ExportRequest{Format: catalog.ExportFormat_EXPORT_FORMAT_CSV, Columns: []string{"id", "name"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	if len(lines) != 10001 || lines[0] != "id,name" || lines[10000] != "9999,Donut 9999" {
+		t.Fatalf("unexpected export: %d lines, first %q, last %q", len(lines), lines[0], lines[len(lines)-1])
+	}
+	if chunks < 2 {
+		t.Fatalf("export is sent in %d chunks, want it streamed", chunks)
+	}
+
+	tests := []struct {
+		name string
+		req  *catalog.ExportRequest
+		code codes.Code
+	}{
+		{"No Format", &catalog.ExportRequest{}, codes.InvalidArgument},
+		{"Unknown Column", &catalog.ExportRequest{Format: catalog.ExportFormat_EXPORT_FORMAT_NDJSON, Columns: []string{"weight"}}, codes.InvalidArgument},
+		{"Feed Columns", &catalog.ExportRequest{Format: catalog.ExportFormat_EXPORT_FORMAT_GOOGLE_XML, Columns: []string{"id"}}, codes.InvalidArgument},
+		{"Unknown Currency", &catalog.ExportRequest{Format: catalog.ExportFormat_EXPORT_FORMAT_CSV, Currency: "XXX"}, codes.InvalidArgument},
+		{"Unknown Category", &catalog.ExportRequest{Format: catalog.ExportFormat_EXPORT_FORMAT_CSV, CategoryId: "missing"}, codes.NotFound},
+		{"Invalid Time", &catalog.ExportRequest{Format: catalog.ExportFormat_EXPORT_FORMAT_CSV, UpdatedSince: &timestamppb.Timestamp{Nanos: -1}}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := download(tt.req)
+			if er, _ := status.FromError(err); er.Code() != tt.code {
+				t.Fatalf("got %v (%s), want %v", er.Code(), er.Message(), tt.code)
+			}
+		})
+	}
+}
+
+func TestBatchGet(t *testing.T) {
+	go NewServer(&AppMock{}, WithProducts(&ProductsMock{})).RunServer(8018)
+	time.Sleep(100 * time.Millisecond)
+	conn, err := grpc.NewClient("127.0.0.1:8018", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := catalog.NewGRPCProductsClient(conn)
+	ctx := context.Background()
+
+	resp, err := client.BatchGet(ctx, &catalog.BatchGetRequest{Ids: []string{"2", "missing", "1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := resp.GetResults()
+	if len(res) != 3 || res[0].GetId() != "2" || res[1].GetId() != "missing" || res[2].GetId() != "1" {
+		t.Fatalf("unexpected results: %v", res)
+	}
+	if res[1].GetFound() || res[1].GetProduct() != nil {
+		t.Fatalf("missing product is reported: %v", res[1])
+	}
+	if !res[0].GetFound() || res[0].GetProduct().GetName() != "Donut 2" || res[0].GetProduct().GetEffectivePrice().GetAmount() != 900 {
+		t.Fatalf("unexpected product: %v", res[0])
+	}
+
+	tests := []struct {
+		name string
+		ids  []string
+		code codes.Code
+	}{
+		{"No IDs", nil, codes.InvalidArgument},
+		{"Empty ID", []string{"1", ""}, codes.InvalidArgument},
+		{"Too Many", strings.Split(strings.Repeat("x,", 100)+"x", ","), codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.BatchGet(ctx, &catalog.BatchGetRequest{Ids: tt.ids})
+			if er, _ := status.FromError(err); er.Code() != tt.code {
+				t.Fatalf("got %v (%s), want %v", er.Code(), er.Message(), tt.code)
+			}
+		})
+	}
+}
+
+func TestBulkMutations(t *testing.T) {
+	go NewServer(&AppMock{}, WithProducts(&ProductsMock{})).RunServer(8019)
+	time.Sleep(100 * time.Millisecond)
+	conn, err := grpc.NewClient("127.0.0.1:8019", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := catalog.NewGRPCProductsClient(conn)
+	ctx := context.Background()
+
+	report, err := client.BulkUpdatePrices(ctx, &catalog.BulkUpdatePricesRequest{
+		Updates: []*catalog.PriceUpdate{
+			{ProductId: "1", Change: &catalog.PriceUpdate_ChangeBp{ChangeBp: 500}},
+			{ProductId: "2", Change: &catalog.PriceUpdate_Price{Price: &catalog.Money{Amount: 700, Currency: "USD"}}},
+			{ProductId: "3"},
+		},
+		Mode: catalog.BulkMode_BULK_MODE_BEST_EFFORT,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := report.GetResults()
+	if len(res) != 3 || res[0].GetNewPrice().GetAmount() != 1050 || res[1].GetNewPrice().GetCurrency() != "USD" ||
+		res[2].GetStatus() != catalog.BulkStatus_BULK_STATUS_FAILED || res[2].GetError() == "" {
+		t.Fatalf("unexpected report: %v", report)
+	}
+
+	// без режима - атомарно
+	report, err = client.BulkDelete(ctx, &catalog.BulkDeleteRequest{Ids: []string{"1", "2"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.GetResults()) != 2 || report.GetResults()[0].GetStatus() != catalog.BulkStatus_BULK_STATUS_SKIPPED {
+		t.Fatalf("unexpected report: %v", report)
+	}
+
+	tests := []struct {
+		name string
+		call func() error
+		code codes.Code
+	}{
+		{"No Updates", func() error {
+			_, err := client.BulkUpdatePrices(ctx, &catalog.BulkUpdatePricesRequest{})
+			return err
+		}, codes.InvalidArgument},
+		{"No IDs", func() error {
+			_, err := client.BulkDelete(ctx, &catalog.BulkDeleteRequest{})
+			return err
+		}, codes.InvalidArgument},
+		{"Unknown Mode", func() error {
+			_, err := client.BulkDelete(ctx, &catalog.BulkDeleteRequest{Ids: []string{"1"}, Mode: 7})
+			return err
+		}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if er, _ := status.FromError(tt.call()); er.Code() != tt.code {
+				t.Fatalf("got %v (%s), want %v", er.Code(), er.Message(), tt.code)
+			}
+		})
+	}
+}
+
+func TestAudit(t *testing.T) {
+	go NewServer(&AppMock{}, WithAudit(&AuditMock{})).RunServer(8020)
+	time.Sleep(100 * time.Millisecond)
+	conn, err := grpc.NewClient("127.0.0.1:8020", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := catalog.NewGRPCAuditClient(conn)
+
+	// свой request id клиента возвращается в заголовке ответа, без него сервер заводит новый
+	var header metadata.MD
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-request-id", "req-from-client")
+	resp, err := client.List(ctx, &catalog.ListAuditRequest{ProductId: "p1"}, grpc.Header(&header))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := header.Get("x-request-id"); len(got) != 1 || got[0] != "req-from-client" {
+		t.Fatalf("got request id %v", got)
+	}
+	e := resp.GetEntries()
+	if len(e) != 1 || e[0].GetProductId() != "p1" || e[0].GetAction() != "update" || e[0].GetDiff() != `{"name":{"old":"A","new":"B"}}` {
+		t.Fatalf("unexpected entries %v", e)
+	}
+	if _, err := client.List(context.Background(), &catalog.ListAuditRequest{Actor: "admin"}, grpc.Header(&header)); err != nil {
+		t.Fatal(err)
+	}
+	if got := header.Get("x-request-id"); len(got) != 1 || got[0] == "" || got[0] == "req-from-client" {
+		t.Fatalf("got request id %v", got)
+	}
+
+	for _, req := range []*catalog.ListAuditRequest{{}, {ProductId: "p1", Limit: -1}} {
+		_, err := client.List(context.Background(), req)
+		if er, _ := status.FromError(err); er.Code() != codes.InvalidArgument {
+			t.Fatalf("%v: got %v, want %v", req, er.Code(), codes.InvalidArgument)
+		}
+	}
+}
+
+func TestTenant(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	v, err := auth.NewVerifier(auth.WithHMACSecret(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	go NewServer(&AppMock{}, WithAuth(v, DefaultPolicy())).RunServer(8021)
+	time.Sleep(100 * time.Millisecond)
+	conn, err := grpc.NewClient("127.0.0.1:8021", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := product.NewGRPCProductClient(conn)
+
+	token := func(tenant string, roles ...string) string {
+		s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, auth.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject:   "user-1",
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			},
+			Roles:  roles,
+			Tenant: tenant,
+		}).SignedString(secret)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	tests := []struct {
+		name    string
+		md      []string
+		tenant  string
+		errCode codes.Code
+	}{
+		{name: "Default", tenant: models.DefaultTenant},
+		{name: "From Header", md: []string{"x-tenant-id", "shop-1"}, tenant: "shop-1"},
+		{name: "From Token", md: []string{"authorization", "Bearer " + token("shop-2")}, tenant: "shop-2"},
+		{name: "Header Matches Token", md: []string{"authorization", "Bearer " + token("shop-2"), "x-tenant-id", "shop-2"}, tenant: "shop-2"},
+		{name: "Header Differs From Token", md: []string{"authorization", "Bearer " + token("shop-2"), "x-tenant-id", "shop-1"}, errCode: codes.PermissionDenied},
+		{name: "All Tenants", md: []string{"x-tenant-id", "*"}, errCode: codes.InvalidArgument},
+		{name: "Too Long", md: []string{"x-tenant-id", strings.Repeat("a", 51)}, errCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.AppendToOutgoingContext(context.Background(), tt.md...)
+			resp, err := client.Get(ctx, &product.ID{Id: "tenant"})
+			er, _ := status.FromError(err)
+			if er.Code() != tt.errCode {
+				t.Fatalf("got %v, want %v", er.Code(), tt.errCode)
+			}
+			if err == nil && resp.GetName() != tt.tenant {
+				t.Fatalf("got tenant %q, want %q", resp.GetName(), tt.tenant)
+			}
+		})
+	}
+
+	// админский токен без магазина пишет только в магазин по умолчанию
+	writes := []struct {
+		name    string
+		md      []string
+		errCode codes.Code
+	}{
+		{name: "Admin Without Tenant", md: []string{"authorization", "Bearer " + token("", auth.RoleCatalogAdmin)}},
+		{name: "Admin Without Tenant To Default", md: []string{"authorization", "Bearer " + token("", auth.RoleCatalogAdmin), "x-tenant-id", models.DefaultTenant}},
+		{name: "Admin Without Tenant To Other", md: []string{"authorization", "Bearer " + token("", auth.RoleCatalogAdmin), "x-tenant-id", "shop-1"}, errCode: codes.PermissionDenied},
+		{name: "All Tenants Role", md: []string{"authorization", "Bearer " + token("", auth.RoleCatalogAdmin, auth.RoleAllTenants), "x-tenant-id", "shop-1"}},
+	}
+	for _, tt := range writes {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.AppendToOutgoingContext(context.Background(), tt.md...)
+			_, err := client.Delete(ctx, &product.ID{Id: "1"})
+			if er, _ := status.FromError(err); er.Code() != tt.errCode {
+				t.Fatalf("got %v, want %v", er.Code(), tt.errCode)
+			}
+		})
+	}
+}
+
+func TestTranslations(t *testing.T) {
+	go NewServer(&AppMock{}, WithTranslations(&TranslationMock{}), WithRESTPort(8087)).RunServer(8022)
+	time.Sleep(100 * time.Millisecond)
+	conn, err := grpc.NewClient("127.0.0.1:8022", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	products := product.NewGRPCProductClient(conn)
+	client := catalog.NewGRPCTranslationClient(conn)
+
+	// язык выбирается по accept-language и возвращается в content-language
+	for _, tt := range []struct{ header, name, locale string }{
+		{"", "Пончик", "ru"},
+		{"de-CH, en;q=0.5", "Donut", "en"},
+	} {
+		ctx := context.Background()
+		if tt.header != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "accept-language", tt.header)
+		}
+		var header metadata.MD
+		p, err := products.Get(ctx, &product.ID{Id: "locale"}, grpc.Header(&header))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := header.Get("content-language"); p.GetName() != tt.name || len(got) != 1 || got[0] != tt.locale {
+			t.Fatalf("%q: got %q in %v", tt.header, p.GetName(), got)
+		}
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://127.0.0.1:8087/products/locale", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Accept-Language", "fr, en;q=0.8")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if got := resp.Header.Get("Content-Language"); got != "en" {
+		t.Fatalf("REST content language: got %q", got)
+	}
+
+	ctx := context.Background()
+	tr := func(locale string) *catalog.Translation {
+		return &catalog.Translation{Locale: locale, Name: "Donut", Description: "Delicious"}
+	}
+	tests := []struct {
+		name    string
+		call    func() error
+		errCode codes.Code
+	}{
+		{"Set", func() error {
+			_, err := client.Set(ctx, &catalog.SetTranslationRequest{ProductId: "1", Translation: tr("en-US")})
+			return err
+		}, codes.OK},
+		{"Set Default Locale", func() error {
+			_, err := client.Set(ctx, &catalog.SetTranslationRequest{ProductId: "1", Translation: tr("ru")})
+			return err
+		}, codes.InvalidArgument},
+		{"Set Invalid Locale", func() error {
+			_, err := client.Set(ctx, &catalog.SetTranslationRequest{ProductId: "1", Translation: tr("english")})
+			return err
+		}, codes.InvalidArgument},
+		{"Set Without Name", func() error {
+			_, err := client.Set(ctx, &catalog.SetTranslationRequest{ProductId: "1", Translation: &catalog.Translation{Locale: "en"}})
+			return err
+		}, codes.InvalidArgument},
+		{"Set Unknown Product", func() error {
+			_, err := client.Set(ctx, &catalog.SetTranslationRequest{ProductId: "404", Translation: tr("en")})
+			return err
+		}, codes.NotFound},
+		{"Delete", func() error {
+			_, err := client.Delete(ctx, &catalog.DeleteTranslationRequest{ProductId: "1", Locale: "en"})
+			return err
+		}, codes.OK},
+		{"Delete Missing", func() error {
+			_, err := client.Delete(ctx, &catalog.DeleteTranslationRequest{ProductId: "1", Locale: "de"})
+			return err
+		}, codes.NotFound},
+		{"List", func() error { _, err := client.List(ctx, &catalog.ListTranslationsRequest{ProductId: "1"}); return err }, codes.OK},
+		{"List Missing", func() error {
+			resp, err := client.ListMissing(ctx, &catalog.ListMissingTranslationsRequest{Locale: "en"})
+			if err == nil && len(resp.GetProducts()) != 1 {
+				return fmt.Errorf("unexpected products %v", resp.GetProducts())
+			}
+			return err
+		}, codes.OK},
+		{"List Missing Without Locale", func() error { _, err := client.ListMissing(ctx, &catalog.ListMissingTranslationsRequest{}); return err }, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			er, _ := status.FromError(tt.call())
+			if er.Code() != tt.errCode {
+				t.Fatalf("got %v (%s), want %v", er.Code(), er.Message(), tt.errCode)
+			}
+		})
+	}
+}
+
+func TestGetBySlug(t *testing.T) {
+	go NewServer(&AppMock{}, WithProducts(&ProductsMock{}), WithRESTPort(8088)).RunServer(8023)
+	time.Sleep(100 * time.Millisecond)
+	conn, err := grpc.NewClient("127.0.0.1:8023", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := catalog.NewGRPCProductsClient(conn)
+	ctx := context.Background()
+
+	tests := []struct {
+		slug    string
+		moved   bool
+		errCode codes.Code
+	}{
+		{"shokoladnyi-ponchik", false, codes.OK},
+		{"ponchik", true, codes.OK},
+		{"Ponchik", false, codes.InvalidArgument},
+		{"", false, codes.InvalidArgument},
+		{"keks", false, codes.NotFound},
+	}
+	for _, tt := range tests {
+		resp, err := client.GetBySlug(ctx, &catalog.GetBySlugRequest{Slug: tt.slug})
+		if er, _ := status.FromError(err); er.Code() != tt.errCode {
+			t.Fatalf("%q: got %v (%s), want %v", tt.slug, er.Code(), er.Message(), tt.errCode)
+		}
+		if err == nil && (resp.GetMoved() != tt.moved || resp.GetProduct().GetSlug() != "shokoladnyi-ponchik") {
+			t.Fatalf("%q: unexpected response %v", tt.slug, resp)
+		}
+	}
+
+	// REST: старый слаг - постоянный редирект на текущий
+	noRedirect := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	resp, err := noRedirect.Get("http://127.0.0.1:8088/p/ponchik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMovedPermanently || resp.Header.Get("Location") != "/p/shokoladnyi-ponchik" {
+		t.Fatalf("got %d to %q", resp.StatusCode, resp.Header.Get("Location"))
+	}
+
+	resp, err = http.Get("http://127.0.0.1:8088/p/ponchik")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var body restBySlug
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	if body.ID != "1" || body.Slug != "shokoladnyi-ponchik" || body.EffectivePrice != 900 || body.Currency != "RUB" {
+		t.Fatalf("unexpected body %+v", body)
+	}
+}
+
+func TestProductStatus(t *testing.T) {
+	go NewServer(&AppMock{}, WithProducts(&ProductsMock{})).RunServer(8024)
+	time.Sleep(100 * time.Millisecond)
+	conn, err := grpc.NewClient("127.0.0.1:8024", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := catalog.NewGRPCProductsClient(conn)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		call    func() error
+		errCode codes.Code
+	}{
+		{"Send To Review", func() error {
+			_, err := client.SetStatus(ctx, &catalog.SetStatusRequest{Id: "1", Status: catalog.ProductStatus_PRODUCT_STATUS_IN_REVIEW})
+			return err
+		}, codes.OK},
+		{"Publish Draft", func() error {
+			_, err := client.SetStatus(ctx, &catalog.SetStatusRequest{Id: "1", Status: catalog.ProductStatus_PRODUCT_STATUS_PUBLISHED})
+			return err
+		}, codes.FailedPrecondition},
+		{"Without Status", func() error {
+			_, err := client.SetStatus(ctx, &catalog.SetStatusRequest{Id: "1"})
+			return err
+		}, codes.InvalidArgument},
+		{"Unknown Product", func() error {
+			_, err := client.SetStatus(ctx, &catalog.SetStatusRequest{Id: "missing", Status: catalog.ProductStatus_PRODUCT_STATUS_IN_REVIEW})
+			return err
+		}, codes.NotFound},
+		{"Schedule", func() error {
+			_, err := client.SetSchedule(ctx, &catalog.SetScheduleRequest{Id: "1", PublishAt: timestamppb.New(time.Now().Add(time.Hour))})
+			return err
+		}, codes.OK},
+		{"Clear Schedule", func() error { _, err := client.SetSchedule(ctx, &catalog.SetScheduleRequest{Id: "1"}); return err }, codes.OK},
+		{"Schedule In Past", func() error {
+			_, err := client.SetSchedule(ctx, &catalog.SetScheduleRequest{Id: "1", PublishAt: timestamppb.New(time.Now().Add(-time.Hour))})
+			return err
+		}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			er, _ := status.FromError(tt.call())
+			if er.Code() != tt.errCode {
+				t.Fatalf("got %v (%s), want %v", er.Code(), er.Message(), tt.errCode)
+			}
+		})
+	}
+}
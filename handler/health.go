@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/glekoz/online-shop_proto/product"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// CheckFunc проверяет одну зависимость (Postgres, кэш и т.д.),
+// nil означает, что зависимость работает
+type CheckFunc func(ctx context.Context) error
+
+func (ps *ProductService) registerHealth(serv *grpc.Server) {
+	healthpb.RegisterHealthServer(serv, ps.health)
+	ps.checkDependencies()
+}
+
+// watchHealth периодически опрашивает зависимости, пока сервер не остановлен
+func (ps *ProductService) watchHealth() {
+	ticker := time.NewTicker(ps.opts.checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ps.done:
+			return
+		case <-ticker.C:
+			ps.checkDependencies()
+		}
+	}
+}
+
+func (ps *ProductService) stopWatch() {
+	ps.stopOnce.Do(func() { close(ps.done) })
+}
+
+// checkDependencies выставляет статус каждой зависимости под её именем,
+// а общий статус ("" и имя сервиса) - SERVING только если живы все зависимости.
+// После Shutdown health-сервер сам игнорирует любые обновления.
+func (ps *ProductService) checkDependencies() {
+	overall := healthpb.HealthCheckResponse_SERVING
+	for name, check := range ps.opts.checks {
+		ctx, cancel := context.WithTimeout(context.Background(), ps.opts.checkInterval)
+		err := check(ctx)
+		cancel()
+		st := healthpb.HealthCheckResponse_SERVING
+		if err != nil {
+			slog.Error("health check failed", "dependency", name, "error", err.Error())
+			st = healthpb.HealthCheckResponse_NOT_SERVING
+			overall = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		ps.health.SetServingStatus(name, st)
+	}
+	ps.health.SetServingStatus("", overall)
+	ps.health.SetServingStatus(product.GRPCProduct_ServiceDesc.ServiceName, overall)
+	ps.ready.Store(overall == healthpb.HealthCheckResponse_SERVING)
+}
+
+func (ps *ProductService) healthMux() *http.ServeMux {
+	mux := http.NewServeMux()
+	// liveness: процесс жив и отвечает, зависимости тут не важны,
+	// иначе кубер начнет перезапускать под при падении базы
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("ok"))
+	})
+	// readiness: готов принимать трафик
+	mux.HandleFunc("GET /readyz", func(w http.ResponseWriter, r *http.Request) {
+		if ps.shuttingDown.Load() || !ps.ready.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("not ready"))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("ok"))
+	})
+	return mux
+}
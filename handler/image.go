@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/glekoz/online-shop_product/pkg/imaging"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/pb/catalog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type ImageService struct {
+	app ImageAppAPI
+	catalog.UnimplementedGRPCImageServer
+}
+
+type ImageAppAPI interface {
+	UploadImage(ctx context.Context, meta models.Image, r io.Reader) (models.Image, error)
+	ListImages(ctx context.Context, productID string) ([]models.Image, error)
+	DeleteImage(ctx context.Context, id string) error
+	SetPrimaryImage(ctx context.Context, id string) error
+	ReorderImages(ctx context.Context, productID string, imageIDs []string) error
+}
+
+func (s *ImageService) Upload(stream catalog.GRPCImage_UploadServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return status.Errorf(codes.InvalidArgument, "empty upload")
+		}
+		return err
+	}
+	info := first.GetInfo()
+	if info == nil {
+		return status.Errorf(codes.InvalidArgument, "first message must contain info")
+	}
+	if info.GetProductId() == "" {
+		return status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	if len(info.GetAlt()) > 300 {
+		return status.Errorf(codes.InvalidArgument, "alt must be up to 300 characters")
+	}
+	// заявленный тип проверяем до приема файла, содержимое проверит app
+	if ct := info.GetContentType(); ct != "" {
+		if _, ok := imaging.ContentTypes[ct]; !ok {
+			return status.Error(codes.InvalidArgument, imaging.ErrUnsupportedType.Error())
+		}
+	}
+	img, err := s.app.UploadImage(stream.Context(), models.Image{
+		ProductID:   info.GetProductId(),
+		ContentType: info.GetContentType(),
+		Alt:         info.GetAlt(),
+	}, &chunkReader{next: func() ([]byte, error) {
+		msg, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if msg.GetInfo() != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "info must be sent only in the first message")
+		}
+		return msg.GetChunk(), nil
+	}})
+	if err != nil {
+		return imageStatus(err, info.GetProductId())
+	}
+	return stream.SendAndClose(imageToPB(img))
+}
+
+func (s *ImageService) List(ctx context.Context, req *catalog.ProductRef) (*catalog.ImageList, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	imgs, err := s.app.ListImages(ctx, req.GetProductId())
+	if err != nil {
+		return nil, imageStatus(err, req.GetProductId())
+	}
+	resp := &catalog.ImageList{Images: make([]*catalog.Image, len(imgs))}
+	for i, img := range imgs {
+		resp.Images[i] = imageToPB(img)
+	}
+	return resp, nil
+}
+
+func (s *ImageService) Delete(ctx context.Context, req *catalog.ImageID) (*emptypb.Empty, error) {
+	if req.GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	if err := s.app.DeleteImage(ctx, req.GetId()); err != nil {
+		return nil, imageStatus(err, req.GetId())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ImageService) SetPrimary(ctx context.Context, req *catalog.ImageID) (*emptypb.Empty, error) {
+	if req.GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	if err := s.app.SetPrimaryImage(ctx, req.GetId()); err != nil {
+		return nil, imageStatus(err, req.GetId())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ImageService) Reorder(ctx context.Context, req *catalog.ImageOrder) (*emptypb.Empty, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	if err := s.app.ReorderImages(ctx, req.GetProductId(), req.GetImageIds()); err != nil {
+		return nil, imageStatus(err, req.GetProductId())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// chunkReader отдает байты кусков из клиентского потока как io.Reader;
+// next возвращает очередной кусок или io.EOF в конце потока
+type chunkReader struct {
+	next func() ([]byte, error)
+	buf  []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		chunk, err := r.next()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = chunk
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func imageStatus(err error, key string) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, models.ErrNotFound):
+		return status.Errorf(codes.NotFound, "%s not found", key)
+	case errors.Is(err, models.ErrImagesDisabled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, models.ErrImageTooLarge),
+		errors.Is(err, models.ErrInvalidImageOrder),
+		errors.Is(err, imaging.ErrUnsupportedType),
+		errors.Is(err, imaging.ErrInvalidImage),
+		errors.Is(err, imaging.ErrTooLarge):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func imageToPB(img models.Image) *catalog.Image {
+	pi := &catalog.Image{
+		Id:          img.ID,
+		ProductId:   img.ProductID,
+		Url:         img.URL,
+		ContentType: img.ContentType,
+		Size:        img.Size,
+		Width:       int32(img.Width),
+		Height:      int32(img.Height),
+		Alt:         img.Alt,
+		Primary:     img.Primary,
+		Position:    int32(img.Position),
+		Thumbnails:  make([]*catalog.Thumbnail, len(img.Thumbnails)),
+	}
+	for i, th := range img.Thumbnails {
+		pi.Thumbnails[i] = &catalog.Thumbnail{
+			Name:        th.Name,
+			Url:         th.URL,
+			ContentType: th.ContentType,
+			Width:       int32(th.Width),
+			Height:      int32(th.Height),
+		}
+	}
+	return pi
+}
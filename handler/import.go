@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/glekoz/online-shop_product/pkg/bulk"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/pb/catalog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ImportService struct {
+	app ImportAppAPI
+	catalog.UnimplementedGRPCImportServer
+}
+
+type ImportAppAPI interface {
+	Import(ctx context.Context, format bulk.Format, r io.Reader, dryRun bool) (models.ImportReport, error)
+}
+
+var importFormats = map[catalog.ImportFormat]bulk.Format{
+	catalog.ImportFormat_IMPORT_FORMAT_CSV:    bulk.CSV,
+	catalog.ImportFormat_IMPORT_FORMAT_NDJSON: bulk.NDJSON,
+}
+
+var importStatuses = map[models.ImportStatus]catalog.ImportStatus{
+	models.ImportCreated:   catalog.ImportStatus_IMPORT_STATUS_CREATED,
+	models.ImportUpdated:   catalog.ImportStatus_IMPORT_STATUS_UPDATED,
+	models.ImportUnchanged: catalog.ImportStatus_IMPORT_STATUS_UNCHANGED,
+	models.ImportFailed:    catalog.ImportStatus_IMPORT_STATUS_FAILED,
+}
+
+func (s *ImportService) Import(stream catalog.GRPCImport_ImportServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return status.Errorf(codes.InvalidArgument, "empty import")
+		}
+		return err
+	}
+	opts := first.GetOptions()
+	if opts == nil {
+		return status.Errorf(codes.InvalidArgument, "first message must contain options")
+	}
+	format, ok := importFormats[opts.GetFormat()]
+	if !ok {
+		return status.Error(codes.InvalidArgument, bulk.ErrUnknownFormat.Error())
+	}
+	report, err := s.app.Import(stream.Context(), format, &chunkReader{next: func() ([]byte, error) {
+		msg, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if msg.GetOptions() != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "options must be sent only in the first message")
+		}
+		return msg.GetChunk(), nil
+	}}, opts.GetDryRun())
+	if err != nil {
+		return importStatus(err)
+	}
+	resp := &catalog.ImportReport{
+		Rows:      make([]*catalog.ImportRowResult, len(report.Rows)),
+		Created:   int32(report.Created),
+		Updated:   int32(report.Updated),
+		Unchanged: int32(report.Unchanged),
+		Failed:    int32(report.Failed),
+		DryRun:    report.DryRun,
+	}
+	for i, row := range report.Rows {
+		resp.Rows[i] = &catalog.ImportRowResult{
+			Line:   int32(row.Line),
+			Sku:    row.SKU,
+			Name:   row.Name,
+			Id:     row.ID,
+			Status: importStatuses[row.Status],
+			Error:  row.Error,
+		}
+	}
+	return stream.SendAndClose(resp)
+}
+
+func importStatus(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, bulk.ErrBadHeader), errors.Is(err, bulk.ErrUnknownFormat):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, models.ErrImportTooLarge):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, models.ErrAlreadyExists):
+		// параллельная запись заняла название или артикул, повтор пройдет
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
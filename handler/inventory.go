@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/pb/catalog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type InventoryService struct {
+	app InventoryAppAPI
+	catalog.UnimplementedGRPCInventoryServer
+}
+
+type InventoryAppAPI interface {
+	AdjustStock(ctx context.Context, productID, location string, delta int, reason string) (int, error)
+	GetStock(ctx context.Context, productID string) (models.Stock, error)
+	SetLowStockThreshold(ctx context.Context, productID string, threshold int) error
+	ListLowStock(ctx context.Context, limit, offset int) ([]models.Stock, error)
+	ListStockMovements(ctx context.Context, productID string, limit, offset int) ([]models.StockMovement, error)
+}
+
+func (s *InventoryService) Adjust(ctx context.Context, req *catalog.StockAdjustment) (*catalog.StockLevel, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	if req.GetDelta() == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "delta must not be zero")
+	}
+	if len(req.GetLocation()) > 50 || len(req.GetReason()) > 200 {
+		return nil, status.Errorf(codes.InvalidArgument, "location must be up to 50 and reason up to 200 characters")
+	}
+	qty, err := s.app.AdjustStock(ctx, req.GetProductId(), req.GetLocation(), int(req.GetDelta()), req.GetReason())
+	if err != nil {
+		return nil, stockStatus(err, req.GetProductId())
+	}
+	location := req.GetLocation()
+	if location == "" {
+		location = models.DefaultLocation
+	}
+	return &catalog.StockLevel{Location: location, Quantity: int32(qty)}, nil
+}
+
+func (s *InventoryService) Get(ctx context.Context, req *catalog.ProductRef) (*catalog.Stock, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	stock, err := s.app.GetStock(ctx, req.GetProductId())
+	if err != nil {
+		return nil, stockStatus(err, req.GetProductId())
+	}
+	return stockToPB(stock), nil
+}
+
+func (s *InventoryService) SetLowStockThreshold(ctx context.Context, req *catalog.LowStockThreshold) (*emptypb.Empty, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	if req.GetThreshold() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "threshold must not be negative")
+	}
+	if err := s.app.SetLowStockThreshold(ctx, req.GetProductId(), int(req.GetThreshold())); err != nil {
+		return nil, stockStatus(err, req.GetProductId())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *InventoryService) ListLowStock(ctx context.Context, req *catalog.ListLowStockRequest) (*catalog.StockList, error) {
+	if req.GetLimit() < 0 || req.GetOffset() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+	stocks, err := s.app.ListLowStock(ctx, int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &catalog.StockList{Items: make([]*catalog.Stock, len(stocks))}
+	for i, st := range stocks {
+		resp.Items[i] = stockToPB(st)
+	}
+	return resp, nil
+}
+
+func (s *InventoryService) Ledger(ctx context.Context, req *catalog.LedgerRequest) (*catalog.LedgerEntries, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	if req.GetLimit() < 0 || req.GetOffset() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+	moves, err := s.app.ListStockMovements(ctx, req.GetProductId(), int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &catalog.LedgerEntries{Entries: make([]*catalog.LedgerEntry, len(moves))}
+	for i, m := range moves {
+		resp.Entries[i] = &catalog.LedgerEntry{
+			Id:            m.ID,
+			Location:      m.Location,
+			Delta:         int32(m.Delta),
+			QuantityAfter: int32(m.QuantityAfter),
+			Reason:        m.Reason,
+			Actor:         m.Actor,
+			CreatedAt:     timestamppb.New(m.CreatedAt),
+		}
+	}
+	return resp, nil
+}
+
+func stockStatus(err error, key string) error {
+	switch {
+	case errors.Is(err, models.ErrNotFound):
+		return status.Errorf(codes.NotFound, "%s not found", key)
+	case errors.Is(err, models.ErrInsufficientStock):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func stockToPB(s models.Stock) *catalog.Stock {
+	res := &catalog.Stock{
+		ProductId:         s.ProductID,
+		Total:             int32(s.Total),
+		Reserved:          int32(s.Reserved),
+		LowStockThreshold: int32(s.LowStockThreshold),
+		Available:         s.Available(),
+		LowStock:          s.LowStock(),
+	}
+	for _, l := range s.Levels {
+		res.Levels = append(res.Levels, &catalog.StockLevel{Location: l.Location, Quantity: int32(l.Quantity), Reserved: int32(l.Reserved)})
+	}
+	return res
+}
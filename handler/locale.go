@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/glekoz/online-shop_product/pkg/i18n"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// localeHeader - языки клиента в формате Accept-Language; язык, на котором
+// отдан товар, возвращается в contentLanguageHeader или в поле locale ответа
+const (
+	localeHeader          = "accept-language"
+	contentLanguageHeader = "content-language"
+)
+
+func localeUnary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(withLocale(ctx), req)
+	}
+}
+
+func localeStream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: withLocale(ss.Context())})
+	}
+}
+
+// withLocale разбирает accept-language; кривой заголовок не ошибка,
+// просто товар придет на языке по умолчанию
+func withLocale(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	v := md.Get(localeHeader)
+	if len(v) == 0 {
+		return ctx
+	}
+	return i18n.WithPreferences(ctx, i18n.ParseAcceptLanguage(v[0]))
+}
@@ -0,0 +1,275 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+	"github.com/glekoz/online-shop_product/pkg/pb/catalog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PricingService struct {
+	app PricingAppAPI
+	catalog.UnimplementedGRPCPricingServer
+}
+
+type PricingAppAPI interface {
+	SetBasePrice(ctx context.Context, productID string, price money.Money) error
+	SetProductPrice(ctx context.Context, productID string, price money.Money) error
+	DeleteProductPrice(ctx context.Context, productID, currency string) error
+	ListProductPrices(ctx context.Context, productID string) (models.ProductPrices, error)
+	QuotePrice(ctx context.Context, productID, currency string) (models.PriceQuote, error)
+	SetCurrencyRate(ctx context.Context, rate money.Rate) error
+	DeleteCurrencyRate(ctx context.Context, base, quote string) error
+	ListCurrencyRates(ctx context.Context) ([]models.CurrencyRate, error)
+	SchedulePrice(ctx context.Context, productID string, price money.Money, at time.Time) (int64, error)
+	CancelScheduledPrice(ctx context.Context, id int64) error
+	PriceHistory(ctx context.Context, productID string, limit, offset int) ([]models.PriceChange, error)
+	PriceAt(ctx context.Context, productID string, at time.Time) (money.Money, error)
+}
+
+var roundings = map[catalog.Rounding]money.Rounding{
+	catalog.Rounding_ROUNDING_UNSPECIFIED: money.RoundHalfUp,
+	catalog.Rounding_ROUNDING_HALF_UP:     money.RoundHalfUp,
+	catalog.Rounding_ROUNDING_HALF_EVEN:   money.RoundHalfEven,
+	catalog.Rounding_ROUNDING_DOWN:        money.RoundDown,
+	catalog.Rounding_ROUNDING_UP:          money.RoundUp,
+}
+
+var priceSources = map[models.PriceSource]catalog.PriceSource{
+	models.PriceBase:      catalog.PriceSource_PRICE_SOURCE_BASE,
+	models.PriceExplicit:  catalog.PriceSource_PRICE_SOURCE_EXPLICIT,
+	models.PriceConverted: catalog.PriceSource_PRICE_SOURCE_CONVERTED,
+}
+
+func (s *PricingService) SetBasePrice(ctx context.Context, req *catalog.ProductPrice) (*emptypb.Empty, error) {
+	price, err := validateProductPrice(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.app.SetBasePrice(ctx, req.GetProductId(), price); err != nil {
+		return nil, pricingStatus(err, req.GetProductId())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PricingService) SetPrice(ctx context.Context, req *catalog.ProductPrice) (*emptypb.Empty, error) {
+	price, err := validateProductPrice(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.app.SetProductPrice(ctx, req.GetProductId(), price); err != nil {
+		return nil, pricingStatus(err, req.GetProductId())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PricingService) DeletePrice(ctx context.Context, req *catalog.ProductPriceRef) (*emptypb.Empty, error) {
+	if err := validatePriceRef(req); err != nil {
+		return nil, err
+	}
+	if err := s.app.DeleteProductPrice(ctx, req.GetProductId(), req.GetCurrency()); err != nil {
+		return nil, pricingStatus(err, req.GetProductId()+"/"+req.GetCurrency())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PricingService) ListPrices(ctx context.Context, req *catalog.ProductRef) (*catalog.ProductPrices, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	prices, err := s.app.ListProductPrices(ctx, req.GetProductId())
+	if err != nil {
+		return nil, pricingStatus(err, req.GetProductId())
+	}
+	resp := &catalog.ProductPrices{ProductId: req.GetProductId(), Base: moneyToPB(prices.Base)}
+	for _, p := range prices.Prices {
+		resp.Prices = append(resp.Prices, moneyToPB(p))
+	}
+	return resp, nil
+}
+
+func (s *PricingService) Quote(ctx context.Context, req *catalog.ProductPriceRef) (*catalog.PriceQuote, error) {
+	if err := validatePriceRef(req); err != nil {
+		return nil, err
+	}
+	q, err := s.app.QuotePrice(ctx, req.GetProductId(), req.GetCurrency())
+	if err != nil {
+		return nil, pricingStatus(err, req.GetProductId())
+	}
+	return &catalog.PriceQuote{Price: moneyToPB(q.Price), Source: priceSources[q.Source], Rate: q.Rate}, nil
+}
+
+func (s *PricingService) SetRate(ctx context.Context, req *catalog.CurrencyRate) (*emptypb.Empty, error) {
+	rounding, ok := roundings[req.GetRounding()]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown rounding")
+	}
+	rate := money.Rate{
+		Base:      req.GetBase(),
+		Quote:     req.GetQuote(),
+		Rate:      req.GetRate(),
+		Rounding:  rounding,
+		Increment: req.GetIncrement(),
+	}
+	if err := rate.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.app.SetCurrencyRate(ctx, rate); err != nil {
+		return nil, pricingStatus(err, rate.Base+"/"+rate.Quote)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PricingService) DeleteRate(ctx context.Context, req *catalog.CurrencyPair) (*emptypb.Empty, error) {
+	if req.GetBase() == "" || req.GetQuote() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "base and quote are required")
+	}
+	if err := s.app.DeleteCurrencyRate(ctx, req.GetBase(), req.GetQuote()); err != nil {
+		return nil, pricingStatus(err, req.GetBase()+"/"+req.GetQuote())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PricingService) ListRates(ctx context.Context, _ *emptypb.Empty) (*catalog.CurrencyRateList, error) {
+	rates, err := s.app.ListCurrencyRates(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &catalog.CurrencyRateList{Rates: make([]*catalog.CurrencyRate, len(rates))}
+	for i, r := range rates {
+		pr := &catalog.CurrencyRate{
+			Base:      r.Base,
+			Quote:     r.Quote,
+			Rate:      r.Rate.Rate,
+			Increment: r.Increment,
+			UpdatedAt: timestamppb.New(r.UpdatedAt),
+		}
+		for pbr, mr := range roundings {
+			if mr == r.Rounding && pbr != catalog.Rounding_ROUNDING_UNSPECIFIED {
+				pr.Rounding = pbr
+			}
+		}
+		resp.Rates[i] = pr
+	}
+	return resp, nil
+}
+
+func (s *PricingService) SchedulePrice(ctx context.Context, req *catalog.ScheduledPrice) (*catalog.PriceChangeID, error) {
+	price, err := validateProductPrice(&catalog.ProductPrice{ProductId: req.GetProductId(), Price: req.GetPrice()})
+	if err != nil {
+		return nil, err
+	}
+	if err := req.GetEffectiveFrom().CheckValid(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "effective_from: %v", err)
+	}
+	id, err := s.app.SchedulePrice(ctx, req.GetProductId(), price, req.GetEffectiveFrom().AsTime())
+	if err != nil {
+		return nil, pricingStatus(err, req.GetProductId())
+	}
+	return &catalog.PriceChangeID{Id: id}, nil
+}
+
+func (s *PricingService) CancelScheduledPrice(ctx context.Context, req *catalog.PriceChangeID) (*emptypb.Empty, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	if err := s.app.CancelScheduledPrice(ctx, req.GetId()); err != nil {
+		return nil, pricingStatus(err, fmt.Sprintf("scheduled price %d", req.GetId()))
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PricingService) History(ctx context.Context, req *catalog.PriceHistoryRequest) (*catalog.PriceHistory, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	if req.GetLimit() < 0 || req.GetOffset() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+	changes, err := s.app.PriceHistory(ctx, req.GetProductId(), int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &catalog.PriceHistory{Changes: make([]*catalog.PriceChange, len(changes))}
+	for i, c := range changes {
+		pc := &catalog.PriceChange{
+			Id:            c.ID,
+			Price:         moneyToPB(c.Price),
+			EffectiveFrom: timestamppb.New(c.EffectiveFrom),
+			Scheduled:     !c.Applied,
+		}
+		if !c.EffectiveTo.IsZero() {
+			pc.EffectiveTo = timestamppb.New(c.EffectiveTo)
+		}
+		resp.Changes[i] = pc
+	}
+	return resp, nil
+}
+
+func (s *PricingService) PriceAt(ctx context.Context, req *catalog.PriceAtRequest) (*catalog.Money, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	if err := req.GetAt().CheckValid(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "at: %v", err)
+	}
+	price, err := s.app.PriceAt(ctx, req.GetProductId(), req.GetAt().AsTime())
+	if err != nil {
+		return nil, pricingStatus(err, req.GetProductId())
+	}
+	return moneyToPB(price), nil
+}
+
+func validateProductPrice(req *catalog.ProductPrice) (money.Money, error) {
+	if req.GetProductId() == "" {
+		return money.Money{}, status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	price := moneyFromPB(req.GetPrice())
+	if err := price.Validate(); err != nil {
+		return money.Money{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if price.Amount <= 0 {
+		return money.Money{}, status.Errorf(codes.InvalidArgument, "price must be greater than 0")
+	}
+	return price, nil
+}
+
+func validatePriceRef(req *catalog.ProductPriceRef) error {
+	if req.GetProductId() == "" {
+		return status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	if _, err := money.Exponent(req.GetCurrency()); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+func pricingStatus(err error, key string) error {
+	switch {
+	case errors.Is(err, models.ErrNotFound):
+		return status.Errorf(codes.NotFound, "%s not found", key)
+	case errors.Is(err, models.ErrNoExchangeRate):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, models.ErrScheduleInPast):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, money.ErrOverflow):
+		return status.Error(codes.OutOfRange, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func moneyFromPB(m *catalog.Money) money.Money {
+	return money.New(m.GetAmount(), m.GetCurrency())
+}
+
+func moneyToPB(m money.Money) *catalog.Money {
+	return &catalog.Money{Amount: m.Amount, Currency: m.Currency}
+}
@@ -0,0 +1,231 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/pb/catalog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ProductsService struct {
+	app ProductsAppAPI
+	catalog.UnimplementedGRPCProductsServer
+}
+
+type ProductsAppAPI interface {
+	BatchGet(ctx context.Context, ids []string) ([]models.BatchItem, error)
+	BulkUpdatePrices(ctx context.Context, updates []models.PriceUpdate, atomic bool) (models.BulkReport, error)
+	BulkDelete(ctx context.Context, ids []string, atomic bool) (models.BulkReport, error)
+	GetBySlug(ctx context.Context, slug string) (string, models.Product, error)
+	SetStatus(ctx context.Context, id string, to models.ProductStatus) error
+	SetSchedule(ctx context.Context, id string, s models.Schedule) error
+}
+
+var productStatuses = map[models.ProductStatus]catalog.ProductStatus{
+	models.StatusDraft:        catalog.ProductStatus_PRODUCT_STATUS_DRAFT,
+	models.StatusInReview:     catalog.ProductStatus_PRODUCT_STATUS_IN_REVIEW,
+	models.StatusPublished:    catalog.ProductStatus_PRODUCT_STATUS_PUBLISHED,
+	models.StatusDiscontinued: catalog.ProductStatus_PRODUCT_STATUS_DISCONTINUED,
+}
+
+var bulkStatuses = map[models.BulkStatus]catalog.BulkStatus{
+	models.BulkApplied: catalog.BulkStatus_BULK_STATUS_APPLIED,
+	models.BulkFailed:  catalog.BulkStatus_BULK_STATUS_FAILED,
+	models.BulkSkipped: catalog.BulkStatus_BULK_STATUS_SKIPPED,
+}
+
+func (s *ProductsService) BatchGet(ctx context.Context, req *catalog.BatchGetRequest) (*catalog.BatchGetResponse, error) {
+	if len(req.GetIds()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ids are required")
+	}
+	for _, id := range req.GetIds() {
+		if id == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "ids must not be empty")
+		}
+	}
+	items, err := s.app.BatchGet(ctx, req.GetIds())
+	if err != nil {
+		return nil, productsStatus(err)
+	}
+	resp := &catalog.BatchGetResponse{Results: make([]*catalog.BatchGetResult, len(items))}
+	for i, item := range items {
+		res := &catalog.BatchGetResult{Id: item.ID, Found: item.Found}
+		if item.Found {
+			res.Product = productToPB(item.ID, item.Product)
+		}
+		resp.Results[i] = res
+	}
+	return resp, nil
+}
+
+func (s *ProductsService) BulkUpdatePrices(ctx context.Context, req *catalog.BulkUpdatePricesRequest) (*catalog.BulkReport, error) {
+	if len(req.GetUpdates()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "updates are required")
+	}
+	atomic, err := bulkAtomic(req.GetMode())
+	if err != nil {
+		return nil, err
+	}
+	// ошибки элементов (нет цены, неизвестная валюта) проверяет app и пишет в отчет
+	updates := make([]models.PriceUpdate, len(req.GetUpdates()))
+	for i, u := range req.GetUpdates() {
+		updates[i] = models.PriceUpdate{ProductID: u.GetProductId(), ChangeBP: int64(u.GetChangeBp())}
+		if u.GetPrice() != nil {
+			updates[i].Price = moneyFromPB(u.GetPrice())
+		}
+	}
+	report, err := s.app.BulkUpdatePrices(ctx, updates, atomic)
+	if err != nil {
+		return nil, productsStatus(err)
+	}
+	return bulkReportToPB(report), nil
+}
+
+func (s *ProductsService) BulkDelete(ctx context.Context, req *catalog.BulkDeleteRequest) (*catalog.BulkReport, error) {
+	if len(req.GetIds()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ids are required")
+	}
+	atomic, err := bulkAtomic(req.GetMode())
+	if err != nil {
+		return nil, err
+	}
+	report, err := s.app.BulkDelete(ctx, req.GetIds(), atomic)
+	if err != nil {
+		return nil, productsStatus(err)
+	}
+	return bulkReportToPB(report), nil
+}
+
+func (s *ProductsService) GetBySlug(ctx context.Context, req *catalog.GetBySlugRequest) (*catalog.GetBySlugResponse, error) {
+	if req.GetSlug() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "slug is required")
+	}
+	id, p, err := s.app.GetBySlug(ctx, req.GetSlug())
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, models.ErrInvalidSlug):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &catalog.GetBySlugResponse{Product: productToPB(id, p), Moved: p.Slug != req.GetSlug()}, nil
+}
+
+func (s *ProductsService) SetStatus(ctx context.Context, req *catalog.SetStatusRequest) (*emptypb.Empty, error) {
+	if req.GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	var to models.ProductStatus
+	for st, pb := range productStatuses {
+		if pb == req.GetStatus() {
+			to = st
+		}
+	}
+	if to == "" {
+		return nil, status.Error(codes.InvalidArgument, models.ErrInvalidStatus.Error())
+	}
+	if err := s.app.SetStatus(ctx, req.GetId(), to); err != nil {
+		return nil, lifecycleStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ProductsService) SetSchedule(ctx context.Context, req *catalog.SetScheduleRequest) (*emptypb.Empty, error) {
+	if req.GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	var sched models.Schedule
+	if req.GetPublishAt() != nil {
+		if err := req.GetPublishAt().CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "publish_at: %v", err)
+		}
+		sched.PublishAt = req.GetPublishAt().AsTime()
+	}
+	if req.GetUnpublishAt() != nil {
+		if err := req.GetUnpublishAt().CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unpublish_at: %v", err)
+		}
+		sched.UnpublishAt = req.GetUnpublishAt().AsTime()
+	}
+	if err := s.app.SetSchedule(ctx, req.GetId(), sched); err != nil {
+		return nil, lifecycleStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func lifecycleStatus(err error) error {
+	switch {
+	case errors.Is(err, models.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrInvalidStatus), errors.Is(err, models.ErrScheduleInPast), errors.Is(err, models.ErrScheduleOrder):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, models.ErrStatusTransition), errors.Is(err, models.ErrScheduleStatus):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func bulkAtomic(mode catalog.BulkMode) (bool, error) {
+	switch mode {
+	case catalog.BulkMode_BULK_MODE_UNSPECIFIED, catalog.BulkMode_BULK_MODE_ATOMIC:
+		return true, nil
+	case catalog.BulkMode_BULK_MODE_BEST_EFFORT:
+		return false, nil
+	}
+	return false, status.Errorf(codes.InvalidArgument, "unknown mode %d", mode)
+}
+
+func bulkReportToPB(report models.BulkReport) *catalog.BulkReport {
+	resp := &catalog.BulkReport{
+		Results: make([]*catalog.BulkItemResult, len(report.Results)),
+		Applied: int32(report.Applied),
+		Failed:  int32(report.Failed),
+		Skipped: int32(report.Skipped),
+	}
+	for i, r := range report.Results {
+		res := &catalog.BulkItemResult{Id: r.ID, Status: bulkStatuses[r.Status], Error: r.Error}
+		if r.OldPrice.Currency != "" {
+			res.OldPrice, res.NewPrice = moneyToPB(r.OldPrice), moneyToPB(r.NewPrice)
+		}
+		resp.Results[i] = res
+	}
+	return resp
+}
+
+func productToPB(id string, p models.Product) *catalog.Product {
+	return &catalog.Product{
+		Id:             id,
+		Name:           p.Name,
+		Description:    p.Description,
+		Price:          moneyToPB(p.Price),
+		EffectivePrice: moneyToPB(p.EffectivePrice),
+		PromotionIds:   p.Promotions,
+		Locale:         p.Locale,
+		Slug:           p.Slug,
+		Status:         productStatuses[p.Status],
+		PublishAt:      optionalTimestamp(p.Schedule.PublishAt),
+		UnpublishAt:    optionalTimestamp(p.Schedule.UnpublishAt),
+	}
+}
+
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func productsStatus(err error) error {
+	if errors.Is(err, models.ErrTooManyIDs) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
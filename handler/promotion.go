@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/pb/catalog"
+	"github.com/glekoz/online-shop_product/pkg/promo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PromotionService struct {
+	app PromotionAppAPI
+	catalog.UnimplementedGRPCPromotionServer
+}
+
+type PromotionAppAPI interface {
+	CreatePromotion(ctx context.Context, p promo.Promotion) (string, error)
+	UpdatePromotion(ctx context.Context, id string, p promo.Promotion) error
+	DeletePromotion(ctx context.Context, id string) error
+	GetPromotion(ctx context.Context, id string) (promo.Promotion, error)
+	ListPromotions(ctx context.Context) ([]promo.Promotion, error)
+	Get(ctx context.Context, id string) (models.Product, error)
+}
+
+var promoKinds = map[catalog.PromotionKind]promo.Kind{
+	catalog.PromotionKind_PROMOTION_KIND_PERCENT: promo.Percent,
+	catalog.PromotionKind_PROMOTION_KIND_FIXED:   promo.Fixed,
+}
+
+var promoTargets = map[catalog.PromotionTarget]promo.Target{
+	catalog.PromotionTarget_PROMOTION_TARGET_ALL:      promo.TargetAll,
+	catalog.PromotionTarget_PROMOTION_TARGET_PRODUCT:  promo.TargetProduct,
+	catalog.PromotionTarget_PROMOTION_TARGET_CATEGORY: promo.TargetCategory,
+}
+
+func (s *PromotionService) Create(ctx context.Context, req *catalog.Promotion) (*catalog.PromotionID, error) {
+	p, err := promotionFromPB(req)
+	if err != nil {
+		return nil, err
+	}
+	id, err := s.app.CreatePromotion(ctx, p)
+	if err != nil {
+		slog.ErrorContext(log.ErrorContext(ctx, err), "promotion creation: "+err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &catalog.PromotionID{Id: id}, nil
+}
+
+func (s *PromotionService) Update(ctx context.Context, req *catalog.Promotion) (*emptypb.Empty, error) {
+	if req.GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	p, err := promotionFromPB(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.app.UpdatePromotion(ctx, req.GetId(), p); err != nil {
+		return nil, promotionStatus(err, req.GetId())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PromotionService) Delete(ctx context.Context, req *catalog.PromotionID) (*emptypb.Empty, error) {
+	if req.GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	if err := s.app.DeletePromotion(ctx, req.GetId()); err != nil {
+		return nil, promotionStatus(err, req.GetId())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PromotionService) Get(ctx context.Context, req *catalog.PromotionID) (*catalog.Promotion, error) {
+	if req.GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	p, err := s.app.GetPromotion(ctx, req.GetId())
+	if err != nil {
+		return nil, promotionStatus(err, req.GetId())
+	}
+	return promotionToPB(p), nil
+}
+
+func (s *PromotionService) List(ctx context.Context, _ *emptypb.Empty) (*catalog.PromotionList, error) {
+	ps, err := s.app.ListPromotions(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &catalog.PromotionList{Promotions: make([]*catalog.Promotion, len(ps))}
+	for i, p := range ps {
+		resp.Promotions[i] = promotionToPB(p)
+	}
+	return resp, nil
+}
+
+func (s *PromotionService) GetPrice(ctx context.Context, req *catalog.ProductRef) (*catalog.EffectivePrice, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	p, err := s.app.Get(ctx, req.GetProductId())
+	if err != nil {
+		return nil, promotionStatus(err, req.GetProductId())
+	}
+	return &catalog.EffectivePrice{
+		ListPrice:    moneyToPB(p.Price),
+		Price:        moneyToPB(p.EffectivePrice),
+		PromotionIds: p.Promotions,
+	}, nil
+}
+
+func promotionStatus(err error, key string) error {
+	if errors.Is(err, models.ErrNotFound) {
+		return status.Errorf(codes.NotFound, "%s not found", key)
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func promotionFromPB(req *catalog.Promotion) (promo.Promotion, error) {
+	if req.GetName() == "" || len(req.GetName()) > 200 {
+		return promo.Promotion{}, status.Errorf(codes.InvalidArgument, "name is required and must be up to 200 characters")
+	}
+	p := promo.Promotion{
+		Name:      req.GetName(),
+		Kind:      promoKinds[req.GetKind()],
+		Value:     req.GetPercent(),
+		Target:    promoTargets[req.GetTarget()],
+		TargetID:  req.GetTargetId(),
+		Priority:  int(req.GetPriority()),
+		Stackable: req.GetStackable(),
+	}
+	if req.Amount != nil {
+		p.Amount = moneyFromPB(req.GetAmount())
+	}
+	if p.Kind == promo.Fixed && p.Value != 0 || p.Kind == promo.Percent && req.Amount != nil {
+		return promo.Promotion{}, status.Errorf(codes.InvalidArgument, "percent is for percent promotions, amount is for fixed ones")
+	}
+	for _, ts := range []struct {
+		name string
+		v    *timestamppb.Timestamp
+		dst  *time.Time
+	}{{"starts_at", req.GetStartsAt(), &p.StartsAt}, {"ends_at", req.GetEndsAt(), &p.EndsAt}} {
+		if ts.v == nil {
+			continue
+		}
+		if err := ts.v.CheckValid(); err != nil {
+			return promo.Promotion{}, status.Errorf(codes.InvalidArgument, "%s: %v", ts.name, err)
+		}
+		*ts.dst = ts.v.AsTime()
+	}
+	if err := p.Validate(); err != nil {
+		return promo.Promotion{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return p, nil
+}
+
+func promotionToPB(p promo.Promotion) *catalog.Promotion {
+	pp := &catalog.Promotion{
+		Id:        p.ID,
+		Name:      p.Name,
+		TargetId:  p.TargetID,
+		Priority:  int32(p.Priority),
+		Stackable: p.Stackable,
+	}
+	for k, v := range promoKinds {
+		if v == p.Kind {
+			pp.Kind = k
+		}
+	}
+	for t, v := range promoTargets {
+		if v == p.Target {
+			pp.Target = t
+		}
+	}
+	if p.Kind == promo.Fixed {
+		pp.Amount = moneyToPB(p.Amount)
+	} else {
+		pp.Percent = p.Value
+	}
+	if !p.StartsAt.IsZero() {
+		pp.StartsAt = timestamppb.New(p.StartsAt)
+	}
+	if !p.EndsAt.IsZero() {
+		pp.EndsAt = timestamppb.New(p.EndsAt)
+	}
+	return pp
+}
@@ -0,0 +1,239 @@
+package handler
+
+import (
+	"context"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/pb/catalog"
+	"github.com/glekoz/online-shop_proto/product"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// RateLimit - token bucket: Rate запросов в секунду с запасом Burst.
+// Нулевой Rate означает отсутствие лимита.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+type Limits struct {
+	// Read и Write - лимиты по умолчанию на одного клиента для чтения и изменения каталога
+	Read  RateLimit
+	Write RateLimit
+	// Methods переопределяет лимит для отдельных методов ("/GRPCProduct/GetAll")
+	Methods map[string]RateLimit
+	// MaxInFlight - сколько запросов обрабатывается одновременно, остальные
+	// сразу получают ResourceExhausted; держим меньше размера пула pgx,
+	// чтобы запросы не копились в ожидании соединения
+	MaxInFlight int
+}
+
+// writeMethods - методы, изменяющие каталог; всё остальное считается чтением
+var writeMethods = map[string]bool{
+	product.GRPCProduct_Create_FullMethodName: true,
+	product.GRPCProduct_Update_FullMethodName: true,
+	product.GRPCProduct_Delete_FullMethodName: true,
+
+	catalog.GRPCCategory_Create_FullMethodName:               true,
+	catalog.GRPCCategory_Update_FullMethodName:               true,
+	catalog.GRPCCategory_Delete_FullMethodName:               true,
+	catalog.GRPCCategory_SetProductCategories_FullMethodName: true,
+
+	catalog.GRPCAttribute_Define_FullMethodName:               true,
+	catalog.GRPCAttribute_Delete_FullMethodName:               true,
+	catalog.GRPCAttribute_SetProductAttributes_FullMethodName: true,
+
+	catalog.GRPCVariant_Create_FullMethodName: true,
+	catalog.GRPCVariant_Update_FullMethodName: true,
+	catalog.GRPCVariant_Delete_FullMethodName: true,
+
+	catalog.GRPCInventory_Adjust_FullMethodName:               true,
+	catalog.GRPCInventory_SetLowStockThreshold_FullMethodName: true,
+
+	catalog.GRPCReservation_Reserve_FullMethodName: true,
+	catalog.GRPCReservation_Commit_FullMethodName:  true,
+	catalog.GRPCReservation_Release_FullMethodName: true,
+
+	catalog.GRPCPricing_SetBasePrice_FullMethodName: true,
+	catalog.GRPCPricing_SetPrice_FullMethodName:     true,
+	catalog.GRPCPricing_DeletePrice_FullMethodName:  true,
+	catalog.GRPCPricing_SetRate_FullMethodName:      true,
+	catalog.GRPCPricing_DeleteRate_FullMethodName:   true,
+
+	catalog.GRPCPricing_SchedulePrice_FullMethodName:        true,
+	catalog.GRPCPricing_CancelScheduledPrice_FullMethodName: true,
+
+	catalog.GRPCPromotion_Create_FullMethodName: true,
+	catalog.GRPCPromotion_Update_FullMethodName: true,
+	catalog.GRPCPromotion_Delete_FullMethodName: true,
+
+	catalog.GRPCImage_Upload_FullMethodName:     true,
+	catalog.GRPCImage_Delete_FullMethodName:     true,
+	catalog.GRPCImage_SetPrimary_FullMethodName: true,
+	catalog.GRPCImage_Reorder_FullMethodName:    true,
+
+	catalog.GRPCSearch_SetProductLanguage_FullMethodName: true,
+
+	catalog.GRPCImport_Import_FullMethodName: true,
+
+	catalog.GRPCProducts_BulkUpdatePrices_FullMethodName: true,
+	catalog.GRPCProducts_BulkDelete_FullMethodName:       true,
+	catalog.GRPCProducts_SetStatus_FullMethodName:        true,
+	catalog.GRPCProducts_SetSchedule_FullMethodName:      true,
+
+	catalog.GRPCTranslation_Set_FullMethodName:    true,
+	catalog.GRPCTranslation_Delete_FullMethodName: true,
+}
+
+const limiterIdleTTL = 10 * time.Minute
+
+var healthMethodPrefix = "/" + healthpb.Health_ServiceDesc.ServiceName + "/"
+
+type limiterEntry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+type rateLimiter struct {
+	limits   Limits
+	inFlight chan struct{}
+
+	mu        sync.Mutex
+	clients   map[string]*limiterEntry
+	cleanedAt time.Time
+}
+
+func newRateLimiter(l Limits) *rateLimiter {
+	rl := &rateLimiter{
+		limits:    l,
+		clients:   make(map[string]*limiterEntry),
+		cleanedAt: time.Now(),
+	}
+	if l.MaxInFlight > 0 {
+		rl.inFlight = make(chan struct{}, l.MaxInFlight)
+	}
+	return rl
+}
+
+func (rl *rateLimiter) unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		release, err := rl.admit(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+		return handler(ctx, req)
+	}
+}
+
+func (rl *rateLimiter) stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		release, err := rl.admit(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		defer release()
+		return handler(srv, ss)
+	}
+}
+
+// admit сначала проверяет лимит клиента, потом берет слот конкурентности.
+// Health-проверки не ограничиваются: под нагрузкой проба не должна падать,
+// иначе под перезапустят как раз тогда, когда он нужнее всего
+func (rl *rateLimiter) admit(ctx context.Context, method string) (func(), error) {
+	if strings.HasPrefix(method, healthMethodPrefix) {
+		return func() {}, nil
+	}
+	if limit := rl.limitFor(method); limit.Rate > 0 {
+		r := rl.limiter(clientKey(ctx)+"|"+method, limit).Reserve()
+		if delay := r.Delay(); delay > 0 {
+			r.Cancel()
+			return nil, resourceExhausted(ctx, "rate limit exceeded for "+method, delay)
+		}
+	}
+	if rl.inFlight == nil {
+		return func() {}, nil
+	}
+	select {
+	case rl.inFlight <- struct{}{}:
+		return func() { <-rl.inFlight }, nil
+	default:
+		return nil, resourceExhausted(ctx, "server is overloaded", time.Second)
+	}
+}
+
+func (rl *rateLimiter) limitFor(method string) RateLimit {
+	if l, ok := rl.limits.Methods[method]; ok {
+		return l
+	}
+	if writeMethods[method] {
+		return rl.limits.Write
+	}
+	return rl.limits.Read
+}
+
+func (rl *rateLimiter) limiter(key string, l RateLimit) *rate.Limiter {
+	now := time.Now()
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	// раз в limiterIdleTTL выкидываем клиентов, которые давно не приходили
+	if now.Sub(rl.cleanedAt) > limiterIdleTTL {
+		for k, e := range rl.clients {
+			if now.Sub(e.lastSeen) > limiterIdleTTL {
+				delete(rl.clients, k)
+			}
+		}
+		rl.cleanedAt = now
+	}
+	e, ok := rl.clients[key]
+	if !ok {
+		burst := l.Burst
+		if burst < 1 {
+			burst = 1
+		}
+		e = &limiterEntry{limiter: rate.NewLimiter(rate.Limit(l.Rate), burst)}
+		rl.clients[key] = e
+	}
+	e.lastSeen = now
+	return e.limiter
+}
+
+// clientKey - subject из JWT, если клиент аутентифицирован, иначе IP адрес
+func clientKey(ctx context.Context) string {
+	if c, ok := auth.ClaimsFromContext(ctx); ok {
+		return "sub:" + c.Subject
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return "ip:" + host
+		}
+		return "ip:" + p.Addr.String()
+	}
+	return "unknown"
+}
+
+// resourceExhausted добавляет подсказку клиенту, когда повторить запрос:
+// retry-after в metadata (в секундах, округление вверх) и RetryInfo в деталях статуса
+func resourceExhausted(ctx context.Context, msg string, delay time.Duration) error {
+	secs := int(math.Ceil(delay.Seconds()))
+	grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.Itoa(secs)))
+	st := status.New(codes.ResourceExhausted, msg)
+	if det, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)}); err == nil {
+		st = det
+	}
+	return st.Err()
+}
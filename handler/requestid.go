@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"context"
+	"unicode"
+
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// requestIDHeader - id запроса от шлюза; если его нет, создаем свой
+// и возвращаем клиенту в заголовке ответа
+const requestIDHeader = "x-request-id"
+
+// maxRequestIDLength - длиннее id не принимаем: он пишется в логи и в аудит
+const maxRequestIDLength = 64
+
+func requestIDUnary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, id := withRequestID(ctx)
+		grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, id))
+		return handler(ctx, req)
+	}
+}
+
+func requestIDStream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, id := withRequestID(ss.Context())
+		ss.SetHeader(metadata.Pairs(requestIDHeader, id))
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// withRequestID кладет id запроса из metadata в LogData
+func withRequestID(ctx context.Context) (context.Context, string) {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(requestIDHeader); len(v) > 0 && validRequestID(v[0]) {
+			id = v[0]
+		}
+	}
+	if id == "" {
+		if u, err := uuid.NewV7(); err == nil {
+			id = u.String()
+		}
+	}
+	return log.WithRequestID(ctx, id), id
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		if r > unicode.MaxASCII || !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/pb/catalog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ReservationService struct {
+	app ReservationAppAPI
+	catalog.UnimplementedGRPCReservationServer
+}
+
+type ReservationAppAPI interface {
+	Reserve(ctx context.Context, id string, items []models.ReservationItem, ttl time.Duration) (models.Reservation, error)
+	CommitReservation(ctx context.Context, id string) (models.Reservation, error)
+	ReleaseReservation(ctx context.Context, id string) (models.Reservation, error)
+	GetReservation(ctx context.Context, id string) (models.Reservation, error)
+}
+
+const (
+	maxReservationTTL   = 24 * time.Hour
+	maxReservationItems = 100
+)
+
+var reservationStatuses = map[models.ReservationStatus]catalog.ReservationStatus{
+	models.ReservationActive:    catalog.ReservationStatus_RESERVATION_STATUS_ACTIVE,
+	models.ReservationCommitted: catalog.ReservationStatus_RESERVATION_STATUS_COMMITTED,
+	models.ReservationReleased:  catalog.ReservationStatus_RESERVATION_STATUS_RELEASED,
+	models.ReservationExpired:   catalog.ReservationStatus_RESERVATION_STATUS_EXPIRED,
+}
+
+func (s *ReservationService) Reserve(ctx context.Context, req *catalog.ReserveRequest) (*catalog.Reservation, error) {
+	if req.GetReservationId() == "" || len(req.GetReservationId()) > 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "reservation_id is required and must be up to 100 characters")
+	}
+	if len(req.GetItems()) == 0 || len(req.GetItems()) > maxReservationItems {
+		return nil, status.Errorf(codes.InvalidArgument, "from 1 to %d items are required", maxReservationItems)
+	}
+	items := make([]models.ReservationItem, len(req.GetItems()))
+	for i, it := range req.GetItems() {
+		if it.GetProductId() == "" || it.GetQuantity() <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "product_id and positive quantity are required for every item")
+		}
+		items[i] = models.ReservationItem{ProductID: it.GetProductId(), Location: it.GetLocation(), Quantity: int(it.GetQuantity())}
+	}
+	var ttl time.Duration
+	if req.GetTtl() != nil {
+		ttl = req.GetTtl().AsDuration()
+		if ttl <= 0 || ttl > maxReservationTTL {
+			return nil, status.Errorf(codes.InvalidArgument, "ttl must be positive and not longer than %v", maxReservationTTL)
+		}
+	}
+	res, err := s.app.Reserve(ctx, req.GetReservationId(), items, ttl)
+	if err != nil {
+		return nil, reservationStatus(ctx, err, req.GetReservationId())
+	}
+	return reservationToPB(res), nil
+}
+
+func (s *ReservationService) Commit(ctx context.Context, req *catalog.ReservationID) (*catalog.Reservation, error) {
+	if req.GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	res, err := s.app.CommitReservation(ctx, req.GetId())
+	if err != nil {
+		return nil, reservationStatus(ctx, err, req.GetId())
+	}
+	return reservationToPB(res), nil
+}
+
+func (s *ReservationService) Release(ctx context.Context, req *catalog.ReservationID) (*catalog.Reservation, error) {
+	if req.GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	res, err := s.app.ReleaseReservation(ctx, req.GetId())
+	if err != nil {
+		return nil, reservationStatus(ctx, err, req.GetId())
+	}
+	return reservationToPB(res), nil
+}
+
+func (s *ReservationService) Get(ctx context.Context, req *catalog.ReservationID) (*catalog.Reservation, error) {
+	if req.GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	res, err := s.app.GetReservation(ctx, req.GetId())
+	if err != nil {
+		return nil, reservationStatus(ctx, err, req.GetId())
+	}
+	return reservationToPB(res), nil
+}
+
+func reservationStatus(ctx context.Context, err error, id string) error {
+	switch {
+	case errors.Is(err, models.ErrNotFound):
+		return status.Errorf(codes.NotFound, "reservation %s not found", id)
+	case errors.Is(err, models.ErrInsufficientStock),
+		errors.Is(err, models.ErrReservationClosed),
+		errors.Is(err, models.ErrReservationExpired):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, models.ErrReservationConflict):
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	slog.ErrorContext(log.ErrorContext(ctx, err), "reservation "+id+": "+err.Error())
+	return status.Error(codes.Internal, err.Error())
+}
+
+func reservationToPB(r models.Reservation) *catalog.Reservation {
+	res := &catalog.Reservation{
+		Id:        r.ID,
+		Status:    reservationStatuses[r.Status],
+		ExpiresAt: timestamppb.New(r.ExpiresAt),
+	}
+	for _, it := range r.Items {
+		res.Items = append(res.Items, &catalog.ReservationItem{ProductId: it.ProductID, Location: it.Location, Quantity: int32(it.Quantity)})
+	}
+	return res
+}
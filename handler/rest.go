@@ -0,0 +1,331 @@
+package handler
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+
+	"github.com/glekoz/online-shop_product/pkg/i18n"
+	"github.com/glekoz/online-shop_product/pkg/pb/catalog"
+	"github.com/glekoz/online-shop_proto/product"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// REST API - тонкая обертка над gRPC методами ProductService: те же валидация,
+// маппинг ошибок, авторизация и лимиты. Каждый маршрут привязан к gRPC методу,
+// по имени которого ищутся роли в auth.Policy и лимиты в Limits.
+
+//go:embed openapi.json
+var openAPISpec []byte
+
+const maxBodySize = 1 << 20
+
+type restProduct struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Price       int32  `json:"price"`
+}
+
+// restPatch - поля, которые не пришли в PATCH, остаются без изменений
+type restPatch struct {
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+	Price       *int32  `json:"price"`
+}
+
+type restDigest struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Price int32  `json:"price"`
+}
+
+// restBySlug - ответ витринного GET /p/{slug}; цены в минорных единицах currency
+type restBySlug struct {
+	ID             string `json:"id"`
+	Slug           string `json:"slug"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	Price          int64  `json:"price"`
+	EffectivePrice int64  `json:"effective_price"`
+	Currency       string `json:"currency"`
+}
+
+type restCreated struct {
+	ID      string        `json:"id"`
+	Similar []restSimilar `json:"similar_products,omitempty"`
+}
+
+type restSimilar struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	Similarity float32 `json:"similarity"`
+}
+
+// problem - RFC 7807
+type problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+}
+
+type restHandler func(ctx context.Context, w http.ResponseWriter, r *http.Request) error
+
+func (ps *ProductService) restMux() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("GET /products", ps.rest(product.GRPCProduct_GetAll_FullMethodName, ps.restGetAll))
+	mux.Handle("POST /products", ps.rest(product.GRPCProduct_Create_FullMethodName, ps.restCreate))
+	mux.Handle("GET /products/{id}", ps.rest(product.GRPCProduct_Get_FullMethodName, ps.restGet))
+	mux.Handle("PUT /products/{id}", ps.rest(product.GRPCProduct_Update_FullMethodName, ps.restUpdate))
+	mux.Handle("PATCH /products/{id}", ps.rest(product.GRPCProduct_Update_FullMethodName, ps.restPatch))
+	mux.Handle("DELETE /products/{id}", ps.rest(product.GRPCProduct_Delete_FullMethodName, ps.restDelete))
+	if ps.opts.products != nil {
+		mux.Handle("GET /p/{slug}", ps.rest(catalog.GRPCProducts_GetBySlug_FullMethodName, ps.restGetBySlug))
+	}
+	mux.HandleFunc("GET /openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(openAPISpec)
+	})
+	return mux
+}
+
+// rest переносит Authorization, X-Request-Id, X-Tenant-Id, Accept-Language и адрес клиента туда же, где их ищут
+// gRPC интерсепторы, и прогоняет запрос через auth и лимитер
+func (ps *ProductService) rest(method string, h restHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		md := metadata.MD{}
+		if a := r.Header.Get("Authorization"); a != "" {
+			md.Set("authorization", a)
+		}
+		if id := r.Header.Get(requestIDHeader); id != "" {
+			md.Set(requestIDHeader, id)
+		}
+		if t := r.Header.Get(tenantHeader); t != "" {
+			md.Set(tenantHeader, t)
+		}
+		if l := r.Header.Get(localeHeader); l != "" {
+			md.Set(localeHeader, l)
+		}
+		ctx = metadata.NewIncomingContext(ctx, md)
+		ctx, id := withRequestID(ctx)
+		w.Header().Set(requestIDHeader, id)
+		if ap, err := netip.ParseAddrPort(r.RemoteAddr); err == nil {
+			ctx = peer.NewContext(ctx, &peer.Peer{Addr: net.TCPAddrFromAddrPort(ap)})
+		}
+
+		var err error
+		if ps.opts.auth != nil {
+			if ctx, err = ps.opts.auth.authorize(ctx, method); err != nil {
+				writeProblem(w, r, err)
+				return
+			}
+		}
+		if ctx, err = withTenant(ctx); err != nil {
+			writeProblem(w, r, err)
+			return
+		}
+		ctx = withLocale(ctx)
+		if ps.limiter != nil {
+			release, err := ps.limiter.admit(ctx, method)
+			if err != nil {
+				writeProblem(w, r, err)
+				return
+			}
+			defer release()
+		}
+		if err := h(ctx, w, r); err != nil {
+			writeProblem(w, r, err)
+		}
+	})
+}
+
+func (ps *ProductService) restGetAll(ctx context.Context, w http.ResponseWriter, r *http.Request) error {
+	resp, err := ps.GetAll(ctx, nil)
+	if err != nil {
+		return err
+	}
+	prods := make([]restDigest, len(resp.GetProducts()))
+	for i, p := range resp.GetProducts() {
+		prods[i] = restDigest{ID: p.GetId(), Name: p.GetName(), Price: p.GetPrice()}
+	}
+	return writeJSON(w, http.StatusOK, map[string][]restDigest{"products": prods})
+}
+
+func (ps *ProductService) restGet(ctx context.Context, w http.ResponseWriter, r *http.Request) error {
+	p, locale, err := ps.get(ctx, &product.ID{Id: r.PathValue("id")})
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Language", locale)
+	return writeJSON(w, http.StatusOK, restProduct{
+		Name:        p.GetName(),
+		Description: p.GetDescription(),
+		Price:       p.GetPrice(),
+	})
+}
+
+// restGetBySlug отвечает 301 на текущий адрес, если слаг старый
+func (ps *ProductService) restGetBySlug(ctx context.Context, w http.ResponseWriter, r *http.Request) error {
+	s := &ProductsService{app: ps.opts.products}
+	resp, err := s.GetBySlug(ctx, &catalog.GetBySlugRequest{Slug: r.PathValue("slug")})
+	if err != nil {
+		return err
+	}
+	p := resp.GetProduct()
+	if resp.GetMoved() {
+		http.Redirect(w, r, "/p/"+p.GetSlug(), http.StatusMovedPermanently)
+		return nil
+	}
+	w.Header().Set("Content-Language", p.GetLocale())
+	return writeJSON(w, http.StatusOK, restBySlug{
+		ID:             p.GetId(),
+		Slug:           p.GetSlug(),
+		Name:           p.GetName(),
+		Description:    p.GetDescription(),
+		Price:          p.GetPrice().GetAmount(),
+		EffectivePrice: p.GetEffectivePrice().GetAmount(),
+		Currency:       p.GetPrice().GetCurrency(),
+	})
+}
+
+func (ps *ProductService) restCreate(ctx context.Context, w http.ResponseWriter, r *http.Request) error {
+	var body restProduct
+	if err := decodeBody(w, r, &body); err != nil {
+		return err
+	}
+	id, similar, err := ps.create(ctx, &product.Product{
+		Name:        body.Name,
+		Description: body.Description,
+		Price:       body.Price,
+	})
+	if err != nil {
+		return err
+	}
+	resp := restCreated{ID: id, Similar: make([]restSimilar, len(similar))}
+	for i, p := range similar {
+		resp.Similar[i] = restSimilar{ID: p.ID, Name: p.Name, Similarity: p.Similarity}
+	}
+	w.Header().Set("Location", "/products/"+id)
+	return writeJSON(w, http.StatusCreated, resp)
+}
+
+func (ps *ProductService) restUpdate(ctx context.Context, w http.ResponseWriter, r *http.Request) error {
+	var body restProduct
+	if err := decodeBody(w, r, &body); err != nil {
+		return err
+	}
+	return ps.restSave(ctx, w, r.PathValue("id"), &product.Product{
+		Name:        body.Name,
+		Description: body.Description,
+		Price:       body.Price,
+	})
+}
+
+func (ps *ProductService) restPatch(ctx context.Context, w http.ResponseWriter, r *http.Request) error {
+	var body restPatch
+	if err := decodeBody(w, r, &body); err != nil {
+		return err
+	}
+	id := r.PathValue("id")
+	// без перевода: иначе PATCH запишет в товар название на языке клиента
+	cur, _, err := ps.get(i18n.WithPreferences(ctx, nil), &product.ID{Id: id})
+	if err != nil {
+		return err
+	}
+	if body.Name != nil {
+		cur.Name = *body.Name
+	}
+	if body.Description != nil {
+		cur.Description = *body.Description
+	}
+	if body.Price != nil {
+		cur.Price = *body.Price
+	}
+	return ps.restSave(ctx, w, id, cur)
+}
+
+func (ps *ProductService) restSave(ctx context.Context, w http.ResponseWriter, id string, p *product.Product) error {
+	if _, err := ps.Update(ctx, &product.UpdateRequest{Id: id, Product: p}); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (ps *ProductService) restDelete(ctx context.Context, w http.ResponseWriter, r *http.Request) error {
+	if _, err := ps.Delete(ctx, &product.ID{Id: r.PathValue("id")}); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) error {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		if errors.Is(err, io.EOF) {
+			return status.Error(codes.InvalidArgument, "request body is empty")
+		}
+		return status.Errorf(codes.InvalidArgument, "invalid JSON body: %v", err)
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	return json.NewEncoder(w).Encode(v)
+}
+
+// httpStatus - соответствие кодов gRPC и HTTP, как в grpc-gateway
+var httpStatus = map[codes.Code]int{
+	codes.OK:                 http.StatusOK,
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.FailedPrecondition: http.StatusBadRequest,
+	codes.Aborted:            http.StatusConflict,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+	codes.Unimplemented:      http.StatusNotImplemented,
+}
+
+func writeProblem(w http.ResponseWriter, r *http.Request, err error) {
+	st := status.Convert(err)
+	code, ok := httpStatus[st.Code()]
+	if !ok {
+		code = http.StatusInternalServerError
+	}
+	for _, d := range st.Details() {
+		if ri, ok := d.(*errdetails.RetryInfo); ok {
+			secs := int(math.Ceil(ri.GetRetryDelay().AsDuration().Seconds()))
+			w.Header().Set("Retry-After", strconv.Itoa(secs))
+		}
+	}
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(problem{
+		Type:     "about:blank",
+		Title:    http.StatusText(code),
+		Status:   code,
+		Detail:   st.Message(),
+		Instance: r.URL.Path,
+	})
+}
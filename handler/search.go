@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+	"github.com/glekoz/online-shop_product/pkg/pb/catalog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// maxQueryLength - длиннее поисковые запросы не бывают, а tsquery из них строится дорого
+const maxQueryLength = 256
+
+type SearchService struct {
+	app SearchAppAPI
+	catalog.UnimplementedGRPCSearchServer
+}
+
+type SearchAppAPI interface {
+	Search(ctx context.Context, q models.SearchQuery) (models.SearchResult, error)
+	SetProductSearchLanguage(ctx context.Context, productID, lang string) error
+	FuzzySearch(ctx context.Context, q models.FuzzyQuery) (models.SearchResult, error)
+	Suggest(ctx context.Context, query string, limit int) ([]string, error)
+}
+
+func (s *SearchService) Search(ctx context.Context, req *catalog.SearchRequest) (*catalog.SearchResponse, error) {
+	if req.GetQuery() == "" || len(req.GetQuery()) > maxQueryLength {
+		return nil, status.Errorf(codes.InvalidArgument, "query is required and must be up to %d bytes", maxQueryLength)
+	}
+	if req.GetLimit() < 0 || req.GetOffset() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+	if req.MinPrice != nil && req.GetMinPrice() < 0 || req.MaxPrice != nil && req.GetMaxPrice() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "price bounds must not be negative")
+	}
+	if req.MinPrice != nil && req.MaxPrice != nil && req.GetMinPrice() > req.GetMaxPrice() {
+		return nil, status.Errorf(codes.InvalidArgument, "min_price must not exceed max_price")
+	}
+	if req.GetCurrency() != "" {
+		if _, err := money.Exponent(req.GetCurrency()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	res, err := s.app.Search(ctx, models.SearchQuery{
+		Query:    req.GetQuery(),
+		Prefix:   req.GetPrefix(),
+		Language: req.GetLanguage(),
+		MinPrice: req.MinPrice,
+		MaxPrice: req.MaxPrice,
+		Currency: req.GetCurrency(),
+		Limit:    int(req.GetLimit()),
+		Offset:   int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, searchStatus(err, "")
+	}
+	return searchResultToPB(res), nil
+}
+
+func (s *SearchService) Fuzzy(ctx context.Context, req *catalog.FuzzySearchRequest) (*catalog.SearchResponse, error) {
+	if req.GetQuery() == "" || len(req.GetQuery()) > maxQueryLength {
+		return nil, status.Errorf(codes.InvalidArgument, "query is required and must be up to %d bytes", maxQueryLength)
+	}
+	if req.GetLimit() < 0 || req.GetOffset() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+	if t := req.GetThreshold(); t < 0 || t > 1 {
+		return nil, status.Error(codes.InvalidArgument, models.ErrInvalidThreshold.Error())
+	}
+	res, err := s.app.FuzzySearch(ctx, models.FuzzyQuery{
+		Query:     req.GetQuery(),
+		Threshold: req.GetThreshold(),
+		Limit:     int(req.GetLimit()),
+		Offset:    int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, searchStatus(err, "")
+	}
+	return searchResultToPB(res), nil
+}
+
+func (s *SearchService) Suggest(ctx context.Context, req *catalog.SuggestRequest) (*catalog.Suggestions, error) {
+	if req.GetQuery() == "" || len(req.GetQuery()) > maxQueryLength {
+		return nil, status.Errorf(codes.InvalidArgument, "query is required and must be up to %d bytes", maxQueryLength)
+	}
+	if req.GetLimit() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not be negative")
+	}
+	names, err := s.app.Suggest(ctx, req.GetQuery(), int(req.GetLimit()))
+	if err != nil {
+		return nil, searchStatus(err, "")
+	}
+	return &catalog.Suggestions{Names: names}, nil
+}
+
+func (s *SearchService) SetProductLanguage(ctx context.Context, req *catalog.ProductLanguage) (*emptypb.Empty, error) {
+	if req.GetProductId() == "" || req.GetLanguage() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id and language are required")
+	}
+	if err := s.app.SetProductSearchLanguage(ctx, req.GetProductId(), req.GetLanguage()); err != nil {
+		return nil, searchStatus(err, req.GetProductId())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func searchStatus(err error, key string) error {
+	switch {
+	case errors.Is(err, models.ErrNotFound):
+		return status.Errorf(codes.NotFound, "%s not found", key)
+	case errors.Is(err, models.ErrEmptyQuery),
+		errors.Is(err, models.ErrUnknownLanguage),
+		errors.Is(err, models.ErrInvalidThreshold):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func searchResultToPB(res models.SearchResult) *catalog.SearchResponse {
+	resp := &catalog.SearchResponse{
+		Hits:       make([]*catalog.SearchHit, len(res.Hits)),
+		Total:      int32(res.Total),
+		DidYouMean: res.DidYouMean,
+	}
+	for i, h := range res.Hits {
+		resp.Hits[i] = &catalog.SearchHit{
+			Product:       digestToPB(h.ProductDigest),
+			Rank:          h.Rank,
+			NameHighlight: h.NameHighlight,
+			Snippet:       h.Snippet,
+		}
+	}
+	return resp
+}
@@ -1,15 +1,247 @@
 package handler
 
 import (
+	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
+	"sync"
+	"time"
 
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/pb/catalog"
 	"github.com/glekoz/online-shop_proto/product"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/reflection"
 )
 
-func NewServer(app AppAPI) *ProductService {
-	return &ProductService{app: app}
+type options struct {
+	reflection    bool
+	healthPort    int
+	checks        map[string]CheckFunc
+	checkInterval time.Duration
+	drainTimeout  time.Duration
+	auth          *authenticator
+	tls           *tls.Config
+	restTLS       *tls.Config
+	limits        *Limits
+	restPort      int
+	categories    CategoryAppAPI
+	attributes    AttributeAppAPI
+	variants      VariantAppAPI
+	inventory     InventoryAppAPI
+	reservations  ReservationAppAPI
+	pricing       PricingAppAPI
+	promotions    PromotionAppAPI
+	images        ImageAppAPI
+	search        SearchAppAPI
+	imports       ImportAppAPI
+	exports       ExportAppAPI
+	products      ProductsAppAPI
+	audit         AuditAppAPI
+	translations  TranslationAppAPI
+}
+
+type Option func(options *options)
+
+// WithReflection включает gRPC reflection (удобно для grpcurl и evans),
+// в проде лучше держать выключенным
+func WithReflection(enabled bool) Option {
+	return func(options *options) {
+		options.reflection = enabled
+	}
+}
+
+// WithHealthPort поднимает HTTP-сервер с /healthz и /readyz на отдельном порту
+func WithHealthPort(port int) Option {
+	return func(options *options) {
+		options.healthPort = port
+	}
+}
+
+// WithHealthCheck добавляет зависимость, состояние которой публикуется
+// в grpc.health.v1 под именем name и влияет на общий статус сервиса
+func WithHealthCheck(name string, check CheckFunc) Option {
+	return func(options *options) {
+		if options.checks == nil {
+			options.checks = make(map[string]CheckFunc)
+		}
+		options.checks[name] = check
+	}
+}
+
+func WithCheckInterval(interval time.Duration) Option {
+	return func(options *options) {
+		options.checkInterval = interval
+	}
+}
+
+// WithDrainTimeout задает, сколько ждать после перевода в NOT_SERVING,
+// чтобы балансировщик успел убрать под из ротации
+func WithDrainTimeout(timeout time.Duration) Option {
+	return func(options *options) {
+		options.drainTimeout = timeout
+	}
+}
+
+// WithAuth включает проверку JWT и ролей для всех RPC согласно policy
+func WithAuth(verifier *auth.Verifier, policy auth.Policy) Option {
+	return func(options *options) {
+		options.auth = &authenticator{verifier: verifier, policy: policy}
+	}
+}
+
+// WithTLS включает TLS на gRPC порту; для mTLS и ротации сертификатов
+// конфиг берется из tlsutil.Reloader. HTTP health-порт остается plaintext,
+// его опрашивает kubelet.
+func WithTLS(cfg *tls.Config) Option {
+	return func(options *options) {
+		options.tls = cfg
+	}
+}
+
+// WithRESTTLS включает TLS на REST порту. Конфиг отдельный от gRPC: REST
+// нужен HTTP/1.1, а mTLS на нем включается явно (tlsutil.Reloader.HTTPConfig)
+func WithRESTTLS(cfg *tls.Config) Option {
+	return func(options *options) {
+		options.restTLS = cfg
+	}
+}
+
+// WithRateLimit включает лимиты на клиента и сброс нагрузки при перегрузке
+func WithRateLimit(l Limits) Option {
+	return func(options *options) {
+		options.limits = &l
+	}
+}
+
+// WithRESTPort поднимает REST/JSON API поверх тех же хендлеров на отдельном порту
+func WithRESTPort(port int) Option {
+	return func(options *options) {
+		options.restPort = port
+	}
+}
+
+// WithCategories регистрирует сервис категорий (catalog.GRPCCategory)
+func WithCategories(app CategoryAppAPI) Option {
+	return func(options *options) {
+		options.categories = app
+	}
+}
+
+// WithAttributes регистрирует сервис атрибутов и фасетного поиска (catalog.GRPCAttribute)
+func WithAttributes(app AttributeAppAPI) Option {
+	return func(options *options) {
+		options.attributes = app
+	}
+}
+
+// WithVariants регистрирует сервис вариантов товара (catalog.GRPCVariant)
+func WithVariants(app VariantAppAPI) Option {
+	return func(options *options) {
+		options.variants = app
+	}
+}
+
+// WithInventory регистрирует сервис складских остатков (catalog.GRPCInventory)
+func WithInventory(app InventoryAppAPI) Option {
+	return func(options *options) {
+		options.inventory = app
+	}
+}
+
+// WithReservations регистрирует сервис резервов под заказы (catalog.GRPCReservation)
+func WithReservations(app ReservationAppAPI) Option {
+	return func(options *options) {
+		options.reservations = app
+	}
+}
+
+// WithPricing регистрирует сервис мультивалютных цен и курсов (catalog.GRPCPricing)
+func WithPricing(app PricingAppAPI) Option {
+	return func(options *options) {
+		options.pricing = app
+	}
+}
+
+// WithPromotions регистрирует сервис акций и скидок (catalog.GRPCPromotion)
+func WithPromotions(app PromotionAppAPI) Option {
+	return func(options *options) {
+		options.promotions = app
+	}
+}
+
+// WithImages регистрирует сервис картинок товаров (catalog.GRPCImage)
+func WithImages(app ImageAppAPI) Option {
+	return func(options *options) {
+		options.images = app
+	}
+}
+
+// WithSearch регистрирует полнотекстовый поиск (catalog.GRPCSearch)
+func WithSearch(app SearchAppAPI) Option {
+	return func(options *options) {
+		options.search = app
+	}
+}
+
+// WithImport регистрирует массовую загрузку товаров (catalog.GRPCImport)
+func WithImport(app ImportAppAPI) Option {
+	return func(options *options) {
+		options.imports = app
+	}
+}
+
+// WithExport регистрирует выгрузку каталога (catalog.GRPCExport)
+func WithExport(app ExportAppAPI) Option {
+	return func(options *options) {
+		options.exports = app
+	}
+}
+
+// WithProducts регистрирует операции над многими товарами (catalog.GRPCProducts)
+func WithProducts(app ProductsAppAPI) Option {
+	return func(options *options) {
+		options.products = app
+	}
+}
+
+// WithAudit регистрирует журнал изменений товаров (catalog.GRPCAudit)
+func WithAudit(app AuditAppAPI) Option {
+	return func(options *options) {
+		options.audit = app
+	}
+}
+
+// WithTranslations регистрирует управление переводами товаров (catalog.GRPCTranslation)
+func WithTranslations(app TranslationAppAPI) Option {
+	return func(options *options) {
+		options.translations = app
+	}
+}
+
+func NewServer(app AppAPI, opts ...Option) *ProductService {
+	options := options{
+		checkInterval: 5 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	ps := &ProductService{
+		app:    app,
+		opts:   options,
+		health: health.NewServer(),
+		done:   make(chan struct{}),
+	}
+	// один лимитер на gRPC и REST, чтобы клиент не обходил лимиты сменой протокола
+	if options.limits != nil {
+		ps.limiter = newRateLimiter(*options.limits)
+	}
+	return ps
 }
 
 func (ps *ProductService) RunServer(port int) error {
@@ -17,7 +249,172 @@ func (ps *ProductService) RunServer(port int) error {
 	if err != nil {
 		return err
 	}
-	serv := grpc.NewServer()
+	serv := grpc.NewServer(ps.serverOptions()...)
 	product.RegisterGRPCProductServer(serv, ps)
-	return serv.Serve(listen)
+	if ps.opts.categories != nil {
+		catalog.RegisterGRPCCategoryServer(serv, &CategoryService{app: ps.opts.categories})
+	}
+	if ps.opts.attributes != nil {
+		catalog.RegisterGRPCAttributeServer(serv, &AttributeService{app: ps.opts.attributes})
+	}
+	if ps.opts.variants != nil {
+		catalog.RegisterGRPCVariantServer(serv, &VariantService{app: ps.opts.variants})
+	}
+	if ps.opts.inventory != nil {
+		catalog.RegisterGRPCInventoryServer(serv, &InventoryService{app: ps.opts.inventory})
+	}
+	if ps.opts.reservations != nil {
+		catalog.RegisterGRPCReservationServer(serv, &ReservationService{app: ps.opts.reservations})
+	}
+	if ps.opts.pricing != nil {
+		catalog.RegisterGRPCPricingServer(serv, &PricingService{app: ps.opts.pricing})
+	}
+	if ps.opts.promotions != nil {
+		catalog.RegisterGRPCPromotionServer(serv, &PromotionService{app: ps.opts.promotions})
+	}
+	if ps.opts.images != nil {
+		catalog.RegisterGRPCImageServer(serv, &ImageService{app: ps.opts.images})
+	}
+	if ps.opts.search != nil {
+		catalog.RegisterGRPCSearchServer(serv, &SearchService{app: ps.opts.search})
+	}
+	if ps.opts.imports != nil {
+		catalog.RegisterGRPCImportServer(serv, &ImportService{app: ps.opts.imports})
+	}
+	if ps.opts.exports != nil {
+		catalog.RegisterGRPCExportServer(serv, &ExportService{app: ps.opts.exports})
+	}
+	if ps.opts.products != nil {
+		catalog.RegisterGRPCProductsServer(serv, &ProductsService{app: ps.opts.products})
+	}
+	if ps.opts.audit != nil {
+		catalog.RegisterGRPCAuditServer(serv, &AuditService{app: ps.opts.audit})
+	}
+	if ps.opts.translations != nil {
+		catalog.RegisterGRPCTranslationServer(serv, &TranslationService{app: ps.opts.translations})
+	}
+	ps.registerHealth(serv)
+	if ps.opts.reflection {
+		reflection.Register(serv)
+	}
+
+	ps.mu.Lock()
+	ps.serv = serv
+	ps.mu.Unlock()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ps.watchHealth()
+	}()
+	defer wg.Wait()
+
+	errs := make(chan error, 2)
+	if ps.opts.healthPort != 0 {
+		ps.serveHTTP(ps.opts.healthPort, ps.healthMux(), nil, serv, errs)
+	}
+	if ps.opts.restPort != 0 {
+		ps.serveHTTP(ps.opts.restPort, ps.restMux(), ps.opts.restTLS, serv, errs)
+	}
+
+	if err := serv.Serve(listen); err != nil {
+		ps.stopWatch()
+		return err
+	}
+	ps.stopWatch()
+	select {
+	case err := <-errs:
+		return err
+	default:
+		return nil
+	}
+}
+
+// serveHTTP запускает вспомогательный HTTP сервер; если он упал,
+// останавливается и gRPC, чтобы RunServer вернул ошибку
+func (ps *ProductService) serveHTTP(port int, h http.Handler, tlsCfg *tls.Config, serv *grpc.Server, errs chan<- error) {
+	hs := &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           h,
+		TLSConfig:         tlsCfg,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	ps.mu.Lock()
+	ps.httpServs = append(ps.httpServs, hs)
+	ps.mu.Unlock()
+	go func() {
+		var err error
+		if tlsCfg != nil {
+			err = hs.ListenAndServeTLS("", "")
+		} else {
+			err = hs.ListenAndServe()
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errs <- err
+			serv.Stop()
+		}
+	}()
+}
+
+func (ps *ProductService) serverOptions() []grpc.ServerOption {
+	// id запроса первым, чтобы он был и в логах отказов auth и лимитера
+	unary := []grpc.UnaryServerInterceptor{requestIDUnary()}
+	stream := []grpc.StreamServerInterceptor{requestIDStream()}
+	if ps.opts.auth != nil {
+		unary = append(unary, ps.opts.auth.unary())
+		stream = append(stream, ps.opts.auth.stream())
+	}
+	// магазин после auth: магазин из токена главнее заголовка
+	unary = append(unary, tenantUnary(), localeUnary())
+	stream = append(stream, tenantStream(), localeStream())
+	// лимитер после auth, чтобы ключом был subject из токена, а не IP шлюза
+	if ps.limiter != nil {
+		unary = append(unary, ps.limiter.unary())
+		stream = append(stream, ps.limiter.stream())
+	}
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+	if ps.opts.tls != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(ps.opts.tls)))
+	}
+	return opts
+}
+
+// Shutdown сначала переводит сервис в NOT_SERVING (readyz начинает отдавать 503),
+// ждет drainTimeout и только потом останавливает gRPC и HTTP серверы
+func (ps *ProductService) Shutdown(ctx context.Context) error {
+	ps.shuttingDown.Store(true)
+	ps.health.Shutdown()
+
+	if ps.opts.drainTimeout > 0 {
+		select {
+		case <-time.After(ps.opts.drainTimeout):
+		case <-ctx.Done():
+		}
+	}
+
+	ps.mu.Lock()
+	serv, httpServs := ps.serv, ps.httpServs
+	ps.mu.Unlock()
+
+	if serv != nil {
+		stopped := make(chan struct{})
+		go func() {
+			serv.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			serv.Stop()
+		}
+	}
+	var errs []error
+	for _, hs := range httpServs {
+		errs = append(errs, hs.Shutdown(ctx))
+	}
+	return errors.Join(errs...)
 }
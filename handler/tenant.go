@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// tenantHeader - магазин, в каталоге которого выполняется запрос. Магазин из
+// токена главнее: с токеном одного магазина в чужой каталог не попасть.
+// Токен без магазина в непубличных методах authorize привязывает к магазину
+// по умолчанию, если у него нет RoleAllTenants
+const tenantHeader = "x-tenant-id"
+
+// maxTenantLength - по ширине колонки tenant_id
+const maxTenantLength = 50
+
+func tenantUnary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := withTenant(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func tenantStream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := withTenant(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// withTenant кладет магазин в LogData: из токена, если он там есть,
+// иначе из metadata, иначе магазин по умолчанию
+func withTenant(ctx context.Context) (context.Context, error) {
+	var header string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(tenantHeader); len(v) > 0 {
+			header = v[0]
+		}
+	}
+	tenant := header
+	if c, ok := auth.ClaimsFromContext(ctx); ok && c.Tenant != "" {
+		if header != "" && header != c.Tenant {
+			return nil, status.Error(codes.PermissionDenied, "token is issued for another tenant")
+		}
+		tenant = c.Tenant
+	}
+	if tenant == "" {
+		tenant = models.DefaultTenant
+	}
+	if !validTenant(tenant) {
+		return nil, status.Error(codes.InvalidArgument, "invalid tenant id")
+	}
+	return log.WithTenantID(ctx, tenant), nil
+}
+
+// validTenant - латиница, цифры, '-' и '_'. '*' в базе значит "все магазины",
+// поэтому снаружи его принимать нельзя
+func validTenant(id string) bool {
+	if id == "" || len(id) > maxTenantLength {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
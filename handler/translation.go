@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/glekoz/online-shop_product/pkg/i18n"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/pb/catalog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TranslationService struct {
+	app TranslationAppAPI
+	catalog.UnimplementedGRPCTranslationServer
+}
+
+type TranslationAppAPI interface {
+	SetTranslation(ctx context.Context, productID string, t models.Translation) error
+	DeleteTranslation(ctx context.Context, productID, locale string) error
+	ListTranslations(ctx context.Context, productID string) ([]models.Translation, error)
+	ListMissingTranslations(ctx context.Context, locale string, limit, offset int) ([]models.UntranslatedProduct, error)
+}
+
+func (s *TranslationService) Set(ctx context.Context, req *catalog.SetTranslationRequest) (*emptypb.Empty, error) {
+	t := req.GetTranslation()
+	if req.GetProductId() == "" || t.GetLocale() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id and locale are required")
+	}
+	if t.GetName() == "" || t.GetDescription() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name and description are required")
+	}
+	err := s.app.SetTranslation(ctx, req.GetProductId(), models.Translation{
+		Locale:      t.GetLocale(),
+		Name:        t.GetName(),
+		Description: t.GetDescription(),
+	})
+	if err != nil {
+		return nil, translationStatus(err, req.GetProductId())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TranslationService) Delete(ctx context.Context, req *catalog.DeleteTranslationRequest) (*emptypb.Empty, error) {
+	if req.GetProductId() == "" || req.GetLocale() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id and locale are required")
+	}
+	if err := s.app.DeleteTranslation(ctx, req.GetProductId(), req.GetLocale()); err != nil {
+		return nil, translationStatus(err, req.GetLocale()+" translation of "+req.GetProductId())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TranslationService) List(ctx context.Context, req *catalog.ListTranslationsRequest) (*catalog.Translations, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	list, err := s.app.ListTranslations(ctx, req.GetProductId())
+	if err != nil {
+		return nil, translationStatus(err, req.GetProductId())
+	}
+	resp := &catalog.Translations{Translations: make([]*catalog.Translation, len(list))}
+	for i, t := range list {
+		resp.Translations[i] = &catalog.Translation{
+			Locale:      t.Locale,
+			Name:        t.Name,
+			Description: t.Description,
+			UpdatedAt:   timestamppb.New(t.UpdatedAt),
+		}
+	}
+	return resp, nil
+}
+
+func (s *TranslationService) ListMissing(ctx context.Context, req *catalog.ListMissingTranslationsRequest) (*catalog.UntranslatedProducts, error) {
+	if req.GetLocale() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "locale is required")
+	}
+	if req.GetLimit() < 0 || req.GetOffset() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+	prods, err := s.app.ListMissingTranslations(ctx, req.GetLocale(), int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		return nil, translationStatus(err, req.GetLocale())
+	}
+	resp := &catalog.UntranslatedProducts{Products: make([]*catalog.UntranslatedProduct, len(prods))}
+	for i, p := range prods {
+		resp.Products[i] = &catalog.UntranslatedProduct{Id: p.ID, Name: p.Name}
+	}
+	return resp, nil
+}
+
+func translationStatus(err error, key string) error {
+	switch {
+	case errors.Is(err, models.ErrNotFound):
+		return status.Errorf(codes.NotFound, "%s not found", key)
+	case errors.Is(err, i18n.ErrInvalidLocale), errors.Is(err, models.ErrDefaultLocale):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"regexp"
+
+	"github.com/glekoz/online-shop_product/pkg/barcode"
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/pb/catalog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type VariantService struct {
+	app VariantAppAPI
+	catalog.UnimplementedGRPCVariantServer
+}
+
+type VariantAppAPI interface {
+	CreateVariant(ctx context.Context, v models.Variant) (string, error)
+	GetVariant(ctx context.Context, id string) (models.Variant, error)
+	GetVariantBySKU(ctx context.Context, sku string) (models.Variant, error)
+	ListVariants(ctx context.Context, productID string) ([]models.Variant, error)
+	UpdateVariant(ctx context.Context, id string, v models.Variant) error
+	DeleteVariant(ctx context.Context, id string) error
+}
+
+var skuRe = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,63}$`)
+
+func (s *VariantService) Create(ctx context.Context, req *catalog.Variant) (*catalog.VariantID, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	v := variantFromPB(req)
+	if err := validateVariant(v); err != nil {
+		return nil, err
+	}
+	id, err := s.app.CreateVariant(ctx, v)
+	if err != nil {
+		if errors.Is(err, models.ErrAlreadyExists) {
+			return nil, status.Error(codes.AlreadyExists, "variant with the same sku, barcode or options already exists")
+		}
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "product %s not found", v.ProductID)
+		}
+		slog.ErrorContext(log.ErrorContext(ctx, err), "variant creation: "+err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &catalog.VariantID{Id: id}, nil
+}
+
+func (s *VariantService) Get(ctx context.Context, req *catalog.VariantID) (*catalog.Variant, error) {
+	if req.GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	v, err := s.app.GetVariant(ctx, req.GetId())
+	if err != nil {
+		return nil, variantStatus(err, req.GetId())
+	}
+	return variantToPB(v), nil
+}
+
+func (s *VariantService) GetBySKU(ctx context.Context, req *catalog.VariantSKU) (*catalog.Variant, error) {
+	if req.GetSku() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "sku is required")
+	}
+	v, err := s.app.GetVariantBySKU(ctx, req.GetSku())
+	if err != nil {
+		return nil, variantStatus(err, req.GetSku())
+	}
+	return variantToPB(v), nil
+}
+
+func (s *VariantService) List(ctx context.Context, req *catalog.ProductRef) (*catalog.VariantList, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	vs, err := s.app.ListVariants(ctx, req.GetProductId())
+	if err != nil {
+		return nil, variantStatus(err, req.GetProductId())
+	}
+	resp := &catalog.VariantList{Variants: make([]*catalog.Variant, len(vs))}
+	for i, v := range vs {
+		resp.Variants[i] = variantToPB(v)
+	}
+	return resp, nil
+}
+
+func (s *VariantService) Update(ctx context.Context, req *catalog.Variant) (*emptypb.Empty, error) {
+	if req.GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	v := variantFromPB(req)
+	if err := validateVariant(v); err != nil {
+		return nil, err
+	}
+	if err := s.app.UpdateVariant(ctx, req.GetId(), v); err != nil {
+		return nil, variantStatus(err, req.GetId())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *VariantService) Delete(ctx context.Context, req *catalog.VariantID) (*emptypb.Empty, error) {
+	if req.GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	if err := s.app.DeleteVariant(ctx, req.GetId()); err != nil {
+		return nil, variantStatus(err, req.GetId())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// validateVariant: родительский товар задается при создании и дальше не меняется
+func validateVariant(v models.Variant) error {
+	if !skuRe.MatchString(v.SKU) {
+		return status.Errorf(codes.InvalidArgument, "sku is required and must contain up to 64 latin letters, digits, dots, dashes or underscores")
+	}
+	if v.PriceOverride < 0 {
+		return status.Errorf(codes.InvalidArgument, "price_override must not be negative")
+	}
+	if v.Barcode != "" {
+		if err := barcode.Validate(v.Barcode); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	for k, val := range v.Options {
+		if k == "" || val == "" {
+			return status.Errorf(codes.InvalidArgument, "option names and values must not be empty")
+		}
+	}
+	return nil
+}
+
+func variantStatus(err error, key string) error {
+	switch {
+	case errors.Is(err, models.ErrNotFound):
+		return status.Errorf(codes.NotFound, "%s not found", key)
+	case errors.Is(err, models.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, "variant with the same sku, barcode or options already exists")
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func variantFromPB(v *catalog.Variant) models.Variant {
+	return models.Variant{
+		ID:            v.GetId(),
+		ProductID:     v.GetProductId(),
+		SKU:           v.GetSku(),
+		PriceOverride: v.GetPriceOverride(),
+		Options:       v.GetOptions(),
+		Barcode:       v.GetBarcode(),
+	}
+}
+
+func variantToPB(v models.Variant) *catalog.Variant {
+	return &catalog.Variant{
+		Id:            v.ID,
+		ProductId:     v.ProductID,
+		Sku:           v.SKU,
+		PriceOverride: v.PriceOverride,
+		Price:         v.Price.Amount,
+		Currency:      v.Price.Currency,
+		Options:       v.Options,
+		Barcode:       v.Barcode,
+	}
+}
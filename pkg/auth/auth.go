@@ -0,0 +1,269 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	RolePublic       = "public"
+	RoleCatalogAdmin = "catalog-admin"
+	// RoleOrderService - сервис заказов, резервирует товар под оформление
+	RoleOrderService = "order-service"
+	// RoleAllTenants - токену без магазина можно выбирать магазин заголовком
+	// x-tenant-id и в непубличных методах, например платформенной админке
+	RoleAllTenants = "all-tenants"
+)
+
+var (
+	ErrNoToken      = errors.New("token is missing")
+	ErrInvalidToken = errors.New("token is invalid")
+)
+
+type Claims struct {
+	jwt.RegisteredClaims
+	Roles []string `json:"roles"`
+	// Tenant - магазин, к каталогу которого выпущен токен. Пустой - магазин
+	// по умолчанию; любой - только для публичных методов или с RoleAllTenants
+	Tenant string `json:"tenant,omitempty"`
+}
+
+func (c Claims) HasRole(role string) bool {
+	return slices.Contains(c.Roles, role)
+}
+
+type claimsKey struct{}
+
+func WithClaims(ctx context.Context, c Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, c)
+}
+
+func ClaimsFromContext(ctx context.Context) (Claims, bool) {
+	c, ok := ctx.Value(claimsKey{}).(Claims)
+	return c, ok
+}
+
+// Actor - кто выполняет запрос, для журналов и аудита: UserID из LogData,
+// а если его нет - subject токена; пустая строка, если запрос анонимный
+func Actor(ctx context.Context) string {
+	if ld, ok := ctx.Value(log.LogDataKey).(log.LogData); ok && ld.UserID != "" {
+		return ld.UserID
+	}
+	if c, ok := ClaimsFromContext(ctx); ok {
+		return c.Subject
+	}
+	return ""
+}
+
+// CatalogAdmin - запрос от администратора каталога: ему видны товары
+// в любом статусе, остальным - только опубликованные
+func CatalogAdmin(ctx context.Context) bool {
+	c, ok := ClaimsFromContext(ctx)
+	return ok && c.HasRole(RoleCatalogAdmin)
+}
+
+// Tenant - магазин запроса: TenantID из LogData, его выставляет перехватчик
+// после проверки токена. Без него запрос идет в магазин по умолчанию
+func Tenant(ctx context.Context) string {
+	if ld, ok := ctx.Value(log.LogDataKey).(log.LogData); ok && ld.TenantID != "" {
+		return ld.TenantID
+	}
+	return models.DefaultTenant
+}
+
+type options struct {
+	hmacSecret []byte
+	rsaKeys    map[string]*rsa.PublicKey // kid -> key из JWKS
+	pemKeys    []*rsa.PublicKey          // ключи из PEM, kid у них неизвестен
+	issuer     string
+	audience   string
+}
+
+type Option func(options *options) error
+
+func WithHMACSecret(secret []byte) Option {
+	return func(options *options) error {
+		if len(secret) < 32 {
+			return errors.New("hmac secret must be at least 32 bytes")
+		}
+		options.hmacSecret = secret
+		return nil
+	}
+}
+
+// WithRSAPublicKeyFile добавляет статический ключ из PEM-файла. kid в PEM
+// нет, поэтому такие ключи пробуются для любого токена, чей kid не нашелся в JWKS
+func WithRSAPublicKeyFile(path string) Option {
+	return func(options *options) error {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		key, err := jwt.ParseRSAPublicKeyFromPEM(data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		options.pemKeys = append(options.pemKeys, key)
+		return nil
+	}
+}
+
+// WithJWKSFile загружает RSA ключи из JWKS (RFC 7517), остальные типы ключей пропускаются
+func WithJWKSFile(path string) Option {
+	return func(options *options) error {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		keys, err := parseJWKS(data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		for kid, key := range keys {
+			options.rsaKeys[kid] = key
+		}
+		return nil
+	}
+}
+
+func WithIssuer(iss string) Option {
+	return func(options *options) error {
+		options.issuer = iss
+		return nil
+	}
+}
+
+func WithAudience(aud string) Option {
+	return func(options *options) error {
+		options.audience = aud
+		return nil
+	}
+}
+
+type Verifier struct {
+	opts   options
+	parser *jwt.Parser
+}
+
+func NewVerifier(opts ...Option) (*Verifier, error) {
+	options := options{rsaKeys: make(map[string]*rsa.PublicKey)}
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return nil, err
+		}
+	}
+	var methods []string
+	if options.hmacSecret != nil {
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+	if len(options.rsaKeys) > 0 || len(options.pemKeys) > 0 {
+		methods = append(methods, jwt.SigningMethodRS256.Alg())
+	}
+	if len(methods) == 0 {
+		return nil, errors.New("no verification keys configured")
+	}
+	popts := []jwt.ParserOption{jwt.WithValidMethods(methods), jwt.WithExpirationRequired()}
+	if options.issuer != "" {
+		popts = append(popts, jwt.WithIssuer(options.issuer))
+	}
+	if options.audience != "" {
+		popts = append(popts, jwt.WithAudience(options.audience))
+	}
+	return &Verifier{opts: options, parser: jwt.NewParser(popts...)}, nil
+}
+
+func (v *Verifier) Verify(token string) (Claims, error) {
+	if token == "" {
+		return Claims{}, ErrNoToken
+	}
+	var c Claims
+	if _, err := v.parser.ParseWithClaims(token, &c, v.keyFunc); err != nil {
+		return Claims{}, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+	if c.Subject == "" {
+		return Claims{}, fmt.Errorf("%w: subject is empty", ErrInvalidToken)
+	}
+	return c, nil
+}
+
+func (v *Verifier) keyFunc(t *jwt.Token) (any, error) {
+	switch t.Method.Alg() {
+	case jwt.SigningMethodHS256.Alg():
+		return v.opts.hmacSecret, nil
+	case jwt.SigningMethodRS256.Alg():
+		if kid, ok := t.Header["kid"].(string); ok {
+			if key, ok := v.opts.rsaKeys[kid]; ok {
+				return key, nil
+			}
+			if len(v.opts.pemKeys) == 0 {
+				return nil, fmt.Errorf("unknown kid %q", kid)
+			}
+			return keySet(v.opts.pemKeys), nil
+		}
+		// без kid перебираем все ключи
+		set := keySet(v.opts.pemKeys)
+		for _, key := range v.opts.rsaKeys {
+			set.Keys = append(set.Keys, key)
+		}
+		return set, nil
+	}
+	return nil, fmt.Errorf("unexpected signing method %s", t.Method.Alg())
+}
+
+func keySet(keys []*rsa.PublicKey) jwt.VerificationKeySet {
+	set := jwt.VerificationKeySet{Keys: make([]jwt.VerificationKey, 0, len(keys))}
+	for _, key := range keys {
+		set.Keys = append(set.Keys, key)
+	}
+	return set
+}
+
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+func parseJWKS(data []byte) (map[string]*rsa.PublicKey, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: modulus: %w", k.Kid, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: exponent: %w", k.Kid, err)
+		}
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no RSA signing keys found")
+	}
+	return keys, nil
+}
@@ -0,0 +1,138 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var secret = []byte("0123456789abcdef0123456789abcdef")
+
+func sign(t *testing.T, method jwt.SigningMethod, key any, kid string, c Claims) string {
+	t.Helper()
+	tok := jwt.NewWithClaims(method, c)
+	if kid != "" {
+		tok.Header["kid"] = kid
+	}
+	s, err := tok.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func claims(sub string, exp time.Duration, roles ...string) Claims {
+	return Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   sub,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(exp)),
+		},
+		Roles: roles,
+	}
+}
+
+func TestVerifyHS256(t *testing.T) {
+	v, err := NewVerifier(WithHMACSecret(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{"Happy", sign(t, jwt.SigningMethodHS256, secret, "", claims("user-1", time.Minute, RoleCatalogAdmin)), nil},
+		{"Empty", "", ErrNoToken},
+		{"Expired", sign(t, jwt.SigningMethodHS256, secret, "", claims("user-1", -time.Minute)), ErrInvalidToken},
+		{"Wrong Secret", sign(t, jwt.SigningMethodHS256, []byte("another-secret-another-secret-!!"), "", claims("user-1", time.Minute)), ErrInvalidToken},
+		{"No Subject", sign(t, jwt.SigningMethodHS256, secret, "", claims("", time.Minute)), ErrInvalidToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := v.Verify(tt.token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && (c.Subject != "user-1" || !c.HasRole(RoleCatalogAdmin)) {
+				t.Fatalf("unexpected claims %+v", c)
+			}
+		})
+	}
+}
+
+func TestVerifyRS256JWKS(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwks, _ := json.Marshal(map[string]any{"keys": []map[string]string{{
+		"kid": "k1",
+		"kty": "RSA",
+		"use": "sig",
+		"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}})
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, jwks, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	v, err := NewVerifier(WithJWKSFile(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := v.Verify(sign(t, jwt.SigningMethodRS256, key, "k1", claims("svc-order", time.Minute))); err != nil {
+		t.Fatalf("valid token: %v", err)
+	}
+	if _, err := v.Verify(sign(t, jwt.SigningMethodRS256, key, "k2", claims("svc-order", time.Minute))); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("unknown kid: got %v", err)
+	}
+	// HS256 не разрешен, если секрет не настроен - защита от подмены алгоритма
+	if _, err := v.Verify(sign(t, jwt.SigningMethodHS256, secret, "", claims("svc-order", time.Minute))); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("hs256 without secret: got %v", err)
+	}
+}
+
+func TestVerifyRS256PEM(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "issuer.pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	v, err := NewVerifier(WithRSAPublicKeyFile(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// kid выдает эмитент, про файл он не знает
+	for _, kid := range []string{"", "2026-10-rotation"} {
+		if _, err := v.Verify(sign(t, jwt.SigningMethodRS256, key, kid, claims("svc-order", time.Minute))); err != nil {
+			t.Fatalf("kid %q: %v", kid, err)
+		}
+	}
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.Verify(sign(t, jwt.SigningMethodRS256, other, "2026-10-rotation", claims("svc-order", time.Minute))); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("foreign key: got %v", err)
+	}
+}
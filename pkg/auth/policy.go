@@ -0,0 +1,22 @@
+package auth
+
+import "strings"
+
+// Policy сопоставляет полное имя gRPC метода ("/Service/Method") с ролями,
+// которым он разрешен. Ключ "/Service/*" покрывает все методы сервиса.
+// RolePublic в списке означает, что токен не обязателен.
+type Policy map[string][]string
+
+// Roles возвращает роли для метода; false - метод в политике не описан
+// и должен быть запрещен
+func (p Policy) Roles(fullMethod string) ([]string, bool) {
+	if roles, ok := p[fullMethod]; ok {
+		return roles, true
+	}
+	if i := strings.LastIndex(fullMethod, "/"); i > 0 {
+		if roles, ok := p[fullMethod[:i]+"/*"]; ok {
+			return roles, true
+		}
+	}
+	return nil, false
+}
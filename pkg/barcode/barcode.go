@@ -0,0 +1,43 @@
+// Package barcode проверяет штрихкоды семейства GTIN: EAN-8, UPC-A, EAN-13 и GTIN-14
+package barcode
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrLength   = errors.New("barcode must contain 8, 12, 13 or 14 digits")
+	ErrDigits   = errors.New("barcode must contain only digits")
+	ErrChecksum = errors.New("barcode check digit is wrong")
+)
+
+// Validate проверяет длину и контрольную цифру (последнюю): цифры справа
+// налево, начиная с соседней с контрольной, берутся с весами 3, 1, 3, 1...
+func Validate(code string) error {
+	switch len(code) {
+	case 8, 12, 13, 14:
+	default:
+		return ErrLength
+	}
+	sum := 0
+	for i := len(code) - 2; i >= 0; i-- {
+		d := code[i]
+		if d < '0' || d > '9' {
+			return ErrDigits
+		}
+		n := int(d - '0')
+		if (len(code)-2-i)%2 == 0 {
+			n *= 3
+		}
+		sum += n
+	}
+	last := code[len(code)-1]
+	if last < '0' || last > '9' {
+		return ErrDigits
+	}
+	if want := (10 - sum%10) % 10; int(last-'0') != want {
+		return fmt.Errorf("%w: want %d", ErrChecksum, want)
+	}
+	return nil
+}
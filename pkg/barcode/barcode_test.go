@@ -0,0 +1,30 @@
+package barcode
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		code string
+		err  error
+	}{
+		{"4006381333931", nil},  // EAN-13
+		{"96385074", nil},       // EAN-8
+		{"036000291452", nil},   // UPC-A
+		{"10012345678902", nil}, // GTIN-14
+		{"4006381333932", ErrChecksum},
+		{"036000291453", ErrChecksum},
+		{"40063813339a1", ErrDigits},
+		{"1234567", ErrLength},
+		{"", ErrLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			if err := Validate(tt.code); !errors.Is(err, tt.err) {
+				t.Fatalf("got %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Package blob - хранилище файлов (картинок товаров) по ключу. Ключи - пути
+// через "/", например products/<id>/<image>/small.jpg
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+)
+
+var (
+	ErrNotFound   = errors.New("blob not found")
+	ErrInvalidKey = errors.New("invalid blob key")
+)
+
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete не считает ошибкой отсутствие ключа
+	Delete(ctx context.Context, key string) error
+	// URL - публичный адрес, по которому клиенты забирают файл
+	URL(key string) string
+}
+
+// checkKey не пускает пустые сегменты, "." и ".." - иначе ключ
+// от клиента мог бы выйти за корень хранилища
+func checkKey(key string) error {
+	if key == "" {
+		return ErrInvalidKey
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." || strings.ContainsAny(part, "\\\x00") {
+			return ErrInvalidKey
+		}
+	}
+	return nil
+}
+
+func joinURL(base, key string) string {
+	return strings.TrimRight(base, "/") + "/" + key
+}
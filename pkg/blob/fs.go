@@ -0,0 +1,93 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// FS хранит файлы в локальной директории. Раздавать их должен кто-то снаружи
+// (nginx, CDN с origin на эту директорию) по адресу baseURL
+type FS struct {
+	root    string
+	baseURL string
+}
+
+func NewFS(root, baseURL string) (*FS, error) {
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, err
+	}
+	return &FS{root: root, baseURL: baseURL}, nil
+}
+
+// Put пишет во временный файл и переименовывает его, поэтому читатель
+// никогда не увидит недописанный файл
+func (s *FS) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	dst := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(dst), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(f.Name(), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), dst)
+}
+
+func (s *FS) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+	f, err := os.Open(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return f, err
+}
+
+// Delete убирает и опустевшие директории над файлом, чтобы от удаленных
+// картинок не копились пустые products/<id>/<image>
+func (s *FS) Delete(ctx context.Context, key string) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	err := os.Remove(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for dir := path.Dir(key); dir != "."; dir = path.Dir(dir) {
+		if os.Remove(s.path(dir)) != nil {
+			break
+		}
+	}
+	return nil
+}
+
+func (s *FS) URL(key string) string {
+	return joinURL(s.baseURL, key)
+}
+
+func (s *FS) path(key string) string {
+	return filepath.Join(s.root, filepath.FromSlash(key))
+}
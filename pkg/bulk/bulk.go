@@ -0,0 +1,200 @@
+// Package bulk читает товары из файлов поставщиков: CSV с заголовком
+// и NDJSON (по JSON-объекту на строку), и пишет выгрузки в тех же форматах
+// и в форматах фида Google Merchant. Здесь только формат файла,
+// проверку значений и работу с базой делает вызывающий
+package bulk
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+type Format string
+
+const (
+	CSV    Format = "csv"
+	NDJSON Format = "ndjson"
+)
+
+var (
+	// ErrMalformedRow - строку не удалось разобрать; чтение можно продолжать
+	ErrMalformedRow  = errors.New("malformed row")
+	ErrBadHeader     = errors.New("bad header")
+	ErrUnknownFormat = errors.New("unknown format, allowed: csv, ndjson")
+)
+
+// Columns - поля записи, они же колонки CSV и ключи NDJSON
+var Columns = []string{"sku", "name", "description", "price", "currency"}
+
+// Record - строка файла как есть, без проверки значений. Price - сумма
+// в мажорных единицах ("123.45"), Line - номер строки в файле для отчета
+type Record struct {
+	Line        int
+	SKU         string
+	Name        string
+	Description string
+	Price       string
+	Currency    string
+}
+
+type Reader interface {
+	// Read возвращает очередную запись или io.EOF. Ошибка с ErrMalformedRow
+	// относится к одной строке (Record.Line заполнен), после нее можно читать дальше;
+	// любая другая ошибка - конец чтения
+	Read() (Record, error)
+}
+
+func NewReader(f Format, r io.Reader) (Reader, error) {
+	switch f {
+	case CSV:
+		return newCSVReader(r)
+	case NDJSON:
+		return newNDJSONReader(r), nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, f)
+}
+
+type csvReader struct {
+	r   *csv.Reader
+	idx map[string]int
+}
+
+// newCSVReader читает заголовок: колонки в любом порядке и регистре,
+// name и price обязательны, неизвестные колонки - ошибка, а не молчаливый пропуск.
+// Колонки выгрузки (ExportColumns) известны и пропускаются, чтобы выгрузку
+// можно было поправить и загрузить обратно
+func newCSVReader(r io.Reader) (*csvReader, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	cr.ReuseRecord = true
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: empty file", ErrBadHeader)
+	}
+	var perr *csv.ParseError
+	if errors.As(err, &perr) {
+		return nil, fmt.Errorf("%w: %v", ErrBadHeader, perr.Err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	idx := make(map[string]int, len(header))
+	for i, h := range header {
+		h = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\uFEFF")))
+		if !slices.Contains(ExportColumns, h) {
+			return nil, fmt.Errorf("%w: unknown column %q", ErrBadHeader, h)
+		}
+		if _, ok := idx[h]; ok {
+			return nil, fmt.Errorf("%w: duplicate column %q", ErrBadHeader, h)
+		}
+		idx[h] = i
+	}
+	for _, h := range []string{"name", "price"} {
+		if _, ok := idx[h]; !ok {
+			return nil, fmt.Errorf("%w: missing column %q", ErrBadHeader, h)
+		}
+	}
+	return &csvReader{r: cr, idx: idx}, nil
+}
+
+func (c *csvReader) Read() (Record, error) {
+	fields, err := c.r.Read()
+	var perr *csv.ParseError
+	if errors.As(err, &perr) {
+		return Record{Line: perr.StartLine}, fmt.Errorf("%w: %v", ErrMalformedRow, perr.Err)
+	}
+	if err != nil {
+		return Record{}, err
+	}
+	line, _ := c.r.FieldPos(0)
+	if len(fields) != len(c.idx) {
+		return Record{Line: line}, fmt.Errorf("%w: %d fields, header has %d", ErrMalformedRow, len(fields), len(c.idx))
+	}
+	get := func(col string) string {
+		if i, ok := c.idx[col]; ok {
+			return strings.TrimSpace(fields[i])
+		}
+		return ""
+	}
+	return Record{
+		Line:        line,
+		SKU:         get("sku"),
+		Name:        get("name"),
+		Description: get("description"),
+		Price:       get("price"),
+		Currency:    get("currency"),
+	}, nil
+}
+
+// maxLineSize - длиннее строки NDJSON с одним товаром не бывают
+const maxLineSize = 64 << 10
+
+type ndjsonReader struct {
+	s    *bufio.Scanner
+	line int
+}
+
+func newNDJSONReader(r io.Reader) *ndjsonReader {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0, 4096), maxLineSize)
+	return &ndjsonReader{s: s}
+}
+
+// ndjsonRecord - price может быть и числом, и строкой: 12.5 и "12.50".
+// Поля выгрузки без пары в Record принимаются и отбрасываются
+type ndjsonRecord struct {
+	SKU         string      `json:"sku"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Price       json.Number `json:"price"`
+	Currency    string      `json:"currency"`
+
+	ID        json.RawMessage `json:"id"`
+	SalePrice json.RawMessage `json:"sale_price"`
+	Available json.RawMessage `json:"available"`
+	ImageURL  json.RawMessage `json:"image_url"`
+	Link      json.RawMessage `json:"link"`
+	UpdatedAt json.RawMessage `json:"updated_at"`
+}
+
+func (n *ndjsonReader) Read() (Record, error) {
+	for n.s.Scan() {
+		n.line++
+		b := strings.TrimSpace(n.s.Text())
+		if b == "" {
+			continue
+		}
+		var rec ndjsonRecord
+		dec := json.NewDecoder(strings.NewReader(b))
+		dec.UseNumber()
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&rec); err != nil {
+			return Record{Line: n.line}, fmt.Errorf("%w: %v", ErrMalformedRow, err)
+		}
+		if dec.More() {
+			return Record{Line: n.line}, fmt.Errorf("%w: one object per line expected", ErrMalformedRow)
+		}
+		return Record{
+			Line:        n.line,
+			SKU:         strings.TrimSpace(rec.SKU),
+			Name:        strings.TrimSpace(rec.Name),
+			Description: strings.TrimSpace(rec.Description),
+			Price:       rec.Price.String(),
+			Currency:    strings.TrimSpace(rec.Currency),
+		}, nil
+	}
+	if err := n.s.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return Record{}, fmt.Errorf("line %d is longer than %d bytes", n.line+1, maxLineSize)
+		}
+		return Record{}, err
+	}
+	return Record{}, io.EOF
+}
@@ -0,0 +1,79 @@
+package bulk
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+// readAll возвращает записи и номера строк с ошибками разбора
+func readAll(t *testing.T, r Reader) ([]Record, []int) {
+	t.Helper()
+	var recs []Record
+	var bad []int
+	for {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return recs, bad
+		}
+		if errors.Is(err, ErrMalformedRow) {
+			bad = append(bad, rec.Line)
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		recs = append(recs, rec)
+	}
+}
+
+func TestCSV(t *testing.T) {
+	data := "\uFEFFName, Price ,SKU\n" +
+		"Glazed Donut,120.50,D-1\n" +
+		"\"Donut, chocolate\",99,\n" +
+		"Broken,1\n" +
+		"Eclair,150,E-1\n"
+	r, err := NewReader(CSV, strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	recs, bad := readAll(t, r)
+	if len(recs) != 3 || len(bad) != 1 || bad[0] != 4 {
+		t.Fatalf("got %+v, bad lines %v", recs, bad)
+	}
+	want := Record{Line: 3, Name: "Donut, chocolate", Price: "99"}
+	if recs[1] != want {
+		t.Fatalf("got %+v, want %+v", recs[1], want)
+	}
+	if recs[2].Line != 5 || recs[2].SKU != "E-1" {
+		t.Fatalf("unexpected last record %+v", recs[2])
+	}
+}
+
+func TestCSVHeader(t *testing.T) {
+	for _, header := range []string{"", "name,price,weight\n", "name\n", "name,price,name\n"} {
+		if _, err := NewReader(CSV, strings.NewReader(header)); !errors.Is(err, ErrBadHeader) {
+			t.Fatalf("header %q: got %v, want %v", header, err, ErrBadHeader)
+		}
+	}
+}
+
+func TestNDJSON(t *testing.T) {
+	data := `{"name":"Glazed Donut","price":120.5,"sku":"D-1"}` + "\n\n" +
+		`{"name":"Eclair","price":"150"}` + "\n" +
+		`{"name":"Cake","price":1,"weight":2}` + "\n" +
+		`not json` + "\n" +
+		`{"name":"Pie","price":10}`
+	r, err := NewReader(NDJSON, strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	recs, bad := readAll(t, r)
+	if len(recs) != 3 || len(bad) != 2 || bad[0] != 4 || bad[1] != 5 {
+		t.Fatalf("got %+v, bad lines %v", recs, bad)
+	}
+	if recs[0].Price != "120.5" || recs[1].Price != "150" || recs[1].Line != 3 || recs[2].Line != 6 {
+		t.Fatalf("unexpected records %+v", recs)
+	}
+}
@@ -0,0 +1,269 @@
+package bulk
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Форматы фида Google Merchant Center: RSS 2.0 с пространством имен g:
+// и текстовый файл с табуляцией. Только для выгрузки
+const (
+	GoogleXML Format = "google-xml"
+	GoogleTSV Format = "google-tsv"
+)
+
+var ErrUnknownColumn = errors.New("unknown column")
+
+// ExportColumns - колонки CSV и ключи NDJSON выгрузки. Колонки импорта
+// (Columns) входят сюда с теми же именами, поэтому выгрузку можно загрузить
+// обратно: остальные колонки импорт пропускает
+var ExportColumns = []string{
+	"id", "sku", "name", "description", "price", "currency",
+	"sale_price", "available", "image_url", "link", "updated_at",
+}
+
+// Product - товар для выгрузки. Суммы - в мажорных единицах, как их принимает
+// импорт ("120.50"); SalePrice пустой, если скидки нет
+type Product struct {
+	ID          string
+	SKU         string
+	Name        string
+	Description string
+	Price       string
+	Currency    string
+	SalePrice   string
+	Available   bool
+	ImageURL    string
+	Link        string
+	UpdatedAt   time.Time
+}
+
+// Feed - сведения о магазине для заголовка фида Google Merchant XML
+type Feed struct {
+	Title string
+	Link  string
+}
+
+type Writer interface {
+	Write(p Product) error
+	// Close дописывает хвост формата и сбрасывает буфер, но не закрывает io.Writer
+	Close() error
+}
+
+// NewWriter создает выгрузку в формате f. columns задают колонки и их порядок
+// для CSV и NDJSON, пусто - все ExportColumns; у фидов Google набор колонок фиксирован
+func NewWriter(f Format, w io.Writer, columns []string, feed Feed) (Writer, error) {
+	if len(columns) == 0 {
+		columns = ExportColumns
+	}
+	for i, c := range columns {
+		if !slices.Contains(ExportColumns, c) {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownColumn, c)
+		}
+		if slices.Contains(columns[:i], c) {
+			return nil, fmt.Errorf("%w: %q is listed twice", ErrUnknownColumn, c)
+		}
+	}
+	bw := bufio.NewWriter(w)
+	switch f {
+	case CSV:
+		cw := csv.NewWriter(bw)
+		if err := cw.Write(columns); err != nil {
+			return nil, err
+		}
+		return &csvWriter{w: cw, bw: bw, columns: columns}, nil
+	case NDJSON:
+		return &ndjsonWriter{bw: bw, enc: json.NewEncoder(bw), columns: columns}, nil
+	case GoogleXML:
+		return newGoogleXMLWriter(bw, feed)
+	case GoogleTSV:
+		_, err := bw.WriteString(strings.Join(googleColumns, "\t") + "\n")
+		return &googleTSVWriter{bw: bw}, err
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, f)
+}
+
+// field - значение колонки в текстовом виде
+func (p Product) field(col string) string {
+	switch col {
+	case "id":
+		return p.ID
+	case "sku":
+		return p.SKU
+	case "name":
+		return p.Name
+	case "description":
+		return p.Description
+	case "price":
+		return p.Price
+	case "currency":
+		return p.Currency
+	case "sale_price":
+		return p.SalePrice
+	case "available":
+		return strconv.FormatBool(p.Available)
+	case "image_url":
+		return p.ImageURL
+	case "link":
+		return p.Link
+	case "updated_at":
+		if p.UpdatedAt.IsZero() {
+			return ""
+		}
+		return p.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+	return ""
+}
+
+type csvWriter struct {
+	w       *csv.Writer
+	bw      *bufio.Writer
+	columns []string
+	record  []string
+}
+
+func (c *csvWriter) Write(p Product) error {
+	c.record = c.record[:0]
+	for _, col := range c.columns {
+		c.record = append(c.record, p.field(col))
+	}
+	return c.w.Write(c.record)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	if err := c.w.Error(); err != nil {
+		return err
+	}
+	return c.bw.Flush()
+}
+
+type ndjsonWriter struct {
+	bw      *bufio.Writer
+	enc     *json.Encoder
+	columns []string
+}
+
+// Write пишет available как bool, остальное строками: суммы остаются
+// десятичными строками и не теряют точность в JSON-числах
+func (n *ndjsonWriter) Write(p Product) error {
+	obj := make(map[string]any, len(n.columns))
+	for _, col := range n.columns {
+		if col == "available" {
+			obj[col] = p.Available
+			continue
+		}
+		obj[col] = p.field(col)
+	}
+	return n.enc.Encode(obj)
+}
+
+func (n *ndjsonWriter) Close() error {
+	return n.bw.Flush()
+}
+
+// googleColumns - атрибуты фида Google Merchant. mpn - артикул поставщика:
+// без GTIN Google требует хотя бы его
+var googleColumns = []string{
+	"id", "title", "description", "link", "image_link",
+	"availability", "price", "sale_price", "condition", "mpn",
+}
+
+func googleFields(p Product) []string {
+	availability := "out_of_stock"
+	if p.Available {
+		availability = "in_stock"
+	}
+	sale := ""
+	if p.SalePrice != "" {
+		sale = p.SalePrice + " " + p.Currency
+	}
+	return []string{
+		p.ID, p.Name, p.Description, p.Link, p.ImageURL,
+		availability, p.Price + " " + p.Currency, sale, "new", p.SKU,
+	}
+}
+
+type googleTSVWriter struct {
+	bw *bufio.Writer
+}
+
+// tsvReplacer - в TSV фида нет экранирования, табуляции и переводы строк
+// внутри значений заменяются пробелами
+var tsvReplacer = strings.NewReplacer("\t", " ", "\r\n", " ", "\n", " ", "\r", " ")
+
+func (g *googleTSVWriter) Write(p Product) error {
+	fields := googleFields(p)
+	for i, f := range fields {
+		fields[i] = tsvReplacer.Replace(f)
+	}
+	_, err := g.bw.WriteString(strings.Join(fields, "\t") + "\n")
+	return err
+}
+
+func (g *googleTSVWriter) Close() error {
+	return g.bw.Flush()
+}
+
+type googleXMLWriter struct {
+	bw  *bufio.Writer
+	enc *xml.Encoder
+}
+
+const googleNamespace = "http://base.google.com/ns/1.0"
+
+func newGoogleXMLWriter(bw *bufio.Writer, feed Feed) (*googleXMLWriter, error) {
+	if _, err := bw.WriteString(xml.Header + `<rss version="2.0" xmlns:g="` + googleNamespace + `">` + "\n<channel>\n"); err != nil {
+		return nil, err
+	}
+	enc := xml.NewEncoder(bw)
+	for _, el := range []struct{ name, value string }{
+		{"title", feed.Title},
+		{"link", feed.Link},
+		{"description", feed.Title},
+	} {
+		if err := enc.EncodeElement(el.value, xml.StartElement{Name: xml.Name{Local: el.name}}); err != nil {
+			return nil, err
+		}
+	}
+	return &googleXMLWriter{bw: bw, enc: enc}, nil
+}
+
+// Write пишет <item> с элементами g:*. Пустые необязательные атрибуты
+// не выводятся: пустой g:sale_price Google считает ошибкой
+func (g *googleXMLWriter) Write(p Product) error {
+	item := xml.StartElement{Name: xml.Name{Local: "item"}}
+	if err := g.enc.EncodeToken(item); err != nil {
+		return err
+	}
+	for i, v := range googleFields(p) {
+		if v == "" {
+			continue
+		}
+		// префикс в имени: encoding/xml иначе объявит пространство имен на каждом элементе
+		el := xml.StartElement{Name: xml.Name{Local: "g:" + googleColumns[i]}}
+		if err := g.enc.EncodeElement(v, el); err != nil {
+			return err
+		}
+	}
+	return g.enc.EncodeToken(item.End())
+}
+
+func (g *googleXMLWriter) Close() error {
+	if err := g.enc.Flush(); err != nil {
+		return err
+	}
+	if _, err := g.bw.WriteString("\n</channel>\n</rss>\n"); err != nil {
+		return err
+	}
+	return g.bw.Flush()
+}
@@ -0,0 +1,136 @@
+package bulk
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+var exportProducts = []Product{
+	{
+		ID:          "p1",
+		SKU:         "D-1",
+		Name:        "Donut, glazed",
+		Description: "Sweet\tand \"fresh\"\nevery day",
+		Price:       "120.50",
+		Currency:    "RUB",
+		SalePrice:   "99.00",
+		Available:   true,
+		ImageURL:    "https://cdn.example.com/p1.jpg",
+		Link:        "https://shop.example.com/p/p1",
+		UpdatedAt:   time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC),
+	},
+	{ID: "p2", Name: "Eclair", Description: "Cream", Price: "1.00", Currency: "USD"},
+}
+
+func writeAll(t *testing.T, f Format, columns []string) string {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := NewWriter(f, &buf, columns, Feed{Title: "Donuts & Co", Link: "https://shop.example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range exportProducts {
+		if err := w.Write(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// выгрузку CSV и NDJSON можно загрузить обратно импортом
+func TestExportRoundTrip(t *testing.T) {
+	for _, f := range []Format{CSV, NDJSON} {
+		r, err := NewReader(f, strings.NewReader(writeAll(t, f, nil)))
+		if err != nil {
+			t.Fatalf("%s: %v", f, err)
+		}
+		recs, bad := readAll(t, r)
+		if len(bad) != 0 || len(recs) != 2 {
+			t.Fatalf("%s: got %+v, bad lines %v", f, recs, bad)
+		}
+		p := exportProducts[0]
+		if recs[0].Name != p.Name || recs[0].Price != p.Price || recs[0].SKU != p.SKU || recs[0].Currency != p.Currency {
+			t.Fatalf("%s: got %+v", f, recs[0])
+		}
+	}
+}
+
+func TestExportColumns(t *testing.T) {
+	out := writeAll(t, CSV, []string{"name", "price"})
+	if want := "name,price\n\"Donut, glazed\",120.50\nEclair,1.00\n"; out != want {
+		t.Fatalf("got %q, want %q", out, want)
+	}
+
+	out = writeAll(t, NDJSON, []string{"id", "available"})
+	var obj map[string]any
+	if err := json.Unmarshal([]byte(strings.SplitN(out, "\n", 2)[0]), &obj); err != nil {
+		t.Fatal(err)
+	}
+	if len(obj) != 2 || obj["id"] != "p1" || obj["available"] != true {
+		t.Fatalf("got %v", obj)
+	}
+
+	for _, cols := range [][]string{{"weight"}, {"name", "name"}} {
+		if _, err := NewWriter(CSV, &bytes.Buffer{}, cols, Feed{}); !errors.Is(err, ErrUnknownColumn) {
+			t.Fatalf("columns %v: got %v, want %v", cols, err, ErrUnknownColumn)
+		}
+	}
+	if _, err := NewWriter("xlsx", &bytes.Buffer{}, nil, Feed{}); !errors.Is(err, ErrUnknownFormat) {
+		t.Fatalf("got %v, want %v", err, ErrUnknownFormat)
+	}
+}
+
+func TestGoogleXML(t *testing.T) {
+	var feed struct {
+		Title string `xml:"channel>title"`
+		Items []struct {
+			ID           string `xml:"http://base.google.com/ns/1.0 id"`
+			Price        string `xml:"http://base.google.com/ns/1.0 price"`
+			SalePrice    string `xml:"http://base.google.com/ns/1.0 sale_price"`
+			Availability string `xml:"http://base.google.com/ns/1.0 availability"`
+			MPN          string `xml:"http://base.google.com/ns/1.0 mpn"`
+		} `xml:"channel>item"`
+	}
+	out := writeAll(t, GoogleXML, nil)
+	if err := xml.Unmarshal([]byte(out), &feed); err != nil {
+		t.Fatal(err)
+	}
+	if feed.Title != "Donuts & Co" || len(feed.Items) != 2 {
+		t.Fatalf("got %+v", feed)
+	}
+	first, second := feed.Items[0], feed.Items[1]
+	if first.ID != "p1" || first.Price != "120.50 RUB" || first.SalePrice != "99.00 RUB" ||
+		first.Availability != "in_stock" || first.MPN != "D-1" {
+		t.Fatalf("unexpected first item %+v", first)
+	}
+	if second.SalePrice != "" || second.Availability != "out_of_stock" {
+		t.Fatalf("unexpected second item %+v", second)
+	}
+	if strings.Contains(out, "<g:sale_price></g:sale_price>") || strings.Contains(out, "<g:mpn></g:mpn>") {
+		t.Fatal("empty optional attributes must be omitted")
+	}
+}
+
+func TestGoogleTSV(t *testing.T) {
+	lines := strings.Split(strings.TrimSuffix(writeAll(t, GoogleTSV, nil), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines: %q", len(lines), lines)
+	}
+	for _, l := range lines {
+		if n := strings.Count(l, "\t"); n != len(googleColumns)-1 {
+			t.Fatalf("line %q has %d tabs", l, n)
+		}
+	}
+	fields := strings.Split(lines[1], "\t")
+	if fields[2] != "Sweet and \"fresh\" every day" || fields[6] != "120.50 RUB" {
+		t.Fatalf("unexpected fields %q", fields)
+	}
+}
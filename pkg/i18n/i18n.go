@@ -0,0 +1,148 @@
+// Package i18n - теги локалей (BCP 47 в упрощенном виде: язык, письменность, регион),
+// разбор Accept-Language и цепочка запасных локалей.
+package i18n
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// DefaultLocale - язык, на котором заведены Name и Description самого товара;
+// для него переводов нет, он же - последняя ступень цепочки
+const DefaultLocale = "ru"
+
+var ErrInvalidLocale = errors.New("locale must look like en, en-US or sr-Latn-RS")
+
+// Canonical приводит тег к виду язык[-Письменность][-РЕГИОН]: "EN_us" -> "en-US".
+// Расширения и варианты не поддерживаются, переводы под них никто не заводит
+func Canonical(tag string) (string, error) {
+	parts := strings.Split(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"), "-")
+	if len(parts) > 3 || !letters(parts[0], 2, 3) {
+		return "", ErrInvalidLocale
+	}
+	parts[0] = strings.ToLower(parts[0])
+	rest := parts[1:]
+	if len(rest) > 0 && letters(rest[0], 4, 4) {
+		rest[0] = strings.ToUpper(rest[0][:1]) + strings.ToLower(rest[0][1:])
+		rest = rest[1:]
+	}
+	if len(rest) > 0 {
+		switch {
+		case letters(rest[0], 2, 2):
+			rest[0] = strings.ToUpper(rest[0])
+		case digits(rest[0], 3):
+		default:
+			return "", ErrInvalidLocale
+		}
+		rest = rest[1:]
+	}
+	if len(rest) > 0 {
+		return "", ErrInvalidLocale
+	}
+	return strings.Join(parts, "-"), nil
+}
+
+func letters(s string, min, max int) bool {
+	if len(s) < min || len(s) > max {
+		return false
+	}
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func digits(s string, n int) bool {
+	if len(s) != n {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseAcceptLanguage возвращает локали из заголовка по убыванию q. Кривые теги,
+// "*" и q=0 пропускаются: из-за них отказывать в запросе незачем
+func ParseAcceptLanguage(header string) []string {
+	type pref struct {
+		tag string
+		q   float64
+	}
+	var prefs []pref
+	for _, item := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(item, ";")
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			q = f
+		}
+		tag, err := Canonical(tag)
+		if err != nil || q <= 0 {
+			continue
+		}
+		prefs = append(prefs, pref{tag: tag, q: q})
+	}
+	// стабильная сортировка: при равных q порядок в заголовке важнее
+	slices.SortStableFunc(prefs, func(a, b pref) int {
+		switch {
+		case a.q > b.q:
+			return -1
+		case a.q < b.q:
+			return 1
+		}
+		return 0
+	})
+	tags := make([]string, 0, len(prefs))
+	for _, p := range prefs {
+		if !slices.Contains(tags, p.tag) {
+			tags = append(tags, p.tag)
+		}
+	}
+	return tags
+}
+
+// Fallbacks - цепочка поиска перевода: каждая локаль, затем ее родители
+// (sr-Latn-RS, sr-Latn, sr), затем def. Так "de-CH, fr" найдет "de" раньше "fr"
+func Fallbacks(prefs []string, def string) []string {
+	var chain []string
+	add := func(tag string) {
+		if !slices.Contains(chain, tag) {
+			chain = append(chain, tag)
+		}
+	}
+	for _, tag := range prefs {
+		for {
+			add(tag)
+			i := strings.LastIndexByte(tag, '-')
+			if i < 0 {
+				break
+			}
+			tag = tag[:i]
+		}
+	}
+	add(def)
+	return chain
+}
+
+type prefsKey struct{}
+
+// WithPreferences кладет в контекст локали клиента, уже разобранные и отсортированные
+func WithPreferences(ctx context.Context, tags []string) context.Context {
+	return context.WithValue(ctx, prefsKey{}, tags)
+}
+
+func Preferences(ctx context.Context) []string {
+	tags, _ := ctx.Value(prefsKey{}).([]string)
+	return tags
+}
@@ -0,0 +1,63 @@
+package i18n
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCanonical(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want string
+		ok   bool
+	}{
+		{"en", "en", true},
+		{"EN_us", "en-US", true},
+		{"sr-latn-rs", "sr-Latn-RS", true},
+		{"zh-hant", "zh-Hant", true},
+		{"es-419", "es-419", true},
+		{" de-CH ", "de-CH", true},
+		{"", "", false},
+		{"e", "", false},
+		{"english", "", false},
+		{"en-USA", "", false},
+		{"en-US-x-private", "", false},
+		{"*", "", false},
+	}
+	for _, tt := range tests {
+		got, err := Canonical(tt.tag)
+		if (err == nil) != tt.ok || got != tt.want {
+			t.Errorf("Canonical(%q) = %q, %v; want %q", tt.tag, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header string
+		want   []string
+	}{
+		{"", []string{}},
+		{"de-CH", []string{"de-CH"}},
+		{"fr;q=0.5, de-CH, en;q=0.8", []string{"de-CH", "en", "fr"}},
+		{"en;q=0.8, fr;q=0.8", []string{"en", "fr"}},
+		{"*, en;q=0, de;q=bad, kk", []string{"kk"}},
+		{"en-us, EN-US;q=0.1", []string{"en-US"}},
+	}
+	for _, tt := range tests {
+		if got := ParseAcceptLanguage(tt.header); !slices.Equal(got, tt.want) {
+			t.Errorf("ParseAcceptLanguage(%q) = %v, want %v", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestFallbacks(t *testing.T) {
+	got := Fallbacks([]string{"sr-Latn-RS", "de-CH", "de", "ru"}, "ru")
+	want := []string{"sr-Latn-RS", "sr-Latn", "sr", "de-CH", "de", "ru"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got := Fallbacks(nil, "ru"); !slices.Equal(got, []string{"ru"}) {
+		t.Fatalf("got %v", got)
+	}
+}
@@ -0,0 +1,173 @@
+// Package imaging проверяет загруженные картинки и делает из них превью.
+// Только стандартная библиотека: декодеры image/jpeg, image/png, image/gif
+// и собственное уменьшение усреднением по площади
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+)
+
+var (
+	ErrUnsupportedType = errors.New("unsupported image type, allowed: image/jpeg, image/png, image/gif")
+	ErrTooLarge        = errors.New("image dimensions are too large")
+	ErrInvalidImage    = errors.New("invalid image data")
+)
+
+// ContentTypes - что принимаем на загрузку
+var ContentTypes = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+}
+
+// Size - превью вписывается в квадрат Max x Max с сохранением пропорций
+type Size struct {
+	Name string
+	Max  int
+}
+
+var DefaultSizes = []Size{{"small", 160}, {"medium", 480}, {"large", 1024}}
+
+// DefaultMaxPixels ограничивает распакованный размер: 8-мегабайтный PNG
+// может развернуться в гигабайты в памяти
+const DefaultMaxPixels = 40_000_000
+
+type Image struct {
+	ContentType string
+	Width       int
+	Height      int
+}
+
+type Thumbnail struct {
+	Name string
+	Image
+	Data []byte
+}
+
+// Sniff определяет тип по содержимому, а не по тому, что прислал клиент
+func Sniff(data []byte) (string, error) {
+	ct := http.DetectContentType(data)
+	if _, ok := ContentTypes[ct]; !ok {
+		return "", fmt.Errorf("%w: got %s", ErrUnsupportedType, ct)
+	}
+	return ct, nil
+}
+
+// Process декодирует картинку и делает превью всех размеров. Превью JPEG-исходника -
+// JPEG, остальных - PNG, чтобы не потерять прозрачность
+func Process(data []byte, sizes []Size, maxPixels int) (Image, []Thumbnail, error) {
+	ct, err := Sniff(data)
+	if err != nil {
+		return Image{}, nil, err
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return Image{}, nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return Image{}, nil, ErrInvalidImage
+	}
+	if maxPixels > 0 && cfg.Width*cfg.Height > maxPixels {
+		return Image{}, nil, fmt.Errorf("%w: %dx%d", ErrTooLarge, cfg.Width, cfg.Height)
+	}
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return Image{}, nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+	orig := Image{ContentType: ct, Width: cfg.Width, Height: cfg.Height}
+
+	rgba := image.NewRGBA(image.Rect(0, 0, cfg.Width, cfg.Height))
+	draw.Draw(rgba, rgba.Bounds(), src, src.Bounds().Min, draw.Src)
+	thumbs := make([]Thumbnail, len(sizes))
+	for i, size := range sizes {
+		w, h := Fit(cfg.Width, cfg.Height, size.Max)
+		dst := resize(rgba, w, h)
+		var buf bytes.Buffer
+		th := Thumbnail{Name: size.Name, Image: Image{Width: w, Height: h}}
+		if ct == "image/jpeg" {
+			th.ContentType = "image/jpeg"
+			err = jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 85})
+		} else {
+			th.ContentType = "image/png"
+			err = (&png.Encoder{CompressionLevel: png.BestCompression}).Encode(&buf, dst)
+		}
+		if err != nil {
+			return Image{}, nil, err
+		}
+		th.Data = buf.Bytes()
+		thumbs[i] = th
+	}
+	return orig, thumbs, nil
+}
+
+// Fit - размеры, в которые вписывается w x h при стороне не больше side;
+// картинки меньше side не увеличиваются
+func Fit(w, h, side int) (int, int) {
+	if w <= side && h <= side {
+		return w, h
+	}
+	if w >= h {
+		return side, max1(int((int64(h)*int64(side) + int64(w)/2) / int64(w)))
+	}
+	return max1(int((int64(w)*int64(side) + int64(h)/2) / int64(h))), side
+}
+
+func max1(n int) int {
+	if n < 1 {
+		return 1
+	}
+	return n
+}
+
+// resize уменьшает картинку: каждый пиксель результата - среднее пикселей
+// исходника, которые на него приходятся. Для уменьшения это дает результат
+// не хуже билинейной интерполяции и без муара
+func resize(src *image.RGBA, w, h int) *image.RGBA {
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	if sw == w && sh == h {
+		copy(dst.Pix, src.Pix)
+		return dst
+	}
+	for dy := 0; dy < h; dy++ {
+		y0, y1 := span(dy, h, sh)
+		for dx := 0; dx < w; dx++ {
+			x0, x1 := span(dx, w, sw)
+			var r, g, b, a uint64
+			for y := y0; y < y1; y++ {
+				row := src.Pix[y*src.Stride+x0*4 : y*src.Stride+x1*4]
+				for i := 0; i < len(row); i += 4 {
+					r += uint64(row[i])
+					g += uint64(row[i+1])
+					b += uint64(row[i+2])
+					a += uint64(row[i+3])
+				}
+			}
+			n := uint64((x1 - x0) * (y1 - y0))
+			o := dy*dst.Stride + dx*4
+			dst.Pix[o] = uint8((r + n/2) / n)
+			dst.Pix[o+1] = uint8((g + n/2) / n)
+			dst.Pix[o+2] = uint8((b + n/2) / n)
+			dst.Pix[o+3] = uint8((a + n/2) / n)
+		}
+	}
+	return dst
+}
+
+// span - какие пиксели исходника длиной src приходятся на пиксель i результата длиной dst
+func span(i, dst, src int) (int, int) {
+	lo := i * src / dst
+	hi := (i + 1) * src / dst
+	if hi <= lo {
+		hi = lo + 1
+	}
+	return lo, hi
+}
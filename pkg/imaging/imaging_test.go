@@ -0,0 +1,89 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func encode(t *testing.T, w, h int, asJPEG bool) []byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.NRGBA{uint8(x), uint8(y), 128, 255})
+		}
+	}
+	var buf bytes.Buffer
+	var err error
+	if asJPEG {
+		err = jpeg.Encode(&buf, img, nil)
+	} else {
+		err = png.Encode(&buf, img)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestProcess(t *testing.T) {
+	sizes := []Size{{"small", 100}, {"big", 1000}}
+	orig, thumbs, err := Process(encode(t, 300, 200, true), sizes, DefaultMaxPixels)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if orig != (Image{ContentType: "image/jpeg", Width: 300, Height: 200}) {
+		t.Fatalf("original: %+v", orig)
+	}
+	want := []Image{{"image/jpeg", 100, 67}, {"image/jpeg", 300, 200}}
+	for i, th := range thumbs {
+		if th.Name != sizes[i].Name || th.Image != want[i] {
+			t.Fatalf("thumbnail %d: %+v, want %+v", i, th.Image, want[i])
+		}
+		cfg, format, err := image.DecodeConfig(bytes.NewReader(th.Data))
+		if err != nil || format != "jpeg" || cfg.Width != want[i].Width || cfg.Height != want[i].Height {
+			t.Fatalf("thumbnail %d decodes to %s %dx%d: %v", i, format, cfg.Width, cfg.Height, err)
+		}
+	}
+
+	_, thumbs, err = Process(encode(t, 50, 120, false), sizes[:1], 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if thumbs[0].Image != (Image{"image/png", 42, 100}) {
+		t.Fatalf("png thumbnail: %+v", thumbs[0].Image)
+	}
+}
+
+func TestProcessRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{"Text", []byte("definitely not an image"), ErrUnsupportedType},
+		{"Truncated", encode(t, 64, 64, false)[:40], ErrInvalidImage},
+		{"Too Many Pixels", encode(t, 300, 200, false), ErrTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := Process(tt.data, DefaultSizes, 300*199); !errors.Is(err, tt.want) {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestResizeAverages(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	copy(src.Pix, []uint8{0, 0, 0, 255, 255, 255, 255, 255, 255, 255, 255, 255, 0, 0, 0, 255})
+	dst := resize(src, 1, 1)
+	if got := dst.RGBAAt(0, 0); got != (color.RGBA{128, 128, 128, 255}) {
+		t.Fatalf("got %v", got)
+	}
+}
@@ -20,6 +20,10 @@ type LogData struct {
 	Service     string
 	ProductID   string
 	ProductName string
+	// RequestID - x-request-id от шлюза, чтобы связать логи и аудит с запросом
+	RequestID string
+	// TenantID - магазин, в каталоге которого выполняется запрос
+	TenantID string
 }
 
 func NewMyJSONLogHandler(h slog.Handler) *MyJSONLogHandler {
@@ -47,6 +51,12 @@ func (h *MyJSONLogHandler) Handle(ctx context.Context, rec slog.Record) error {
 		if ld.ProductName != "" {
 			rec.Add("product_name", ld.ProductName)
 		}
+		if ld.RequestID != "" {
+			rec.Add("request_id", ld.RequestID)
+		}
+		if ld.TenantID != "" {
+			rec.Add("tenant_id", ld.TenantID)
+		}
 	}
 	return h.handler.Handle(ctx, rec)
 }
@@ -93,3 +103,19 @@ func WithProductName(ctx context.Context, productName string) context.Context {
 	}
 	return context.WithValue(ctx, LogDataKey, LogData{ProductName: productName})
 }
+
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	if ld, ok := ctx.Value(LogDataKey).(LogData); ok {
+		ld.RequestID = requestID
+		return context.WithValue(ctx, LogDataKey, ld)
+	}
+	return context.WithValue(ctx, LogDataKey, LogData{RequestID: requestID})
+}
+
+func WithTenantID(ctx context.Context, tenantID string) context.Context {
+	if ld, ok := ctx.Value(LogDataKey).(LogData); ok {
+		ld.TenantID = tenantID
+		return context.WithValue(ctx, LogDataKey, ld)
+	}
+	return context.WithValue(ctx, LogDataKey, LogData{TenantID: tenantID})
+}
@@ -0,0 +1,56 @@
+package models
+
+type AttributeType string
+
+const (
+	AttributeString  AttributeType = "string"
+	AttributeNumber  AttributeType = "number"
+	AttributeBoolean AttributeType = "boolean"
+	AttributeEnum    AttributeType = "enum"
+)
+
+type AttributeDefinition struct {
+	ID         string
+	CategoryID string
+	Code       string
+	Name       string
+	Type       AttributeType
+	EnumValues []string
+}
+
+// Attributes - значения атрибутов товара по коду: string, float64 или bool
+type Attributes map[string]any
+
+type AttributeFilter struct {
+	Code  string
+	AnyOf []any
+	Min   *float64
+	Max   *float64
+}
+
+type ProductFilter struct {
+	CategoryID         string // пустая строка - весь каталог
+	IncludeDescendants bool
+	Attributes         []AttributeFilter
+	Limit              int
+	Offset             int
+}
+
+type FacetValue struct {
+	Value any
+	Count int
+}
+
+// Facet считается по товарам, прошедшим фильтр: для строк и bool - Values,
+// для чисел - Min и Max
+type Facet struct {
+	Code   string
+	Values []FacetValue
+	Min    *float64
+	Max    *float64
+}
+
+type FilterResult struct {
+	Products []ProductDigest
+	Facets   []Facet
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type AuditAction string
+
+const (
+	AuditCreate AuditAction = "create"
+	AuditUpdate AuditAction = "update"
+	AuditDelete AuditAction = "delete"
+)
+
+// AuditEntry - запись журнала изменений товара. Diff - JSON вида
+// {"поле": {"old": ..., "new": ...}} только с изменившимися полями;
+// при создании old - null, при удалении new - null
+type AuditEntry struct {
+	ID        int64
+	ProductID string
+	Action    AuditAction
+	Actor     string
+	RequestID string
+	Diff      json.RawMessage
+	CreatedAt time.Time
+}
+
+// AuditFilter - нужен хотя бы один из ProductID и Actor
+type AuditFilter struct {
+	ProductID string
+	Actor     string
+	Limit     int
+	Offset    int
+}
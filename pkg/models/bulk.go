@@ -0,0 +1,38 @@
+package models
+
+import "github.com/glekoz/online-shop_product/pkg/money"
+
+// PriceUpdate - новая базовая цена товара: либо Price целиком,
+// либо ChangeBP - изменение текущей в сотых долях процента (500 - +5%)
+type PriceUpdate struct {
+	ProductID string
+	Price     money.Money
+	ChangeBP  int64
+}
+
+type BulkStatus string
+
+const (
+	BulkApplied BulkStatus = "applied"
+	BulkFailed  BulkStatus = "failed"
+	// BulkSkipped - в атомарном режиме элемент в порядке, но не применен из-за ошибок в других
+	BulkSkipped BulkStatus = "skipped"
+)
+
+// BulkItemResult - итог по элементу массовой операции; цены заполнены
+// только у обновления цен
+type BulkItemResult struct {
+	ID       string
+	Status   BulkStatus
+	Error    string
+	OldPrice money.Money
+	NewPrice money.Money
+}
+
+type BulkReport struct {
+	Results []BulkItemResult
+	Applied int
+	Failed  int
+	Skipped int
+	Atomic  bool
+}
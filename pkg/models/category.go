@@ -0,0 +1,13 @@
+package models
+
+type Category struct {
+	ID       string
+	ParentID string // пустая строка - корневая категория
+	Name     string
+	Slug     string
+}
+
+type CategoryNode struct {
+	Category
+	Children []*CategoryNode
+}
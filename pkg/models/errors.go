@@ -3,11 +3,46 @@ package models
 import "errors"
 
 const (
-	UniqueErrCode = "23505"
+	UniqueErrCode     = "23505"
+	ForeignKeyErrCode = "23503"
 )
 
 var (
 	ErrNotFound      = errors.New("no result found")
 	ErrInternal      = errors.New("something goes wrong")
 	ErrAlreadyExists = errors.New("already exists")
+	ErrCategoryCycle = errors.New("category cannot be moved under itself or its descendant")
+	ErrHasChildren   = errors.New("category has subcategories")
+
+	ErrInvalidAttribute  = errors.New("invalid attribute value")
+	ErrInsufficientStock = errors.New("insufficient stock")
+
+	ErrReservationConflict = errors.New("reservation id is already used for other items")
+	ErrReservationClosed   = errors.New("reservation is already closed")
+	ErrReservationExpired  = errors.New("reservation has expired")
+
+	ErrNoExchangeRate = errors.New("no exchange rate for the currency pair")
+	ErrScheduleInPast = errors.New("effective time must be in the future")
+	ErrLegacyCurrency = errors.New("price is not in the default currency, use catalog.GRPCPricing to change it")
+
+	ErrImagesDisabled    = errors.New("image storage is not configured")
+	ErrImageTooLarge     = errors.New("image file is too large")
+	ErrInvalidImageOrder = errors.New("image order must list every image of the product exactly once")
+
+	ErrEmptyQuery       = errors.New("search query has no words")
+	ErrUnknownLanguage  = errors.New("unknown search language")
+	ErrInvalidThreshold = errors.New("similarity threshold must be between 0 and 1")
+
+	ErrImportTooLarge = errors.New("import file is too large")
+	ErrTooManyIDs     = errors.New("too many ids in one request")
+
+	ErrAuditFilter = errors.New("product id or actor is required")
+
+	ErrInvalidStatus    = errors.New("status must be one of draft, in_review, published, discontinued")
+	ErrStatusTransition = errors.New("product status transition is not allowed")
+	ErrScheduleOrder    = errors.New("unpublish time must be after publish time")
+	ErrScheduleStatus   = errors.New("publish can be scheduled only in review, unpublish only in review or published")
+
+	ErrInvalidSlug   = errors.New("slug may contain only lowercase latin letters, digits and single dashes")
+	ErrDefaultLocale = errors.New("default locale is stored in the product itself, update the product instead")
 )
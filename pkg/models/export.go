@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// ExportFilter - какие товары выгружать; пустые поля не ограничивают.
+// CategoryID включает подкатегории
+type ExportFilter struct {
+	CategoryID   string
+	Currency     string
+	InStockOnly  bool
+	UpdatedSince time.Time
+	// PublishedOnly - без черновиков и снятых; app выставляет его для фидов
+	PublishedOnly bool
+}
+
+// ExportRow - товар в выгрузке: карточка списка и поля, которых в ней нет
+type ExportRow struct {
+	ProductDigest
+	SKU         string
+	Description string
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// Image - картинка товара. Файлы лежат в blob-хранилище под ключами Key
+// и Thumbnail.Key, URL заполняются в app по этим ключам
+type Image struct {
+	ID          string
+	ProductID   string
+	Position    int
+	Primary     bool
+	Key         string
+	URL         string
+	ContentType string
+	Size        int64
+	Width       int
+	Height      int
+	Alt         string
+	Thumbnails  []Thumbnail
+	CreatedAt   time.Time
+}
+
+type Thumbnail struct {
+	Name        string
+	Key         string
+	URL         string
+	ContentType string
+	Width       int
+	Height      int
+}
@@ -0,0 +1,43 @@
+package models
+
+import "github.com/glekoz/online-shop_product/pkg/money"
+
+// ImportRow - проверенная строка импорта. ID - id на случай, если товар
+// придется создать; существующий товар ищется по SKU, а без него по названию
+type ImportRow struct {
+	Line        int
+	ID          string
+	SKU         string
+	Name        string
+	Description string
+	Price       money.Money
+}
+
+type ImportStatus string
+
+const (
+	ImportCreated   ImportStatus = "created"
+	ImportUpdated   ImportStatus = "updated"
+	ImportUnchanged ImportStatus = "unchanged"
+	ImportFailed    ImportStatus = "failed"
+)
+
+// ImportRowResult - итог по строке файла; Error заполнен только у ImportFailed
+type ImportRowResult struct {
+	Line   int
+	SKU    string
+	Name   string
+	ID     string
+	Status ImportStatus
+	Error  string
+}
+
+type ImportReport struct {
+	Rows      []ImportRowResult
+	Created   int
+	Updated   int
+	Unchanged int
+	Failed    int
+	// DryRun - все проверено, но ничего не записано
+	DryRun bool
+}
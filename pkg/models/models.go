@@ -1,24 +1,55 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/money"
+)
 
 type FullProduct struct {
 	ID          string
 	Name        string
-	Price       int
+	Price       money.Money
 	Description string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
 
+// BatchItem - товар из BatchGet; Found=false - товара с таким ID нет
+type BatchItem struct {
+	ID      string
+	Found   bool
+	Product Product
+}
+
 type Product struct {
 	Name        string
-	Price       int
+	Price       money.Money
 	Description string
+	// Slug - текущий слаг для URL, подбирается по названию в repository
+	Slug string
+	// EffectivePrice - цена с учетом акций, Promotions - примененные акции;
+	// заполняются в app, в базе и кэше их нет
+	EffectivePrice money.Money
+	Promotions     []string
+	// Locale - язык, на котором отданы Name и Description; заполняется в app
+	Locale string
+	// Status и Schedule меняются только через SetStatus и SetSchedule, Update их не трогает
+	Status   ProductStatus
+	Schedule Schedule
 }
 
 type ProductDigest struct {
-	ID    string
-	Name  string
-	Price int
+	ID        string
+	Name      string
+	Slug      string
+	Price     money.Money
+	Available bool // есть на складе
+	// цена с учетом акций и примененные акции, см. Product
+	EffectivePrice money.Money
+	Promotions     []string
+	// PrimaryImage - главная картинка, nil если картинок нет; заполняется в app
+	PrimaryImage *Image
+	// Locale - язык Name, см. Product
+	Locale string
 }
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/money"
+)
+
+type CurrencyRate struct {
+	money.Rate
+	UpdatedAt time.Time
+}
+
+// ProductPrices - базовая цена товара и явно заданные цены в других валютах
+type ProductPrices struct {
+	Base   money.Money
+	Prices []money.Money
+}
+
+type PriceSource string
+
+const (
+	PriceBase      PriceSource = "base"      // валюта совпадает с валютой товара
+	PriceExplicit  PriceSource = "explicit"  // цена задана вручную в product_prices
+	PriceConverted PriceSource = "converted" // пересчитана по курсу
+)
+
+type PriceQuote struct {
+	Price  money.Money
+	Source PriceSource
+	Rate   string // курс, по которому пересчитали; пустой, если не пересчитывали
+}
+
+// PriceChange - запись истории базовой цены. Applied = false - изменение
+// запланировано и еще не наступило; нулевой EffectiveTo - цена действует сейчас
+type PriceChange struct {
+	ID            int64
+	ProductID     string
+	Price         money.Money
+	EffectiveFrom time.Time
+	EffectiveTo   time.Time
+	Applied       bool
+}
@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+type ReservationStatus string
+
+const (
+	ReservationActive    ReservationStatus = "active"
+	ReservationCommitted ReservationStatus = "committed"
+	ReservationReleased  ReservationStatus = "released"
+	ReservationExpired   ReservationStatus = "expired"
+)
+
+type ReservationItem struct {
+	ProductID string
+	Location  string
+	Quantity  int
+}
+
+// Reservation удерживает товар на складе, пока покупатель платит.
+// ID выдает сервис заказов, повторные вызовы с тем же ID идемпотентны.
+type Reservation struct {
+	ID        string
+	Status    ReservationStatus
+	Items     []ReservationItem
+	ExpiresAt time.Time
+}
@@ -0,0 +1,48 @@
+package models
+
+type SearchQuery struct {
+	Query string
+	// Prefix - поиск по мере ввода: последнее слово ищется как префикс
+	Prefix bool
+	// Language - словарь Postgres для разбора запроса, пусто - язык сервиса
+	Language string
+	// MinPrice и MaxPrice - в минорных единицах Currency, nil - без границы
+	MinPrice *int64
+	MaxPrice *int64
+	Currency string
+	Limit    int
+	Offset   int
+}
+
+type SearchHit struct {
+	ProductDigest
+	// Rank - ts_rank полнотекстового поиска или сходство от 0 до 1 в нечетком
+	Rank float32
+	// NameHighlight и Snippet - название и фрагменты описания, где
+	// совпадения обернуты в <mark></mark>
+	NameHighlight string
+	Snippet       string
+}
+
+type SearchResult struct {
+	Hits  []SearchHit
+	Total int // всего найдено, без учета Limit и Offset
+	// DidYouMean - похожие названия товаров, когда по запросу ничего не нашлось
+	DidYouMean []string
+}
+
+// FuzzyQuery - поиск с опечатками по сходству триграмм названия
+type FuzzyQuery struct {
+	Query string
+	// Threshold - минимальное сходство от 0 до 1, 0 - порог сервиса
+	Threshold float32
+	Limit     int
+	Offset    int
+}
+
+// SimilarProduct - товар с названием, похожим на проверяемое
+type SimilarProduct struct {
+	ID         string
+	Name       string
+	Similarity float32
+}
@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// ProductStatus - этап жизненного цикла товара; витрина видит только
+// StatusPublished. Допустимые переходы проверяет app
+type ProductStatus string
+
+const (
+	StatusDraft        ProductStatus = "draft"
+	StatusInReview     ProductStatus = "in_review"
+	StatusPublished    ProductStatus = "published"
+	StatusDiscontinued ProductStatus = "discontinued"
+)
+
+// Schedule - отложенные публикация и снятие с продажи, нулевое время - не
+// запланировано. PublishAt переводит товар из in_review в published,
+// UnpublishAt - из published в discontinued
+type Schedule struct {
+	PublishAt   time.Time
+	UnpublishAt time.Time
+}
@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+// DefaultLocation - склад, если клиент не указал другой
+const DefaultLocation = "main"
+
+type StockLevel struct {
+	Location string
+	Quantity int
+	Reserved int // удерживается под неоплаченные заказы, входит в Quantity
+}
+
+type Stock struct {
+	ProductID         string
+	Levels            []StockLevel
+	Total             int
+	Reserved          int
+	LowStockThreshold int // 0 - не следить за остатком
+}
+
+func (s Stock) Available() bool {
+	return s.Total > s.Reserved
+}
+
+func (s Stock) LowStock() bool {
+	return s.LowStockThreshold > 0 && s.Total <= s.LowStockThreshold
+}
+
+// StockMovement - запись журнала; Delta > 0 - приход, < 0 - списание
+type StockMovement struct {
+	ID            int64
+	ProductID     string
+	Location      string
+	Delta         int
+	QuantityAfter int
+	Reason        string
+	Actor         string
+	CreatedAt     time.Time
+}
@@ -0,0 +1,5 @@
+package models
+
+// DefaultTenant - магазин для запросов, в которых магазин не указан,
+// в нем же оказались все товары, заведенные до разделения каталога
+const DefaultTenant = "default"
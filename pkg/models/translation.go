@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// Translation - название и описание товара на другом языке
+type Translation struct {
+	Locale      string
+	Name        string
+	Description string
+	UpdatedAt   time.Time
+}
+
+// UntranslatedProduct - товар без перевода в запрошенной локали
+type UntranslatedProduct struct {
+	ID   string
+	Name string
+}
@@ -0,0 +1,16 @@
+package models
+
+import "github.com/glekoz/online-shop_product/pkg/money"
+
+type Variant struct {
+	ID        string
+	ProductID string
+	SKU       string
+	// PriceOverride - 0, если вариант продается по цене родительского товара;
+	// задается в минорных единицах валюты родительского товара
+	PriceOverride int64
+	// Price - итоговая цена, заполняется при чтении
+	Price   money.Money
+	Options map[string]string // size=M, color=red
+	Barcode string            // EAN/UPC, может быть пустым
+}
@@ -0,0 +1,288 @@
+// Package money - денежные суммы в минорных единицах (копейки, центы) с кодом валюты ISO 4217.
+// Float тут нигде не используется: суммы целые, курсы считаются через big.Rat.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// DefaultCurrency - валюта старого API (product.GRPCProduct), где цена - просто int32
+const DefaultCurrency = "RUB"
+
+var (
+	ErrUnknownCurrency  = errors.New("unknown currency")
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+	ErrInvalidRate      = errors.New("rate must be a positive decimal number")
+	ErrInvalidRounding  = errors.New("unknown rounding mode")
+	ErrOverflow         = errors.New("amount overflows int64")
+	ErrInvalidAmount    = errors.New("amount must be a decimal number")
+)
+
+type Money struct {
+	Amount   int64 // в минорных единицах валюты
+	Currency string
+}
+
+func New(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// Validate проверяет код валюты
+func (m Money) Validate() error {
+	_, err := Exponent(m.Currency)
+	return err
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// FitsInt32 - помещается ли сумма в int32 старого API
+func (m Money) FitsInt32() bool {
+	return m.Amount >= math.MinInt32 && m.Amount <= math.MaxInt32
+}
+
+// Add складывает суммы в одной валюте
+func (m Money) Add(o Money) (Money, error) {
+	if m.Currency != o.Currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, o.Currency)
+	}
+	s := m.Amount + o.Amount
+	if (s > m.Amount) != (o.Amount > 0) {
+		return Money{}, ErrOverflow
+	}
+	return Money{Amount: s, Currency: m.Currency}, nil
+}
+
+// String печатает сумму в мажорных единицах: "123.45 RUB", "500 JPY"
+func (m Money) String() string {
+	exp, err := Exponent(m.Currency)
+	if err != nil || exp == 0 {
+		return fmt.Sprintf("%d %s", m.Amount, m.Currency)
+	}
+	sign := ""
+	a := m.Amount
+	if a < 0 {
+		sign = "-"
+	}
+	abs := new(big.Int).Abs(big.NewInt(a)).String()
+	if len(abs) <= exp {
+		abs = strings.Repeat("0", exp-len(abs)+1) + abs
+	}
+	return fmt.Sprintf("%s%s.%s %s", sign, abs[:len(abs)-exp], abs[len(abs)-exp:], m.Currency)
+}
+
+// Parse читает сумму в мажорных единицах, как ее печатает String без кода валюты:
+// "123.45", "500". Знаков после точки не больше, чем у валюты: "1.005" RUB - ошибка,
+// а не округление. Десятичная запятая тоже принимается, в прайсах она встречается часто
+func Parse(amount, currency string) (Money, error) {
+	exp, err := Exponent(currency)
+	if err != nil {
+		return Money{}, err
+	}
+	s := strings.Replace(strings.TrimSpace(amount), ",", ".", 1)
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" || len(frac) > exp || strings.HasSuffix(s, ".") || !digits(whole) || !digits(frac) {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidAmount, amount)
+	}
+	frac += strings.Repeat("0", exp-len(frac))
+	n, ok := new(big.Int).SetString(whole+frac, 10)
+	if !ok || !n.IsInt64() {
+		return Money{}, ErrOverflow
+	}
+	if neg {
+		n.Neg(n)
+	}
+	return Money{Amount: n.Int64(), Currency: currency}, nil
+}
+
+func digits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Rate - курс пересчета Base -> Quote: 1 единица Base = Rate единиц Quote (в мажорных единицах).
+// Округление и шаг применяются к результату в минорных единицах Quote
+type Rate struct {
+	Base     string
+	Quote    string
+	Rate     string // десятичная строка, например "0.0108"
+	Rounding Rounding
+	// Increment - шаг округления в минорных единицах (100 - до целых рублей); 0 и 1 - без шага
+	Increment int64
+}
+
+// Validate проверяет валюты, курс и правило округления
+func (r Rate) Validate() error {
+	if _, err := Exponent(r.Base); err != nil {
+		return err
+	}
+	if _, err := Exponent(r.Quote); err != nil {
+		return err
+	}
+	if r.Base == r.Quote {
+		return fmt.Errorf("%w: base and quote are the same", ErrInvalidRate)
+	}
+	if _, err := parseRate(r.Rate); err != nil {
+		return err
+	}
+	if !r.Rounding.Valid() {
+		return ErrInvalidRounding
+	}
+	if r.Increment < 0 {
+		return fmt.Errorf("%w: increment must not be negative", ErrInvalidRate)
+	}
+	return nil
+}
+
+// Convert пересчитывает m по курсу r. Валюта m должна совпадать с r.Base
+func Convert(m Money, r Rate) (Money, error) {
+	if m.Currency != r.Base {
+		return Money{}, fmt.Errorf("%w: rate is for %s, amount is in %s", ErrCurrencyMismatch, r.Base, m.Currency)
+	}
+	from, err := Exponent(r.Base)
+	if err != nil {
+		return Money{}, err
+	}
+	to, err := Exponent(r.Quote)
+	if err != nil {
+		return Money{}, err
+	}
+	rate, err := parseRate(r.Rate)
+	if err != nil {
+		return Money{}, err
+	}
+	// amount * rate * 10^(to - from)
+	v := new(big.Rat).Mul(new(big.Rat).SetInt64(m.Amount), rate)
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(to-from))), nil))
+	if to > from {
+		v.Mul(v, scale)
+	} else {
+		v.Quo(v, scale)
+	}
+	amount, err := round(v, r.Rounding, r.Increment)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: amount, Currency: r.Quote}, nil
+}
+
+// ChangeBy меняет сумму на bp сотых долей процента: 500 - +5%, -1000 - -10%.
+// Результат округляется до минорной единицы половиной вверх
+func (m Money) ChangeBy(bp int64) (Money, error) {
+	v := new(big.Rat).SetFrac(
+		new(big.Int).Mul(big.NewInt(m.Amount), new(big.Int).Add(big.NewInt(10_000), big.NewInt(bp))),
+		big.NewInt(10_000),
+	)
+	amount, err := round(v, RoundHalfUp, 1)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: amount, Currency: m.Currency}, nil
+}
+
+func parseRate(s string) (*big.Rat, error) {
+	rate, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok || rate.Sign() <= 0 {
+		return nil, ErrInvalidRate
+	}
+	return rate, nil
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// round округляет v до целого, кратного step
+func round(v *big.Rat, mode Rounding, step int64) (int64, error) {
+	if step <= 1 {
+		step = 1
+	}
+	st := big.NewInt(step)
+	// q - целая часть v/step (к нулю), rem - остаток
+	n := new(big.Int).Mul(v.Denom(), st)
+	q, rem := new(big.Int).QuoRem(v.Num(), n, new(big.Int))
+	if rem.Sign() != 0 {
+		neg := v.Sign() < 0
+		// сравниваем |rem| с половиной делителя: 2|rem| vs n
+		cmp := new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(n)
+		away := false
+		switch mode {
+		case RoundUp:
+			away = true
+		case RoundDown:
+			away = false
+		case RoundHalfUp:
+			away = cmp >= 0
+		case RoundHalfEven:
+			away = cmp > 0 || (cmp == 0 && q.Bit(0) == 1)
+		default:
+			return 0, ErrInvalidRounding
+		}
+		if away {
+			if neg {
+				q.Sub(q, big.NewInt(1))
+			} else {
+				q.Add(q, big.NewInt(1))
+			}
+		}
+	}
+	q.Mul(q, st)
+	if !q.IsInt64() {
+		return 0, ErrOverflow
+	}
+	return q.Int64(), nil
+}
+
+// Rounding - правило округления при пересчете; значения совпадают с тем, что хранится в БД
+type Rounding string
+
+const (
+	RoundHalfUp   Rounding = "half_up"   // 0.5 -> 1, банковские расчеты в РФ
+	RoundHalfEven Rounding = "half_even" // 0.5 -> 0, 1.5 -> 2
+	RoundDown     Rounding = "down"      // к нулю
+	RoundUp       Rounding = "up"        // от нуля
+)
+
+func (r Rounding) Valid() bool {
+	switch r {
+	case RoundHalfUp, RoundHalfEven, RoundDown, RoundUp:
+		return true
+	}
+	return false
+}
+
+// Exponent возвращает число знаков после запятой у валюты (2 для RUB, 0 для JPY)
+func Exponent(code string) (int, error) {
+	exp, ok := currencies[code]
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrUnknownCurrency, code)
+	}
+	return exp, nil
+}
+
+// currencies - активные коды ISO 4217 и их минорные единицы.
+// Список не полный: только то, чем реально торгуем и с чем можем столкнуться
+var currencies = map[string]int{
+	"AED": 2, "AMD": 2, "AUD": 2, "AZN": 2, "BHD": 3, "BYN": 2, "CAD": 2,
+	"CHF": 2, "CLP": 0, "CNY": 2, "CZK": 2, "DKK": 2, "EUR": 2, "GBP": 2,
+	"GEL": 2, "HKD": 2, "HUF": 2, "IDR": 2, "ILS": 2, "INR": 2, "IQD": 3,
+	"ISK": 0, "JOD": 3, "JPY": 0, "KGS": 2, "KRW": 0, "KWD": 3, "KZT": 2,
+	"LYD": 3, "MDL": 2, "MXN": 2, "NOK": 2, "NZD": 2, "OMR": 3, "PLN": 2,
+	"RSD": 2, "RUB": 2, "SAR": 2, "SEK": 2, "SGD": 2, "THB": 2, "TJS": 2,
+	"TMT": 2, "TND": 3, "TRY": 2, "UAH": 2, "USD": 2, "UZS": 2, "VND": 0,
+	"ZAR": 2,
+}
@@ -0,0 +1,122 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name string
+		m    Money
+		r    Rate
+		want Money
+	}{
+		{"rub to usd half up", New(10050, "RUB"), Rate{Base: "RUB", Quote: "USD", Rate: "0.0125", Rounding: RoundHalfUp}, New(126, "USD")},
+		{"rub to usd down", New(10050, "RUB"), Rate{Base: "RUB", Quote: "USD", Rate: "0.0125", Rounding: RoundDown}, New(125, "USD")},
+		{"half even to even", New(100, "USD"), Rate{Base: "USD", Quote: "EUR", Rate: "0.125", Rounding: RoundHalfEven}, New(12, "EUR")},
+		{"half even to odd", New(300, "USD"), Rate{Base: "USD", Quote: "EUR", Rate: "0.125", Rounding: RoundHalfEven}, New(38, "EUR")},
+		{"to zero exponent", New(12345, "USD"), Rate{Base: "USD", Quote: "JPY", Rate: "150.5", Rounding: RoundHalfUp}, New(18579, "JPY")},
+		{"from zero exponent", New(1000, "JPY"), Rate{Base: "JPY", Quote: "KWD", Rate: "0.00205", Rounding: RoundUp}, New(2050, "KWD")},
+		{"increment to whole rubles", New(999, "USD"), Rate{Base: "USD", Quote: "RUB", Rate: "80.33", Rounding: RoundUp, Increment: 100}, New(80300, "RUB")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Convert(tt.m, tt.r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	if _, err := Convert(New(1, "EUR"), Rate{Base: "USD", Quote: "RUB", Rate: "80", Rounding: RoundHalfUp}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Fatalf("got %v, want %v", err, ErrCurrencyMismatch)
+	}
+	if _, err := Convert(New(1, "USD"), Rate{Base: "USD", Quote: "RUB", Rate: "-1", Rounding: RoundHalfUp}); !errors.Is(err, ErrInvalidRate) {
+		t.Fatalf("got %v, want %v", err, ErrInvalidRate)
+	}
+	if _, err := Convert(New(1<<62, "USD"), Rate{Base: "USD", Quote: "RUB", Rate: "100", Rounding: RoundHalfUp}); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("got %v, want %v", err, ErrOverflow)
+	}
+	if err := (Rate{Base: "USD", Quote: "XXX", Rate: "1", Rounding: RoundHalfUp}).Validate(); !errors.Is(err, ErrUnknownCurrency) {
+		t.Fatalf("got %v, want %v", err, ErrUnknownCurrency)
+	}
+}
+
+func TestString(t *testing.T) {
+	for m, want := range map[Money]string{
+		New(12345, "RUB"): "123.45 RUB",
+		New(-5, "USD"):    "-0.05 USD",
+		New(500, "JPY"):   "500 JPY",
+		New(1, "KWD"):     "0.001 KWD",
+	} {
+		if got := m.String(); got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	}
+}
+
+func TestChangeBy(t *testing.T) {
+	tests := []struct {
+		m    Money
+		bp   int64
+		want int64
+	}{
+		{New(10000, "RUB"), 500, 10500},
+		{New(199, "RUB"), 500, 209},   // 208.95
+		{New(199, "RUB"), -1000, 179}, // 179.1
+		{New(500, "JPY"), 0, 500},
+		{New(100, "RUB"), -10000, 0},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.ChangeBy(tt.bp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != New(tt.want, tt.m.Currency) {
+			t.Errorf("%v changed by %d bp: got %v, want %d", tt.m, tt.bp, got, tt.want)
+		}
+	}
+	if _, err := New(math.MaxInt64/2+1, "RUB").ChangeBy(10_000); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("got %v, want %v", err, ErrOverflow)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		amount   string
+		currency string
+		want     Money
+		err      error
+	}{
+		{"123.45", "RUB", New(12345, "RUB"), nil},
+		{"123,4", "RUB", New(12340, "RUB"), nil},
+		{" 500 ", "JPY", New(500, "JPY"), nil},
+		{"-0.5", "USD", New(-50, "USD"), nil},
+		{"1.005", "RUB", Money{}, ErrInvalidAmount},
+		{"1.", "RUB", Money{}, ErrInvalidAmount},
+		{".5", "RUB", Money{}, ErrInvalidAmount},
+		{"1e3", "RUB", Money{}, ErrInvalidAmount},
+		{"", "RUB", Money{}, ErrInvalidAmount},
+		{"99999999999999999999", "RUB", Money{}, ErrOverflow},
+		{"1", "XXX", Money{}, ErrUnknownCurrency},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.amount, tt.currency)
+		if !errors.Is(err, tt.err) || got != tt.want {
+			t.Fatalf("Parse(%q, %s) = %v, %v; want %v, %v", tt.amount, tt.currency, got, err, tt.want, tt.err)
+		}
+		if err == nil {
+			if back, _ := Parse(strings.Fields(got.String())[0], got.Currency); back != got {
+				t.Fatalf("round trip of %v gives %v", got, back)
+			}
+		}
+	}
+}
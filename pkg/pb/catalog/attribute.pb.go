@@ -0,0 +1,891 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: catalog/attribute.proto
+
+package catalog
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AttributeType int32
+
+const (
+	AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED AttributeType = 0
+	AttributeType_ATTRIBUTE_TYPE_STRING      AttributeType = 1
+	AttributeType_ATTRIBUTE_TYPE_NUMBER      AttributeType = 2
+	AttributeType_ATTRIBUTE_TYPE_BOOLEAN     AttributeType = 3
+	AttributeType_ATTRIBUTE_TYPE_ENUM        AttributeType = 4
+)
+
+// Enum value maps for AttributeType.
+var (
+	AttributeType_name = map[int32]string{
+		0: "ATTRIBUTE_TYPE_UNSPECIFIED",
+		1: "ATTRIBUTE_TYPE_STRING",
+		2: "ATTRIBUTE_TYPE_NUMBER",
+		3: "ATTRIBUTE_TYPE_BOOLEAN",
+		4: "ATTRIBUTE_TYPE_ENUM",
+	}
+	AttributeType_value = map[string]int32{
+		"ATTRIBUTE_TYPE_UNSPECIFIED": 0,
+		"ATTRIBUTE_TYPE_STRING":      1,
+		"ATTRIBUTE_TYPE_NUMBER":      2,
+		"ATTRIBUTE_TYPE_BOOLEAN":     3,
+		"ATTRIBUTE_TYPE_ENUM":        4,
+	}
+)
+
+func (x AttributeType) Enum() *AttributeType {
+	p := new(AttributeType)
+	*p = x
+	return p
+}
+
+func (x AttributeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttributeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_catalog_attribute_proto_enumTypes[0].Descriptor()
+}
+
+func (AttributeType) Type() protoreflect.EnumType {
+	return &file_catalog_attribute_proto_enumTypes[0]
+}
+
+func (x AttributeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttributeType.Descriptor instead.
+func (AttributeType) EnumDescriptor() ([]byte, []int) {
+	return file_catalog_attribute_proto_rawDescGZIP(), []int{0}
+}
+
+type AttributeDefinition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"` // ключ в значениях товара, например "color"
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Type          AttributeType          `protobuf:"varint,5,opt,name=type,proto3,enum=catalog.AttributeType" json:"type,omitempty"`
+	EnumValues    []string               `protobuf:"bytes,6,rep,name=enum_values,json=enumValues,proto3" json:"enum_values,omitempty"` // только для ATTRIBUTE_TYPE_ENUM
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeDefinition) Reset() {
+	*x = AttributeDefinition{}
+	mi := &file_catalog_attribute_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeDefinition) ProtoMessage() {}
+
+func (x *AttributeDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_attribute_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeDefinition.ProtoReflect.Descriptor instead.
+func (*AttributeDefinition) Descriptor() ([]byte, []int) {
+	return file_catalog_attribute_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AttributeDefinition) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetType() AttributeType {
+	if x != nil {
+		return x.Type
+	}
+	return AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
+}
+
+func (x *AttributeDefinition) GetEnumValues() []string {
+	if x != nil {
+		return x.EnumValues
+	}
+	return nil
+}
+
+type AttributeID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeID) Reset() {
+	*x = AttributeID{}
+	mi := &file_catalog_attribute_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeID) ProtoMessage() {}
+
+func (x *AttributeID) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_attribute_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeID.ProtoReflect.Descriptor instead.
+func (*AttributeID) Descriptor() ([]byte, []int) {
+	return file_catalog_attribute_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AttributeID) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type AttributeDefinitionList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Definitions   []*AttributeDefinition `protobuf:"bytes,1,rep,name=definitions,proto3" json:"definitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeDefinitionList) Reset() {
+	*x = AttributeDefinitionList{}
+	mi := &file_catalog_attribute_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeDefinitionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeDefinitionList) ProtoMessage() {}
+
+func (x *AttributeDefinitionList) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_attribute_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeDefinitionList.ProtoReflect.Descriptor instead.
+func (*AttributeDefinitionList) Descriptor() ([]byte, []int) {
+	return file_catalog_attribute_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AttributeDefinitionList) GetDefinitions() []*AttributeDefinition {
+	if x != nil {
+		return x.Definitions
+	}
+	return nil
+}
+
+// значение enum передается как string_value
+type AttributeValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*AttributeValue_StringValue
+	//	*AttributeValue_NumberValue
+	//	*AttributeValue_BoolValue
+	Kind          isAttributeValue_Kind `protobuf_oneof:"kind"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeValue) Reset() {
+	*x = AttributeValue{}
+	mi := &file_catalog_attribute_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeValue) ProtoMessage() {}
+
+func (x *AttributeValue) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_attribute_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeValue.ProtoReflect.Descriptor instead.
+func (*AttributeValue) Descriptor() ([]byte, []int) {
+	return file_catalog_attribute_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AttributeValue) GetKind() isAttributeValue_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *AttributeValue) GetStringValue() string {
+	if x != nil {
+		if x, ok := x.Kind.(*AttributeValue_StringValue); ok {
+			return x.StringValue
+		}
+	}
+	return ""
+}
+
+func (x *AttributeValue) GetNumberValue() float64 {
+	if x != nil {
+		if x, ok := x.Kind.(*AttributeValue_NumberValue); ok {
+			return x.NumberValue
+		}
+	}
+	return 0
+}
+
+func (x *AttributeValue) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Kind.(*AttributeValue_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+type isAttributeValue_Kind interface {
+	isAttributeValue_Kind()
+}
+
+type AttributeValue_StringValue struct {
+	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type AttributeValue_NumberValue struct {
+	NumberValue float64 `protobuf:"fixed64,2,opt,name=number_value,json=numberValue,proto3,oneof"`
+}
+
+type AttributeValue_BoolValue struct {
+	BoolValue bool `protobuf:"varint,3,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+func (*AttributeValue_StringValue) isAttributeValue_Kind() {}
+
+func (*AttributeValue_NumberValue) isAttributeValue_Kind() {}
+
+func (*AttributeValue_BoolValue) isAttributeValue_Kind() {}
+
+// SetProductAttributes заменяет все значения атрибутов товара
+type ProductAttributes struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	ProductId     string                     `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Values        map[string]*AttributeValue `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductAttributes) Reset() {
+	*x = ProductAttributes{}
+	mi := &file_catalog_attribute_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductAttributes) ProtoMessage() {}
+
+func (x *ProductAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_attribute_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductAttributes.ProtoReflect.Descriptor instead.
+func (*ProductAttributes) Descriptor() ([]byte, []int) {
+	return file_catalog_attribute_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProductAttributes) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductAttributes) GetValues() map[string]*AttributeValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// Условия по разным атрибутам объединяются через И. any_of - значение
+// товара совпадает с одним из перечисленных; min/max - диапазон для чисел.
+type AttributeFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	AnyOf         []*AttributeValue      `protobuf:"bytes,2,rep,name=any_of,json=anyOf,proto3" json:"any_of,omitempty"`
+	Min           *float64               `protobuf:"fixed64,3,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *float64               `protobuf:"fixed64,4,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeFilter) Reset() {
+	*x = AttributeFilter{}
+	mi := &file_catalog_attribute_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeFilter) ProtoMessage() {}
+
+func (x *AttributeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_attribute_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeFilter.ProtoReflect.Descriptor instead.
+func (*AttributeFilter) Descriptor() ([]byte, []int) {
+	return file_catalog_attribute_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AttributeFilter) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AttributeFilter) GetAnyOf() []*AttributeValue {
+	if x != nil {
+		return x.AnyOf
+	}
+	return nil
+}
+
+func (x *AttributeFilter) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *AttributeFilter) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+type FilterRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId         string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // пустая строка - весь каталог
+	IncludeDescendants bool                   `protobuf:"varint,2,opt,name=include_descendants,json=includeDescendants,proto3" json:"include_descendants,omitempty"`
+	Filters            []*AttributeFilter     `protobuf:"bytes,3,rep,name=filters,proto3" json:"filters,omitempty"`
+	Limit              int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset             int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *FilterRequest) Reset() {
+	*x = FilterRequest{}
+	mi := &file_catalog_attribute_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterRequest) ProtoMessage() {}
+
+func (x *FilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_attribute_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterRequest.ProtoReflect.Descriptor instead.
+func (*FilterRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_attribute_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FilterRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *FilterRequest) GetIncludeDescendants() bool {
+	if x != nil {
+		return x.IncludeDescendants
+	}
+	return false
+}
+
+func (x *FilterRequest) GetFilters() []*AttributeFilter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *FilterRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FilterRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type FacetValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *AttributeValue        `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetValue) Reset() {
+	*x = FacetValue{}
+	mi := &file_catalog_attribute_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetValue) ProtoMessage() {}
+
+func (x *FacetValue) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_attribute_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetValue.ProtoReflect.Descriptor instead.
+func (*FacetValue) Descriptor() ([]byte, []int) {
+	return file_catalog_attribute_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FacetValue) GetValue() *AttributeValue {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *FacetValue) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Для строк, enum и bool - количество товаров по значениям,
+// для чисел - минимум и максимум.
+type Facet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Values        []*FacetValue          `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	Min           *float64               `protobuf:"fixed64,3,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *float64               `protobuf:"fixed64,4,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Facet) Reset() {
+	*x = Facet{}
+	mi := &file_catalog_attribute_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Facet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Facet) ProtoMessage() {}
+
+func (x *Facet) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_attribute_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Facet.ProtoReflect.Descriptor instead.
+func (*Facet) Descriptor() ([]byte, []int) {
+	return file_catalog_attribute_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Facet) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Facet) GetValues() []*FacetValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Facet) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *Facet) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+type FilterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*ProductDigest       `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Facets        []*Facet               `protobuf:"bytes,2,rep,name=facets,proto3" json:"facets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterResponse) Reset() {
+	*x = FilterResponse{}
+	mi := &file_catalog_attribute_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterResponse) ProtoMessage() {}
+
+func (x *FilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_attribute_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterResponse.ProtoReflect.Descriptor instead.
+func (*FilterResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_attribute_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FilterResponse) GetProducts() []*ProductDigest {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *FilterResponse) GetFacets() []*Facet {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+var File_catalog_attribute_proto protoreflect.FileDescriptor
+
+const file_catalog_attribute_proto_rawDesc = "" +
+	"\n" +
+	"\x17catalog/attribute.proto\x12\acatalog\x1a\x1bgoogle/protobuf/empty.proto\x1a\x14catalog/common.proto\x1a\x16catalog/category.proto\"\xbb\x01\n" +
+	"\x13AttributeDefinition\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12*\n" +
+	"\x04type\x18\x05 \x01(\x0e2\x16.catalog.AttributeTypeR\x04type\x12\x1f\n" +
+	"\venum_values\x18\x06 \x03(\tR\n" +
+	"enumValues\"\x1d\n" +
+	"\vAttributeID\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Y\n" +
+	"\x17AttributeDefinitionList\x12>\n" +
+	"\vdefinitions\x18\x01 \x03(\v2\x1c.catalog.AttributeDefinitionR\vdefinitions\"\x83\x01\n" +
+	"\x0eAttributeValue\x12#\n" +
+	"\fstring_value\x18\x01 \x01(\tH\x00R\vstringValue\x12#\n" +
+	"\fnumber_value\x18\x02 \x01(\x01H\x00R\vnumberValue\x12\x1f\n" +
+	"\n" +
+	"bool_value\x18\x03 \x01(\bH\x00R\tboolValueB\x06\n" +
+	"\x04kind\"\xc6\x01\n" +
+	"\x11ProductAttributes\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12>\n" +
+	"\x06values\x18\x02 \x03(\v2&.catalog.ProductAttributes.ValuesEntryR\x06values\x1aR\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12-\n" +
+	"\x05value\x18\x02 \x01(\v2\x17.catalog.AttributeValueR\x05value:\x028\x01\"\x93\x01\n" +
+	"\x0fAttributeFilter\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12.\n" +
+	"\x06any_of\x18\x02 \x03(\v2\x17.catalog.AttributeValueR\x05anyOf\x12\x15\n" +
+	"\x03min\x18\x03 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x04 \x01(\x01H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"\xc3\x01\n" +
+	"\rFilterRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12/\n" +
+	"\x13include_descendants\x18\x02 \x01(\bR\x12includeDescendants\x122\n" +
+	"\afilters\x18\x03 \x03(\v2\x18.catalog.AttributeFilterR\afilters\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\"Q\n" +
+	"\n" +
+	"FacetValue\x12-\n" +
+	"\x05value\x18\x01 \x01(\v2\x17.catalog.AttributeValueR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\x86\x01\n" +
+	"\x05Facet\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12+\n" +
+	"\x06values\x18\x02 \x03(\v2\x13.catalog.FacetValueR\x06values\x12\x15\n" +
+	"\x03min\x18\x03 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x04 \x01(\x01H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"l\n" +
+	"\x0eFilterResponse\x122\n" +
+	"\bproducts\x18\x01 \x03(\v2\x16.catalog.ProductDigestR\bproducts\x12&\n" +
+	"\x06facets\x18\x02 \x03(\v2\x0e.catalog.FacetR\x06facets*\x9a\x01\n" +
+	"\rAttributeType\x12\x1e\n" +
+	"\x1aATTRIBUTE_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ATTRIBUTE_TYPE_STRING\x10\x01\x12\x19\n" +
+	"\x15ATTRIBUTE_TYPE_NUMBER\x10\x02\x12\x1a\n" +
+	"\x16ATTRIBUTE_TYPE_BOOLEAN\x10\x03\x12\x17\n" +
+	"\x13ATTRIBUTE_TYPE_ENUM\x10\x042\x94\x03\n" +
+	"\rGRPCAttribute\x12<\n" +
+	"\x06Define\x12\x1c.catalog.AttributeDefinition\x1a\x14.catalog.AttributeID\x126\n" +
+	"\x06Delete\x12\x14.catalog.AttributeID\x1a\x16.google.protobuf.Empty\x12=\n" +
+	"\x04List\x12\x13.catalog.CategoryID\x1a .catalog.AttributeDefinitionList\x12J\n" +
+	"\x14SetProductAttributes\x12\x1a.catalog.ProductAttributes\x1a\x16.google.protobuf.Empty\x12G\n" +
+	"\x14GetProductAttributes\x12\x13.catalog.ProductRef\x1a\x1a.catalog.ProductAttributes\x129\n" +
+	"\x06Filter\x12\x16.catalog.FilterRequest\x1a\x17.catalog.FilterResponseB6Z4github.com/glekoz/online-shop_product/pkg/pb/catalogb\x06proto3"
+
+var (
+	file_catalog_attribute_proto_rawDescOnce sync.Once
+	file_catalog_attribute_proto_rawDescData []byte
+)
+
+func file_catalog_attribute_proto_rawDescGZIP() []byte {
+	file_catalog_attribute_proto_rawDescOnce.Do(func() {
+		file_catalog_attribute_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_catalog_attribute_proto_rawDesc), len(file_catalog_attribute_proto_rawDesc)))
+	})
+	return file_catalog_attribute_proto_rawDescData
+}
+
+var file_catalog_attribute_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_catalog_attribute_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_catalog_attribute_proto_goTypes = []any{
+	(AttributeType)(0),              // 0: catalog.AttributeType
+	(*AttributeDefinition)(nil),     // 1: catalog.AttributeDefinition
+	(*AttributeID)(nil),             // 2: catalog.AttributeID
+	(*AttributeDefinitionList)(nil), // 3: catalog.AttributeDefinitionList
+	(*AttributeValue)(nil),          // 4: catalog.AttributeValue
+	(*ProductAttributes)(nil),       // 5: catalog.ProductAttributes
+	(*AttributeFilter)(nil),         // 6: catalog.AttributeFilter
+	(*FilterRequest)(nil),           // 7: catalog.FilterRequest
+	(*FacetValue)(nil),              // 8: catalog.FacetValue
+	(*Facet)(nil),                   // 9: catalog.Facet
+	(*FilterResponse)(nil),          // 10: catalog.FilterResponse
+	nil,                             // 11: catalog.ProductAttributes.ValuesEntry
+	(*ProductDigest)(nil),           // 12: catalog.ProductDigest
+	(*CategoryID)(nil),              // 13: catalog.CategoryID
+	(*ProductRef)(nil),              // 14: catalog.ProductRef
+	(*emptypb.Empty)(nil),           // 15: google.protobuf.Empty
+}
+var file_catalog_attribute_proto_depIdxs = []int32{
+	0,  // 0: catalog.AttributeDefinition.type:type_name -> catalog.AttributeType
+	1,  // 1: catalog.AttributeDefinitionList.definitions:type_name -> catalog.AttributeDefinition
+	11, // 2: catalog.ProductAttributes.values:type_name -> catalog.ProductAttributes.ValuesEntry
+	4,  // 3: catalog.AttributeFilter.any_of:type_name -> catalog.AttributeValue
+	6,  // 4: catalog.FilterRequest.filters:type_name -> catalog.AttributeFilter
+	4,  // 5: catalog.FacetValue.value:type_name -> catalog.AttributeValue
+	8,  // 6: catalog.Facet.values:type_name -> catalog.FacetValue
+	12, // 7: catalog.FilterResponse.products:type_name -> catalog.ProductDigest
+	9,  // 8: catalog.FilterResponse.facets:type_name -> catalog.Facet
+	4,  // 9: catalog.ProductAttributes.ValuesEntry.value:type_name -> catalog.AttributeValue
+	1,  // 10: catalog.GRPCAttribute.Define:input_type -> catalog.AttributeDefinition
+	2,  // 11: catalog.GRPCAttribute.Delete:input_type -> catalog.AttributeID
+	13, // 12: catalog.GRPCAttribute.List:input_type -> catalog.CategoryID
+	5,  // 13: catalog.GRPCAttribute.SetProductAttributes:input_type -> catalog.ProductAttributes
+	14, // 14: catalog.GRPCAttribute.GetProductAttributes:input_type -> catalog.ProductRef
+	7,  // 15: catalog.GRPCAttribute.Filter:input_type -> catalog.FilterRequest
+	2,  // 16: catalog.GRPCAttribute.Define:output_type -> catalog.AttributeID
+	15, // 17: catalog.GRPCAttribute.Delete:output_type -> google.protobuf.Empty
+	3,  // 18: catalog.GRPCAttribute.List:output_type -> catalog.AttributeDefinitionList
+	15, // 19: catalog.GRPCAttribute.SetProductAttributes:output_type -> google.protobuf.Empty
+	5,  // 20: catalog.GRPCAttribute.GetProductAttributes:output_type -> catalog.ProductAttributes
+	10, // 21: catalog.GRPCAttribute.Filter:output_type -> catalog.FilterResponse
+	16, // [16:22] is the sub-list for method output_type
+	10, // [10:16] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_catalog_attribute_proto_init() }
+func file_catalog_attribute_proto_init() {
+	if File_catalog_attribute_proto != nil {
+		return
+	}
+	file_catalog_common_proto_init()
+	file_catalog_category_proto_init()
+	file_catalog_attribute_proto_msgTypes[3].OneofWrappers = []any{
+		(*AttributeValue_StringValue)(nil),
+		(*AttributeValue_NumberValue)(nil),
+		(*AttributeValue_BoolValue)(nil),
+	}
+	file_catalog_attribute_proto_msgTypes[5].OneofWrappers = []any{}
+	file_catalog_attribute_proto_msgTypes[8].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_attribute_proto_rawDesc), len(file_catalog_attribute_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_catalog_attribute_proto_goTypes,
+		DependencyIndexes: file_catalog_attribute_proto_depIdxs,
+		EnumInfos:         file_catalog_attribute_proto_enumTypes,
+		MessageInfos:      file_catalog_attribute_proto_msgTypes,
+	}.Build()
+	File_catalog_attribute_proto = out.File
+	file_catalog_attribute_proto_goTypes = nil
+	file_catalog_attribute_proto_depIdxs = nil
+}
@@ -0,0 +1,320 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: catalog/attribute.proto
+
+package catalog
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GRPCAttribute_Define_FullMethodName               = "/catalog.GRPCAttribute/Define"
+	GRPCAttribute_Delete_FullMethodName               = "/catalog.GRPCAttribute/Delete"
+	GRPCAttribute_List_FullMethodName                 = "/catalog.GRPCAttribute/List"
+	GRPCAttribute_SetProductAttributes_FullMethodName = "/catalog.GRPCAttribute/SetProductAttributes"
+	GRPCAttribute_GetProductAttributes_FullMethodName = "/catalog.GRPCAttribute/GetProductAttributes"
+	GRPCAttribute_Filter_FullMethodName               = "/catalog.GRPCAttribute/Filter"
+)
+
+// GRPCAttributeClient is the client API for GRPCAttribute service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Атрибуты описываются на уровне категории и наследуются подкатегориями.
+// Значения атрибутов товара проверяются по определениям всех его категорий.
+type GRPCAttributeClient interface {
+	Define(ctx context.Context, in *AttributeDefinition, opts ...grpc.CallOption) (*AttributeID, error)
+	Delete(ctx context.Context, in *AttributeID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// определения категории вместе с унаследованными от предков
+	List(ctx context.Context, in *CategoryID, opts ...grpc.CallOption) (*AttributeDefinitionList, error)
+	SetProductAttributes(ctx context.Context, in *ProductAttributes, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetProductAttributes(ctx context.Context, in *ProductRef, opts ...grpc.CallOption) (*ProductAttributes, error)
+	Filter(ctx context.Context, in *FilterRequest, opts ...grpc.CallOption) (*FilterResponse, error)
+}
+
+type gRPCAttributeClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGRPCAttributeClient(cc grpc.ClientConnInterface) GRPCAttributeClient {
+	return &gRPCAttributeClient{cc}
+}
+
+func (c *gRPCAttributeClient) Define(ctx context.Context, in *AttributeDefinition, opts ...grpc.CallOption) (*AttributeID, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttributeID)
+	err := c.cc.Invoke(ctx, GRPCAttribute_Define_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCAttributeClient) Delete(ctx context.Context, in *AttributeID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCAttribute_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCAttributeClient) List(ctx context.Context, in *CategoryID, opts ...grpc.CallOption) (*AttributeDefinitionList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttributeDefinitionList)
+	err := c.cc.Invoke(ctx, GRPCAttribute_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCAttributeClient) SetProductAttributes(ctx context.Context, in *ProductAttributes, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCAttribute_SetProductAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCAttributeClient) GetProductAttributes(ctx context.Context, in *ProductRef, opts ...grpc.CallOption) (*ProductAttributes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductAttributes)
+	err := c.cc.Invoke(ctx, GRPCAttribute_GetProductAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCAttributeClient) Filter(ctx context.Context, in *FilterRequest, opts ...grpc.CallOption) (*FilterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FilterResponse)
+	err := c.cc.Invoke(ctx, GRPCAttribute_Filter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GRPCAttributeServer is the server API for GRPCAttribute service.
+// All implementations must embed UnimplementedGRPCAttributeServer
+// for forward compatibility.
+//
+// Атрибуты описываются на уровне категории и наследуются подкатегориями.
+// Значения атрибутов товара проверяются по определениям всех его категорий.
+type GRPCAttributeServer interface {
+	Define(context.Context, *AttributeDefinition) (*AttributeID, error)
+	Delete(context.Context, *AttributeID) (*emptypb.Empty, error)
+	// определения категории вместе с унаследованными от предков
+	List(context.Context, *CategoryID) (*AttributeDefinitionList, error)
+	SetProductAttributes(context.Context, *ProductAttributes) (*emptypb.Empty, error)
+	GetProductAttributes(context.Context, *ProductRef) (*ProductAttributes, error)
+	Filter(context.Context, *FilterRequest) (*FilterResponse, error)
+	mustEmbedUnimplementedGRPCAttributeServer()
+}
+
+// UnimplementedGRPCAttributeServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGRPCAttributeServer struct{}
+
+func (UnimplementedGRPCAttributeServer) Define(context.Context, *AttributeDefinition) (*AttributeID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Define not implemented")
+}
+func (UnimplementedGRPCAttributeServer) Delete(context.Context, *AttributeID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedGRPCAttributeServer) List(context.Context, *CategoryID) (*AttributeDefinitionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedGRPCAttributeServer) SetProductAttributes(context.Context, *ProductAttributes) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProductAttributes not implemented")
+}
+func (UnimplementedGRPCAttributeServer) GetProductAttributes(context.Context, *ProductRef) (*ProductAttributes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductAttributes not implemented")
+}
+func (UnimplementedGRPCAttributeServer) Filter(context.Context, *FilterRequest) (*FilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Filter not implemented")
+}
+func (UnimplementedGRPCAttributeServer) mustEmbedUnimplementedGRPCAttributeServer() {}
+func (UnimplementedGRPCAttributeServer) testEmbeddedByValue()                       {}
+
+// UnsafeGRPCAttributeServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GRPCAttributeServer will
+// result in compilation errors.
+type UnsafeGRPCAttributeServer interface {
+	mustEmbedUnimplementedGRPCAttributeServer()
+}
+
+func RegisterGRPCAttributeServer(s grpc.ServiceRegistrar, srv GRPCAttributeServer) {
+	// If the following call pancis, it indicates UnimplementedGRPCAttributeServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GRPCAttribute_ServiceDesc, srv)
+}
+
+func _GRPCAttribute_Define_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttributeDefinition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCAttributeServer).Define(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCAttribute_Define_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCAttributeServer).Define(ctx, req.(*AttributeDefinition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCAttribute_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttributeID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCAttributeServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCAttribute_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCAttributeServer).Delete(ctx, req.(*AttributeID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCAttribute_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCAttributeServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCAttribute_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCAttributeServer).List(ctx, req.(*CategoryID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCAttribute_SetProductAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductAttributes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCAttributeServer).SetProductAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCAttribute_SetProductAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCAttributeServer).SetProductAttributes(ctx, req.(*ProductAttributes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCAttribute_GetProductAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCAttributeServer).GetProductAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCAttribute_GetProductAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCAttributeServer).GetProductAttributes(ctx, req.(*ProductRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCAttribute_Filter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCAttributeServer).Filter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCAttribute_Filter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCAttributeServer).Filter(ctx, req.(*FilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GRPCAttribute_ServiceDesc is the grpc.ServiceDesc for GRPCAttribute service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GRPCAttribute_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "catalog.GRPCAttribute",
+	HandlerType: (*GRPCAttributeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Define",
+			Handler:    _GRPCAttribute_Define_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _GRPCAttribute_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _GRPCAttribute_List_Handler,
+		},
+		{
+			MethodName: "SetProductAttributes",
+			Handler:    _GRPCAttribute_SetProductAttributes_Handler,
+		},
+		{
+			MethodName: "GetProductAttributes",
+			Handler:    _GRPCAttribute_GetProductAttributes_Handler,
+		},
+		{
+			MethodName: "Filter",
+			Handler:    _GRPCAttribute_Filter_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "catalog/attribute.proto",
+}
@@ -0,0 +1,131 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: catalog/audit.proto
+
+package catalog
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GRPCAudit_List_FullMethodName = "/catalog.GRPCAudit/List"
+)
+
+// GRPCAuditClient is the client API for GRPCAudit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Журнал создания, изменения и удаления товаров. Записи пишутся в той же
+// транзакции, что и изменение; доступен только администраторам.
+type GRPCAuditClient interface {
+	// List листает журнал товара или пользователя, новые записи первыми;
+	// нужен хотя бы один из product_id и actor
+	List(ctx context.Context, in *ListAuditRequest, opts ...grpc.CallOption) (*AuditEntries, error)
+}
+
+type gRPCAuditClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGRPCAuditClient(cc grpc.ClientConnInterface) GRPCAuditClient {
+	return &gRPCAuditClient{cc}
+}
+
+func (c *gRPCAuditClient) List(ctx context.Context, in *ListAuditRequest, opts ...grpc.CallOption) (*AuditEntries, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditEntries)
+	err := c.cc.Invoke(ctx, GRPCAudit_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GRPCAuditServer is the server API for GRPCAudit service.
+// All implementations must embed UnimplementedGRPCAuditServer
+// for forward compatibility.
+//
+// Журнал создания, изменения и удаления товаров. Записи пишутся в той же
+// транзакции, что и изменение; доступен только администраторам.
+type GRPCAuditServer interface {
+	// List листает журнал товара или пользователя, новые записи первыми;
+	// нужен хотя бы один из product_id и actor
+	List(context.Context, *ListAuditRequest) (*AuditEntries, error)
+	mustEmbedUnimplementedGRPCAuditServer()
+}
+
+// UnimplementedGRPCAuditServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGRPCAuditServer struct{}
+
+func (UnimplementedGRPCAuditServer) List(context.Context, *ListAuditRequest) (*AuditEntries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedGRPCAuditServer) mustEmbedUnimplementedGRPCAuditServer() {}
+func (UnimplementedGRPCAuditServer) testEmbeddedByValue()                   {}
+
+// UnsafeGRPCAuditServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GRPCAuditServer will
+// result in compilation errors.
+type UnsafeGRPCAuditServer interface {
+	mustEmbedUnimplementedGRPCAuditServer()
+}
+
+func RegisterGRPCAuditServer(s grpc.ServiceRegistrar, srv GRPCAuditServer) {
+	// If the following call pancis, it indicates UnimplementedGRPCAuditServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GRPCAudit_ServiceDesc, srv)
+}
+
+func _GRPCAudit_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCAuditServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCAudit_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCAuditServer).List(ctx, req.(*ListAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GRPCAudit_ServiceDesc is the grpc.ServiceDesc for GRPCAudit service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GRPCAudit_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "catalog.GRPCAudit",
+	HandlerType: (*GRPCAuditServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _GRPCAudit_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "catalog/audit.proto",
+}
@@ -0,0 +1,614 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: catalog/category.proto
+
+package catalog
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Category struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId      string                 `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // пустая строка - корневая категория
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_catalog_category_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_category_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_catalog_category_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Category) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Category) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type CategoryID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryID) Reset() {
+	*x = CategoryID{}
+	mi := &file_catalog_category_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryID) ProtoMessage() {}
+
+func (x *CategoryID) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_category_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryID.ProtoReflect.Descriptor instead.
+func (*CategoryID) Descriptor() ([]byte, []int) {
+	return file_catalog_category_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CategoryID) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CategorySlug struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategorySlug) Reset() {
+	*x = CategorySlug{}
+	mi := &file_catalog_category_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategorySlug) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategorySlug) ProtoMessage() {}
+
+func (x *CategorySlug) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_category_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategorySlug.ProtoReflect.Descriptor instead.
+func (*CategorySlug) Descriptor() ([]byte, []int) {
+	return file_catalog_category_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CategorySlug) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type CategoryNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Children      []*CategoryNode        `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryNode) Reset() {
+	*x = CategoryNode{}
+	mi := &file_catalog_category_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryNode) ProtoMessage() {}
+
+func (x *CategoryNode) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_category_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryNode.ProtoReflect.Descriptor instead.
+func (*CategoryNode) Descriptor() ([]byte, []int) {
+	return file_catalog_category_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CategoryNode) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *CategoryNode) GetChildren() []*CategoryNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type CategoryTree struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roots         []*CategoryNode        `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryTree) Reset() {
+	*x = CategoryTree{}
+	mi := &file_catalog_category_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryTree) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryTree) ProtoMessage() {}
+
+func (x *CategoryTree) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_category_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryTree.ProtoReflect.Descriptor instead.
+func (*CategoryTree) Descriptor() ([]byte, []int) {
+	return file_catalog_category_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CategoryTree) GetRoots() []*CategoryNode {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+type CategoryList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryList) Reset() {
+	*x = CategoryList{}
+	mi := &file_catalog_category_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryList) ProtoMessage() {}
+
+func (x *CategoryList) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_category_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryList.ProtoReflect.Descriptor instead.
+func (*CategoryList) Descriptor() ([]byte, []int) {
+	return file_catalog_category_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CategoryList) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type ProductRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductRef) Reset() {
+	*x = ProductRef{}
+	mi := &file_catalog_category_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductRef) ProtoMessage() {}
+
+func (x *ProductRef) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_category_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductRef.ProtoReflect.Descriptor instead.
+func (*ProductRef) Descriptor() ([]byte, []int) {
+	return file_catalog_category_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProductRef) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// SetProductCategories заменяет весь набор категорий товара
+type ProductCategories struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	CategoryIds   []string               `protobuf:"bytes,2,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductCategories) Reset() {
+	*x = ProductCategories{}
+	mi := &file_catalog_category_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductCategories) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductCategories) ProtoMessage() {}
+
+func (x *ProductCategories) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_category_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductCategories.ProtoReflect.Descriptor instead.
+func (*ProductCategories) Descriptor() ([]byte, []int) {
+	return file_catalog_category_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ProductCategories) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductCategories) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+type ListProductsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId         string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	IncludeDescendants bool                   `protobuf:"varint,2,opt,name=include_descendants,json=includeDescendants,proto3" json:"include_descendants,omitempty"`
+	Limit              int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset             int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_catalog_category_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_category_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_category_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListProductsRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetIncludeDescendants() bool {
+	if x != nil {
+		return x.IncludeDescendants
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+var File_catalog_category_proto protoreflect.FileDescriptor
+
+const file_catalog_category_proto_rawDesc = "" +
+	"\n" +
+	"\x16catalog/category.proto\x12\acatalog\x1a\x1bgoogle/protobuf/empty.proto\x1a\x14catalog/common.proto\"_\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x04 \x01(\tR\x04slug\"\x1c\n" +
+	"\n" +
+	"CategoryID\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\"\n" +
+	"\fCategorySlug\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\"p\n" +
+	"\fCategoryNode\x12-\n" +
+	"\bcategory\x18\x01 \x01(\v2\x11.catalog.CategoryR\bcategory\x121\n" +
+	"\bchildren\x18\x02 \x03(\v2\x15.catalog.CategoryNodeR\bchildren\";\n" +
+	"\fCategoryTree\x12+\n" +
+	"\x05roots\x18\x01 \x03(\v2\x15.catalog.CategoryNodeR\x05roots\"A\n" +
+	"\fCategoryList\x121\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x11.catalog.CategoryR\n" +
+	"categories\"+\n" +
+	"\n" +
+	"ProductRef\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"U\n" +
+	"\x11ProductCategories\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12!\n" +
+	"\fcategory_ids\x18\x02 \x03(\tR\vcategoryIds\"\x95\x01\n" +
+	"\x13ListProductsRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12/\n" +
+	"\x13include_descendants\x18\x02 \x01(\bR\x12includeDescendants\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset2\x9d\x04\n" +
+	"\fGRPCCategory\x120\n" +
+	"\x06Create\x12\x11.catalog.Category\x1a\x13.catalog.CategoryID\x12-\n" +
+	"\x03Get\x12\x13.catalog.CategoryID\x1a\x11.catalog.Category\x125\n" +
+	"\tGetBySlug\x12\x15.catalog.CategorySlug\x1a\x11.catalog.Category\x125\n" +
+	"\x04Tree\x12\x16.google.protobuf.Empty\x1a\x15.catalog.CategoryTree\x123\n" +
+	"\x06Update\x12\x11.catalog.Category\x1a\x16.google.protobuf.Empty\x125\n" +
+	"\x06Delete\x12\x13.catalog.CategoryID\x1a\x16.google.protobuf.Empty\x12J\n" +
+	"\x14SetProductCategories\x12\x1a.catalog.ProductCategories\x1a\x16.google.protobuf.Empty\x12B\n" +
+	"\x14GetProductCategories\x12\x13.catalog.ProductRef\x1a\x15.catalog.CategoryList\x12B\n" +
+	"\fListProducts\x12\x1c.catalog.ListProductsRequest\x1a\x14.catalog.ProductListB6Z4github.com/glekoz/online-shop_product/pkg/pb/catalogb\x06proto3"
+
+var (
+	file_catalog_category_proto_rawDescOnce sync.Once
+	file_catalog_category_proto_rawDescData []byte
+)
+
+func file_catalog_category_proto_rawDescGZIP() []byte {
+	file_catalog_category_proto_rawDescOnce.Do(func() {
+		file_catalog_category_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_catalog_category_proto_rawDesc), len(file_catalog_category_proto_rawDesc)))
+	})
+	return file_catalog_category_proto_rawDescData
+}
+
+var file_catalog_category_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_catalog_category_proto_goTypes = []any{
+	(*Category)(nil),            // 0: catalog.Category
+	(*CategoryID)(nil),          // 1: catalog.CategoryID
+	(*CategorySlug)(nil),        // 2: catalog.CategorySlug
+	(*CategoryNode)(nil),        // 3: catalog.CategoryNode
+	(*CategoryTree)(nil),        // 4: catalog.CategoryTree
+	(*CategoryList)(nil),        // 5: catalog.CategoryList
+	(*ProductRef)(nil),          // 6: catalog.ProductRef
+	(*ProductCategories)(nil),   // 7: catalog.ProductCategories
+	(*ListProductsRequest)(nil), // 8: catalog.ListProductsRequest
+	(*emptypb.Empty)(nil),       // 9: google.protobuf.Empty
+	(*ProductList)(nil),         // 10: catalog.ProductList
+}
+var file_catalog_category_proto_depIdxs = []int32{
+	0,  // 0: catalog.CategoryNode.category:type_name -> catalog.Category
+	3,  // 1: catalog.CategoryNode.children:type_name -> catalog.CategoryNode
+	3,  // 2: catalog.CategoryTree.roots:type_name -> catalog.CategoryNode
+	0,  // 3: catalog.CategoryList.categories:type_name -> catalog.Category
+	0,  // 4: catalog.GRPCCategory.Create:input_type -> catalog.Category
+	1,  // 5: catalog.GRPCCategory.Get:input_type -> catalog.CategoryID
+	2,  // 6: catalog.GRPCCategory.GetBySlug:input_type -> catalog.CategorySlug
+	9,  // 7: catalog.GRPCCategory.Tree:input_type -> google.protobuf.Empty
+	0,  // 8: catalog.GRPCCategory.Update:input_type -> catalog.Category
+	1,  // 9: catalog.GRPCCategory.Delete:input_type -> catalog.CategoryID
+	7,  // 10: catalog.GRPCCategory.SetProductCategories:input_type -> catalog.ProductCategories
+	6,  // 11: catalog.GRPCCategory.GetProductCategories:input_type -> catalog.ProductRef
+	8,  // 12: catalog.GRPCCategory.ListProducts:input_type -> catalog.ListProductsRequest
+	1,  // 13: catalog.GRPCCategory.Create:output_type -> catalog.CategoryID
+	0,  // 14: catalog.GRPCCategory.Get:output_type -> catalog.Category
+	0,  // 15: catalog.GRPCCategory.GetBySlug:output_type -> catalog.Category
+	4,  // 16: catalog.GRPCCategory.Tree:output_type -> catalog.CategoryTree
+	9,  // 17: catalog.GRPCCategory.Update:output_type -> google.protobuf.Empty
+	9,  // 18: catalog.GRPCCategory.Delete:output_type -> google.protobuf.Empty
+	9,  // 19: catalog.GRPCCategory.SetProductCategories:output_type -> google.protobuf.Empty
+	5,  // 20: catalog.GRPCCategory.GetProductCategories:output_type -> catalog.CategoryList
+	10, // 21: catalog.GRPCCategory.ListProducts:output_type -> catalog.ProductList
+	13, // [13:22] is the sub-list for method output_type
+	4,  // [4:13] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_catalog_category_proto_init() }
+func file_catalog_category_proto_init() {
+	if File_catalog_category_proto != nil {
+		return
+	}
+	file_catalog_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_category_proto_rawDesc), len(file_catalog_category_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_catalog_category_proto_goTypes,
+		DependencyIndexes: file_catalog_category_proto_depIdxs,
+		MessageInfos:      file_catalog_category_proto_msgTypes,
+	}.Build()
+	File_catalog_category_proto = out.File
+	file_catalog_category_proto_goTypes = nil
+	file_catalog_category_proto_depIdxs = nil
+}
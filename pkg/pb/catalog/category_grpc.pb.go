@@ -0,0 +1,426 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: catalog/category.proto
+
+package catalog
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GRPCCategory_Create_FullMethodName               = "/catalog.GRPCCategory/Create"
+	GRPCCategory_Get_FullMethodName                  = "/catalog.GRPCCategory/Get"
+	GRPCCategory_GetBySlug_FullMethodName            = "/catalog.GRPCCategory/GetBySlug"
+	GRPCCategory_Tree_FullMethodName                 = "/catalog.GRPCCategory/Tree"
+	GRPCCategory_Update_FullMethodName               = "/catalog.GRPCCategory/Update"
+	GRPCCategory_Delete_FullMethodName               = "/catalog.GRPCCategory/Delete"
+	GRPCCategory_SetProductCategories_FullMethodName = "/catalog.GRPCCategory/SetProductCategories"
+	GRPCCategory_GetProductCategories_FullMethodName = "/catalog.GRPCCategory/GetProductCategories"
+	GRPCCategory_ListProducts_FullMethodName         = "/catalog.GRPCCategory/ListProducts"
+)
+
+// GRPCCategoryClient is the client API for GRPCCategory service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GRPCCategoryClient interface {
+	Create(ctx context.Context, in *Category, opts ...grpc.CallOption) (*CategoryID, error)
+	Get(ctx context.Context, in *CategoryID, opts ...grpc.CallOption) (*Category, error)
+	GetBySlug(ctx context.Context, in *CategorySlug, opts ...grpc.CallOption) (*Category, error)
+	Tree(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CategoryTree, error)
+	Update(ctx context.Context, in *Category, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Delete(ctx context.Context, in *CategoryID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetProductCategories(ctx context.Context, in *ProductCategories, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetProductCategories(ctx context.Context, in *ProductRef, opts ...grpc.CallOption) (*CategoryList, error)
+	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ProductList, error)
+}
+
+type gRPCCategoryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGRPCCategoryClient(cc grpc.ClientConnInterface) GRPCCategoryClient {
+	return &gRPCCategoryClient{cc}
+}
+
+func (c *gRPCCategoryClient) Create(ctx context.Context, in *Category, opts ...grpc.CallOption) (*CategoryID, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryID)
+	err := c.cc.Invoke(ctx, GRPCCategory_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCCategoryClient) Get(ctx context.Context, in *CategoryID, opts ...grpc.CallOption) (*Category, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Category)
+	err := c.cc.Invoke(ctx, GRPCCategory_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCCategoryClient) GetBySlug(ctx context.Context, in *CategorySlug, opts ...grpc.CallOption) (*Category, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Category)
+	err := c.cc.Invoke(ctx, GRPCCategory_GetBySlug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCCategoryClient) Tree(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CategoryTree, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryTree)
+	err := c.cc.Invoke(ctx, GRPCCategory_Tree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCCategoryClient) Update(ctx context.Context, in *Category, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCCategory_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCCategoryClient) Delete(ctx context.Context, in *CategoryID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCCategory_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCCategoryClient) SetProductCategories(ctx context.Context, in *ProductCategories, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCCategory_SetProductCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCCategoryClient) GetProductCategories(ctx context.Context, in *ProductRef, opts ...grpc.CallOption) (*CategoryList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryList)
+	err := c.cc.Invoke(ctx, GRPCCategory_GetProductCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCCategoryClient) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ProductList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductList)
+	err := c.cc.Invoke(ctx, GRPCCategory_ListProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GRPCCategoryServer is the server API for GRPCCategory service.
+// All implementations must embed UnimplementedGRPCCategoryServer
+// for forward compatibility.
+type GRPCCategoryServer interface {
+	Create(context.Context, *Category) (*CategoryID, error)
+	Get(context.Context, *CategoryID) (*Category, error)
+	GetBySlug(context.Context, *CategorySlug) (*Category, error)
+	Tree(context.Context, *emptypb.Empty) (*CategoryTree, error)
+	Update(context.Context, *Category) (*emptypb.Empty, error)
+	Delete(context.Context, *CategoryID) (*emptypb.Empty, error)
+	SetProductCategories(context.Context, *ProductCategories) (*emptypb.Empty, error)
+	GetProductCategories(context.Context, *ProductRef) (*CategoryList, error)
+	ListProducts(context.Context, *ListProductsRequest) (*ProductList, error)
+	mustEmbedUnimplementedGRPCCategoryServer()
+}
+
+// UnimplementedGRPCCategoryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGRPCCategoryServer struct{}
+
+func (UnimplementedGRPCCategoryServer) Create(context.Context, *Category) (*CategoryID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedGRPCCategoryServer) Get(context.Context, *CategoryID) (*Category, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedGRPCCategoryServer) GetBySlug(context.Context, *CategorySlug) (*Category, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBySlug not implemented")
+}
+func (UnimplementedGRPCCategoryServer) Tree(context.Context, *emptypb.Empty) (*CategoryTree, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tree not implemented")
+}
+func (UnimplementedGRPCCategoryServer) Update(context.Context, *Category) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedGRPCCategoryServer) Delete(context.Context, *CategoryID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedGRPCCategoryServer) SetProductCategories(context.Context, *ProductCategories) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProductCategories not implemented")
+}
+func (UnimplementedGRPCCategoryServer) GetProductCategories(context.Context, *ProductRef) (*CategoryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductCategories not implemented")
+}
+func (UnimplementedGRPCCategoryServer) ListProducts(context.Context, *ListProductsRequest) (*ProductList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
+}
+func (UnimplementedGRPCCategoryServer) mustEmbedUnimplementedGRPCCategoryServer() {}
+func (UnimplementedGRPCCategoryServer) testEmbeddedByValue()                      {}
+
+// UnsafeGRPCCategoryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GRPCCategoryServer will
+// result in compilation errors.
+type UnsafeGRPCCategoryServer interface {
+	mustEmbedUnimplementedGRPCCategoryServer()
+}
+
+func RegisterGRPCCategoryServer(s grpc.ServiceRegistrar, srv GRPCCategoryServer) {
+	// If the following call pancis, it indicates UnimplementedGRPCCategoryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GRPCCategory_ServiceDesc, srv)
+}
+
+func _GRPCCategory_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Category)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCCategoryServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCCategory_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCCategoryServer).Create(ctx, req.(*Category))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCCategory_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCCategoryServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCCategory_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCCategoryServer).Get(ctx, req.(*CategoryID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCCategory_GetBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategorySlug)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCCategoryServer).GetBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCCategory_GetBySlug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCCategoryServer).GetBySlug(ctx, req.(*CategorySlug))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCCategory_Tree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCCategoryServer).Tree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCCategory_Tree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCCategoryServer).Tree(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCCategory_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Category)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCCategoryServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCCategory_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCCategoryServer).Update(ctx, req.(*Category))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCCategory_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCCategoryServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCCategory_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCCategoryServer).Delete(ctx, req.(*CategoryID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCCategory_SetProductCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductCategories)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCCategoryServer).SetProductCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCCategory_SetProductCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCCategoryServer).SetProductCategories(ctx, req.(*ProductCategories))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCCategory_GetProductCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCCategoryServer).GetProductCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCCategory_GetProductCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCCategoryServer).GetProductCategories(ctx, req.(*ProductRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCCategory_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCCategoryServer).ListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCCategory_ListProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCCategoryServer).ListProducts(ctx, req.(*ListProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GRPCCategory_ServiceDesc is the grpc.ServiceDesc for GRPCCategory service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GRPCCategory_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "catalog.GRPCCategory",
+	HandlerType: (*GRPCCategoryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _GRPCCategory_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _GRPCCategory_Get_Handler,
+		},
+		{
+			MethodName: "GetBySlug",
+			Handler:    _GRPCCategory_GetBySlug_Handler,
+		},
+		{
+			MethodName: "Tree",
+			Handler:    _GRPCCategory_Tree_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _GRPCCategory_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _GRPCCategory_Delete_Handler,
+		},
+		{
+			MethodName: "SetProductCategories",
+			Handler:    _GRPCCategory_SetProductCategories_Handler,
+		},
+		{
+			MethodName: "GetProductCategories",
+			Handler:    _GRPCCategory_GetProductCategories_Handler,
+		},
+		{
+			MethodName: "ListProducts",
+			Handler:    _GRPCCategory_ListProducts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "catalog/category.proto",
+}
@@ -0,0 +1,536 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: catalog/common.proto
+
+package catalog
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ProductDigest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price          int64                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`                                         // в минорных единицах currency
+	Available      bool                   `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"`                                 // есть на складе
+	Currency       string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`                                    // ISO 4217
+	EffectivePrice int64                  `protobuf:"varint,6,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"` // с учетом акций, в той же валюте
+	PromotionIds   []string               `protobuf:"bytes,7,rep,name=promotion_ids,json=promotionIds,proto3" json:"promotion_ids,omitempty"`        // примененные акции
+	PrimaryImage   *Image                 `protobuf:"bytes,8,opt,name=primary_image,json=primaryImage,proto3" json:"primary_image,omitempty"`        // не задана, если у товара нет картинок
+	Locale         string                 `protobuf:"bytes,9,opt,name=locale,proto3" json:"locale,omitempty"`                                        // язык name, выбранный по accept-language
+	Slug           string                 `protobuf:"bytes,10,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProductDigest) Reset() {
+	*x = ProductDigest{}
+	mi := &file_catalog_common_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductDigest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductDigest) ProtoMessage() {}
+
+func (x *ProductDigest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_common_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductDigest.ProtoReflect.Descriptor instead.
+func (*ProductDigest) Descriptor() ([]byte, []int) {
+	return file_catalog_common_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProductDigest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductDigest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductDigest) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ProductDigest) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *ProductDigest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ProductDigest) GetEffectivePrice() int64 {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return 0
+}
+
+func (x *ProductDigest) GetPromotionIds() []string {
+	if x != nil {
+		return x.PromotionIds
+	}
+	return nil
+}
+
+func (x *ProductDigest) GetPrimaryImage() *Image {
+	if x != nil {
+		return x.PrimaryImage
+	}
+	return nil
+}
+
+func (x *ProductDigest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *ProductDigest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+// Image - картинка товара; url и превью отдаются из blob-хранилища (CDN)
+type Image struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"` // оригинал
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"` // байт
+	Width         int32                  `protobuf:"varint,6,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Alt           string                 `protobuf:"bytes,8,opt,name=alt,proto3" json:"alt,omitempty"`
+	Primary       bool                   `protobuf:"varint,9,opt,name=primary,proto3" json:"primary,omitempty"`
+	Position      int32                  `protobuf:"varint,10,opt,name=position,proto3" json:"position,omitempty"` // порядок в галерее, с 0
+	Thumbnails    []*Thumbnail           `protobuf:"bytes,11,rep,name=thumbnails,proto3" json:"thumbnails,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Image) Reset() {
+	*x = Image{}
+	mi := &file_catalog_common_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Image) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Image) ProtoMessage() {}
+
+func (x *Image) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_common_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Image.ProtoReflect.Descriptor instead.
+func (*Image) Descriptor() ([]byte, []int) {
+	return file_catalog_common_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Image) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Image) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *Image) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Image) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Image) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Image) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Image) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Image) GetAlt() string {
+	if x != nil {
+		return x.Alt
+	}
+	return ""
+}
+
+func (x *Image) GetPrimary() bool {
+	if x != nil {
+		return x.Primary
+	}
+	return false
+}
+
+func (x *Image) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Image) GetThumbnails() []*Thumbnail {
+	if x != nil {
+		return x.Thumbnails
+	}
+	return nil
+}
+
+type Thumbnail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // small, medium, large
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Width         int32                  `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Thumbnail) Reset() {
+	*x = Thumbnail{}
+	mi := &file_catalog_common_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Thumbnail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Thumbnail) ProtoMessage() {}
+
+func (x *Thumbnail) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_common_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Thumbnail.ProtoReflect.Descriptor instead.
+func (*Thumbnail) Descriptor() ([]byte, []int) {
+	return file_catalog_common_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Thumbnail) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Thumbnail) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Thumbnail) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Thumbnail) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Thumbnail) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// Money - сумма в минорных единицах (копейках, центах) и код валюты ISO 4217
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_catalog_common_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_common_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_catalog_common_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ProductList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*ProductDigest       `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductList) Reset() {
+	*x = ProductList{}
+	mi := &file_catalog_common_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductList) ProtoMessage() {}
+
+func (x *ProductList) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_common_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductList.ProtoReflect.Descriptor instead.
+func (*ProductList) Descriptor() ([]byte, []int) {
+	return file_catalog_common_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProductList) GetProducts() []*ProductDigest {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+var File_catalog_common_proto protoreflect.FileDescriptor
+
+const file_catalog_common_proto_rawDesc = "" +
+	"\n" +
+	"\x14catalog/common.proto\x12\acatalog\"\xb2\x02\n" +
+	"\rProductDigest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x03R\x05price\x12\x1c\n" +
+	"\tavailable\x18\x04 \x01(\bR\tavailable\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12'\n" +
+	"\x0feffective_price\x18\x06 \x01(\x03R\x0eeffectivePrice\x12#\n" +
+	"\rpromotion_ids\x18\a \x03(\tR\fpromotionIds\x123\n" +
+	"\rprimary_image\x18\b \x01(\v2\x0e.catalog.ImageR\fprimaryImage\x12\x16\n" +
+	"\x06locale\x18\t \x01(\tR\x06locale\x12\x12\n" +
+	"\x04slug\x18\n" +
+	" \x01(\tR\x04slug\"\xa9\x02\n" +
+	"\x05Image\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x12\x14\n" +
+	"\x05width\x18\x06 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\a \x01(\x05R\x06height\x12\x10\n" +
+	"\x03alt\x18\b \x01(\tR\x03alt\x12\x18\n" +
+	"\aprimary\x18\t \x01(\bR\aprimary\x12\x1a\n" +
+	"\bposition\x18\n" +
+	" \x01(\x05R\bposition\x122\n" +
+	"\n" +
+	"thumbnails\x18\v \x03(\v2\x12.catalog.ThumbnailR\n" +
+	"thumbnails\"\x82\x01\n" +
+	"\tThumbnail\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x14\n" +
+	"\x05width\x18\x04 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x05 \x01(\x05R\x06height\";\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"A\n" +
+	"\vProductList\x122\n" +
+	"\bproducts\x18\x01 \x03(\v2\x16.catalog.ProductDigestR\bproductsB6Z4github.com/glekoz/online-shop_product/pkg/pb/catalogb\x06proto3"
+
+var (
+	file_catalog_common_proto_rawDescOnce sync.Once
+	file_catalog_common_proto_rawDescData []byte
+)
+
+func file_catalog_common_proto_rawDescGZIP() []byte {
+	file_catalog_common_proto_rawDescOnce.Do(func() {
+		file_catalog_common_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_catalog_common_proto_rawDesc), len(file_catalog_common_proto_rawDesc)))
+	})
+	return file_catalog_common_proto_rawDescData
+}
+
+var file_catalog_common_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_catalog_common_proto_goTypes = []any{
+	(*ProductDigest)(nil), // 0: catalog.ProductDigest
+	(*Image)(nil),         // 1: catalog.Image
+	(*Thumbnail)(nil),     // 2: catalog.Thumbnail
+	(*Money)(nil),         // 3: catalog.Money
+	(*ProductList)(nil),   // 4: catalog.ProductList
+}
+var file_catalog_common_proto_depIdxs = []int32{
+	1, // 0: catalog.ProductDigest.primary_image:type_name -> catalog.Image
+	2, // 1: catalog.Image.thumbnails:type_name -> catalog.Thumbnail
+	0, // 2: catalog.ProductList.products:type_name -> catalog.ProductDigest
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_catalog_common_proto_init() }
+func file_catalog_common_proto_init() {
+	if File_catalog_common_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_common_proto_rawDesc), len(file_catalog_common_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_catalog_common_proto_goTypes,
+		DependencyIndexes: file_catalog_common_proto_depIdxs,
+		MessageInfos:      file_catalog_common_proto_msgTypes,
+	}.Build()
+	File_catalog_common_proto = out.File
+	file_catalog_common_proto_goTypes = nil
+	file_catalog_common_proto_depIdxs = nil
+}
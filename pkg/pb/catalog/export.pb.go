@@ -0,0 +1,295 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: catalog/export.proto
+
+package catalog
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	// колонки те же, что у импорта, плюс id, sale_price, available, image_url,
+	// link, updated_at; файл можно поправить и загрузить обратно
+	ExportFormat_EXPORT_FORMAT_CSV    ExportFormat = 1
+	ExportFormat_EXPORT_FORMAT_NDJSON ExportFormat = 2
+	// фид Google Merchant Center: RSS 2.0 с атрибутами g:*
+	ExportFormat_EXPORT_FORMAT_GOOGLE_XML ExportFormat = 3
+	// тот же фид в виде текста с табуляцией
+	ExportFormat_EXPORT_FORMAT_GOOGLE_TSV ExportFormat = 4
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_NDJSON",
+		3: "EXPORT_FORMAT_GOOGLE_XML",
+		4: "EXPORT_FORMAT_GOOGLE_TSV",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_CSV":         1,
+		"EXPORT_FORMAT_NDJSON":      2,
+		"EXPORT_FORMAT_GOOGLE_XML":  3,
+		"EXPORT_FORMAT_GOOGLE_TSV":  4,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_catalog_export_proto_enumTypes[0].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_catalog_export_proto_enumTypes[0]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_catalog_export_proto_rawDescGZIP(), []int{0}
+}
+
+type ExportRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Format ExportFormat           `protobuf:"varint,1,opt,name=format,proto3,enum=catalog.ExportFormat" json:"format,omitempty"`
+	// колонки CSV и ключи NDJSON в нужном порядке, пусто - все;
+	// для фидов Google не задаются
+	Columns []string `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	// фильтры, пустые не ограничивают; category_id включает подкатегории
+	CategoryId    string                 `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	InStockOnly   bool                   `protobuf:"varint,5,opt,name=in_stock_only,json=inStockOnly,proto3" json:"in_stock_only,omitempty"`
+	UpdatedSince  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_since,json=updatedSince,proto3" json:"updated_since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRequest) Reset() {
+	*x = ExportRequest{}
+	mi := &file_catalog_export_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRequest) ProtoMessage() {}
+
+func (x *ExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_export_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRequest.ProtoReflect.Descriptor instead.
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_export_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExportRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ExportRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *ExportRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ExportRequest) GetInStockOnly() bool {
+	if x != nil {
+		return x.InStockOnly
+	}
+	return false
+}
+
+func (x *ExportRequest) GetUpdatedSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedSince
+	}
+	return nil
+}
+
+type ExportChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportChunk) Reset() {
+	*x = ExportChunk{}
+	mi := &file_catalog_export_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportChunk) ProtoMessage() {}
+
+func (x *ExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_export_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportChunk.ProtoReflect.Descriptor instead.
+func (*ExportChunk) Descriptor() ([]byte, []int) {
+	return file_catalog_export_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_catalog_export_proto protoreflect.FileDescriptor
+
+const file_catalog_export_proto_rawDesc = "" +
+	"\n" +
+	"\x14catalog/export.proto\x12\acatalog\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfa\x01\n" +
+	"\rExportRequest\x12-\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x15.catalog.ExportFormatR\x06format\x12\x18\n" +
+	"\acolumns\x18\x02 \x03(\tR\acolumns\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\tR\n" +
+	"categoryId\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\"\n" +
+	"\rin_stock_only\x18\x05 \x01(\bR\vinStockOnly\x12?\n" +
+	"\rupdated_since\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fupdatedSince\"!\n" +
+	"\vExportChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data*\x9a\x01\n" +
+	"\fExportFormat\x12\x1d\n" +
+	"\x19EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11EXPORT_FORMAT_CSV\x10\x01\x12\x18\n" +
+	"\x14EXPORT_FORMAT_NDJSON\x10\x02\x12\x1c\n" +
+	"\x18EXPORT_FORMAT_GOOGLE_XML\x10\x03\x12\x1c\n" +
+	"\x18EXPORT_FORMAT_GOOGLE_TSV\x10\x042F\n" +
+	"\n" +
+	"GRPCExport\x128\n" +
+	"\x06Export\x12\x16.catalog.ExportRequest\x1a\x14.catalog.ExportChunk0\x01B6Z4github.com/glekoz/online-shop_product/pkg/pb/catalogb\x06proto3"
+
+var (
+	file_catalog_export_proto_rawDescOnce sync.Once
+	file_catalog_export_proto_rawDescData []byte
+)
+
+func file_catalog_export_proto_rawDescGZIP() []byte {
+	file_catalog_export_proto_rawDescOnce.Do(func() {
+		file_catalog_export_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_catalog_export_proto_rawDesc), len(file_catalog_export_proto_rawDesc)))
+	})
+	return file_catalog_export_proto_rawDescData
+}
+
+var file_catalog_export_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_catalog_export_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_catalog_export_proto_goTypes = []any{
+	(ExportFormat)(0),             // 0: catalog.ExportFormat
+	(*ExportRequest)(nil),         // 1: catalog.ExportRequest
+	(*ExportChunk)(nil),           // 2: catalog.ExportChunk
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_catalog_export_proto_depIdxs = []int32{
+	0, // 0: catalog.ExportRequest.format:type_name -> catalog.ExportFormat
+	3, // 1: catalog.ExportRequest.updated_since:type_name -> google.protobuf.Timestamp
+	1, // 2: catalog.GRPCExport.Export:input_type -> catalog.ExportRequest
+	2, // 3: catalog.GRPCExport.Export:output_type -> catalog.ExportChunk
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_catalog_export_proto_init() }
+func file_catalog_export_proto_init() {
+	if File_catalog_export_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_export_proto_rawDesc), len(file_catalog_export_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_catalog_export_proto_goTypes,
+		DependencyIndexes: file_catalog_export_proto_depIdxs,
+		EnumInfos:         file_catalog_export_proto_enumTypes,
+		MessageInfos:      file_catalog_export_proto_msgTypes,
+	}.Build()
+	File_catalog_export_proto = out.File
+	file_catalog_export_proto_goTypes = nil
+	file_catalog_export_proto_depIdxs = nil
+}
@@ -0,0 +1,132 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: catalog/export.proto
+
+package catalog
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GRPCExport_Export_FullMethodName = "/catalog.GRPCExport/Export"
+)
+
+// GRPCExportClient is the client API for GRPCExport service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Выгрузка каталога в файл. Export - серверный поток: сообщения - куски файла
+// по порядку, их достаточно склеить. Товары читаются из базы курсором
+// в одном снимке, поэтому выгрузка согласована, даже если каталог меняется.
+type GRPCExportClient interface {
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
+}
+
+type gRPCExportClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGRPCExportClient(cc grpc.ClientConnInterface) GRPCExportClient {
+	return &gRPCExportClient{cc}
+}
+
+func (c *gRPCExportClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GRPCExport_ServiceDesc.Streams[0], GRPCExport_Export_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GRPCExport_ExportClient = grpc.ServerStreamingClient[ExportChunk]
+
+// GRPCExportServer is the server API for GRPCExport service.
+// All implementations must embed UnimplementedGRPCExportServer
+// for forward compatibility.
+//
+// Выгрузка каталога в файл. Export - серверный поток: сообщения - куски файла
+// по порядку, их достаточно склеить. Товары читаются из базы курсором
+// в одном снимке, поэтому выгрузка согласована, даже если каталог меняется.
+type GRPCExportServer interface {
+	Export(*ExportRequest, grpc.ServerStreamingServer[ExportChunk]) error
+	mustEmbedUnimplementedGRPCExportServer()
+}
+
+// UnimplementedGRPCExportServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGRPCExportServer struct{}
+
+func (UnimplementedGRPCExportServer) Export(*ExportRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedGRPCExportServer) mustEmbedUnimplementedGRPCExportServer() {}
+func (UnimplementedGRPCExportServer) testEmbeddedByValue()                    {}
+
+// UnsafeGRPCExportServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GRPCExportServer will
+// result in compilation errors.
+type UnsafeGRPCExportServer interface {
+	mustEmbedUnimplementedGRPCExportServer()
+}
+
+func RegisterGRPCExportServer(s grpc.ServiceRegistrar, srv GRPCExportServer) {
+	// If the following call pancis, it indicates UnimplementedGRPCExportServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GRPCExport_ServiceDesc, srv)
+}
+
+func _GRPCExport_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GRPCExportServer).Export(m, &grpc.GenericServerStream[ExportRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GRPCExport_ExportServer = grpc.ServerStreamingServer[ExportChunk]
+
+// GRPCExport_ServiceDesc is the grpc.ServiceDesc for GRPCExport service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GRPCExport_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "catalog.GRPCExport",
+	HandlerType: (*GRPCExportServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _GRPCExport_Export_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "catalog/export.proto",
+}
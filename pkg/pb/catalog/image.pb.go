@@ -0,0 +1,409 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: catalog/image.proto
+
+package catalog
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ImageUploadInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // необязательно; если задан, должен совпасть с содержимым
+	Alt           string                 `protobuf:"bytes,3,opt,name=alt,proto3" json:"alt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageUploadInfo) Reset() {
+	*x = ImageUploadInfo{}
+	mi := &file_catalog_image_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageUploadInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageUploadInfo) ProtoMessage() {}
+
+func (x *ImageUploadInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_image_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageUploadInfo.ProtoReflect.Descriptor instead.
+func (*ImageUploadInfo) Descriptor() ([]byte, []int) {
+	return file_catalog_image_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ImageUploadInfo) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ImageUploadInfo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ImageUploadInfo) GetAlt() string {
+	if x != nil {
+		return x.Alt
+	}
+	return ""
+}
+
+type ImageUploadChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*ImageUploadChunk_Info
+	//	*ImageUploadChunk_Chunk
+	Data          isImageUploadChunk_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageUploadChunk) Reset() {
+	*x = ImageUploadChunk{}
+	mi := &file_catalog_image_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageUploadChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageUploadChunk) ProtoMessage() {}
+
+func (x *ImageUploadChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_image_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageUploadChunk.ProtoReflect.Descriptor instead.
+func (*ImageUploadChunk) Descriptor() ([]byte, []int) {
+	return file_catalog_image_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ImageUploadChunk) GetData() isImageUploadChunk_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImageUploadChunk) GetInfo() *ImageUploadInfo {
+	if x != nil {
+		if x, ok := x.Data.(*ImageUploadChunk_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *ImageUploadChunk) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*ImageUploadChunk_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImageUploadChunk_Data interface {
+	isImageUploadChunk_Data()
+}
+
+type ImageUploadChunk_Info struct {
+	Info *ImageUploadInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type ImageUploadChunk_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ImageUploadChunk_Info) isImageUploadChunk_Data() {}
+
+func (*ImageUploadChunk_Chunk) isImageUploadChunk_Data() {}
+
+type ImageID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageID) Reset() {
+	*x = ImageID{}
+	mi := &file_catalog_image_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageID) ProtoMessage() {}
+
+func (x *ImageID) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_image_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageID.ProtoReflect.Descriptor instead.
+func (*ImageID) Descriptor() ([]byte, []int) {
+	return file_catalog_image_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ImageID) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ImageList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Images        []*Image               `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageList) Reset() {
+	*x = ImageList{}
+	mi := &file_catalog_image_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageList) ProtoMessage() {}
+
+func (x *ImageList) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_image_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageList.ProtoReflect.Descriptor instead.
+func (*ImageList) Descriptor() ([]byte, []int) {
+	return file_catalog_image_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ImageList) GetImages() []*Image {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+type ImageOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ImageIds      []string               `protobuf:"bytes,2,rep,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageOrder) Reset() {
+	*x = ImageOrder{}
+	mi := &file_catalog_image_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageOrder) ProtoMessage() {}
+
+func (x *ImageOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_image_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageOrder.ProtoReflect.Descriptor instead.
+func (*ImageOrder) Descriptor() ([]byte, []int) {
+	return file_catalog_image_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ImageOrder) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ImageOrder) GetImageIds() []string {
+	if x != nil {
+		return x.ImageIds
+	}
+	return nil
+}
+
+var File_catalog_image_proto protoreflect.FileDescriptor
+
+const file_catalog_image_proto_rawDesc = "" +
+	"\n" +
+	"\x13catalog/image.proto\x12\acatalog\x1a\x1bgoogle/protobuf/empty.proto\x1a\x16catalog/category.proto\x1a\x14catalog/common.proto\"e\n" +
+	"\x0fImageUploadInfo\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x10\n" +
+	"\x03alt\x18\x03 \x01(\tR\x03alt\"b\n" +
+	"\x10ImageUploadChunk\x12.\n" +
+	"\x04info\x18\x01 \x01(\v2\x18.catalog.ImageUploadInfoH\x00R\x04info\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"\x19\n" +
+	"\aImageID\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"3\n" +
+	"\tImageList\x12&\n" +
+	"\x06images\x18\x01 \x03(\v2\x0e.catalog.ImageR\x06images\"H\n" +
+	"\n" +
+	"ImageOrder\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1b\n" +
+	"\timage_ids\x18\x02 \x03(\tR\bimageIds2\x97\x02\n" +
+	"\tGRPCImage\x125\n" +
+	"\x06Upload\x12\x19.catalog.ImageUploadChunk\x1a\x0e.catalog.Image(\x01\x12/\n" +
+	"\x04List\x12\x13.catalog.ProductRef\x1a\x12.catalog.ImageList\x122\n" +
+	"\x06Delete\x12\x10.catalog.ImageID\x1a\x16.google.protobuf.Empty\x126\n" +
+	"\n" +
+	"SetPrimary\x12\x10.catalog.ImageID\x1a\x16.google.protobuf.Empty\x126\n" +
+	"\aReorder\x12\x13.catalog.ImageOrder\x1a\x16.google.protobuf.EmptyB6Z4github.com/glekoz/online-shop_product/pkg/pb/catalogb\x06proto3"
+
+var (
+	file_catalog_image_proto_rawDescOnce sync.Once
+	file_catalog_image_proto_rawDescData []byte
+)
+
+func file_catalog_image_proto_rawDescGZIP() []byte {
+	file_catalog_image_proto_rawDescOnce.Do(func() {
+		file_catalog_image_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_catalog_image_proto_rawDesc), len(file_catalog_image_proto_rawDesc)))
+	})
+	return file_catalog_image_proto_rawDescData
+}
+
+var file_catalog_image_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_catalog_image_proto_goTypes = []any{
+	(*ImageUploadInfo)(nil),  // 0: catalog.ImageUploadInfo
+	(*ImageUploadChunk)(nil), // 1: catalog.ImageUploadChunk
+	(*ImageID)(nil),          // 2: catalog.ImageID
+	(*ImageList)(nil),        // 3: catalog.ImageList
+	(*ImageOrder)(nil),       // 4: catalog.ImageOrder
+	(*Image)(nil),            // 5: catalog.Image
+	(*ProductRef)(nil),       // 6: catalog.ProductRef
+	(*emptypb.Empty)(nil),    // 7: google.protobuf.Empty
+}
+var file_catalog_image_proto_depIdxs = []int32{
+	0, // 0: catalog.ImageUploadChunk.info:type_name -> catalog.ImageUploadInfo
+	5, // 1: catalog.ImageList.images:type_name -> catalog.Image
+	1, // 2: catalog.GRPCImage.Upload:input_type -> catalog.ImageUploadChunk
+	6, // 3: catalog.GRPCImage.List:input_type -> catalog.ProductRef
+	2, // 4: catalog.GRPCImage.Delete:input_type -> catalog.ImageID
+	2, // 5: catalog.GRPCImage.SetPrimary:input_type -> catalog.ImageID
+	4, // 6: catalog.GRPCImage.Reorder:input_type -> catalog.ImageOrder
+	5, // 7: catalog.GRPCImage.Upload:output_type -> catalog.Image
+	3, // 8: catalog.GRPCImage.List:output_type -> catalog.ImageList
+	7, // 9: catalog.GRPCImage.Delete:output_type -> google.protobuf.Empty
+	7, // 10: catalog.GRPCImage.SetPrimary:output_type -> google.protobuf.Empty
+	7, // 11: catalog.GRPCImage.Reorder:output_type -> google.protobuf.Empty
+	7, // [7:12] is the sub-list for method output_type
+	2, // [2:7] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_catalog_image_proto_init() }
+func file_catalog_image_proto_init() {
+	if File_catalog_image_proto != nil {
+		return
+	}
+	file_catalog_category_proto_init()
+	file_catalog_common_proto_init()
+	file_catalog_image_proto_msgTypes[1].OneofWrappers = []any{
+		(*ImageUploadChunk_Info)(nil),
+		(*ImageUploadChunk_Chunk)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_image_proto_rawDesc), len(file_catalog_image_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_catalog_image_proto_goTypes,
+		DependencyIndexes: file_catalog_image_proto_depIdxs,
+		MessageInfos:      file_catalog_image_proto_msgTypes,
+	}.Build()
+	File_catalog_image_proto = out.File
+	file_catalog_image_proto_goTypes = nil
+	file_catalog_image_proto_depIdxs = nil
+}
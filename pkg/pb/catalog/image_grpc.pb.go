@@ -0,0 +1,278 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: catalog/image.proto
+
+package catalog
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GRPCImage_Upload_FullMethodName     = "/catalog.GRPCImage/Upload"
+	GRPCImage_List_FullMethodName       = "/catalog.GRPCImage/List"
+	GRPCImage_Delete_FullMethodName     = "/catalog.GRPCImage/Delete"
+	GRPCImage_SetPrimary_FullMethodName = "/catalog.GRPCImage/SetPrimary"
+	GRPCImage_Reorder_FullMethodName    = "/catalog.GRPCImage/Reorder"
+)
+
+// GRPCImageClient is the client API for GRPCImage service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Картинки товаров. Upload - клиентский поток: первое сообщение содержит info,
+// следующие - куски файла (до 64 КБ удобнее всего). Тип определяется по содержимому
+// (jpeg, png, gif), превью строятся на сервере. Первая картинка товара становится главной.
+type GRPCImageClient interface {
+	Upload(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImageUploadChunk, Image], error)
+	List(ctx context.Context, in *ProductRef, opts ...grpc.CallOption) (*ImageList, error)
+	Delete(ctx context.Context, in *ImageID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetPrimary(ctx context.Context, in *ImageID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Reorder - новый порядок; должны быть перечислены все картинки товара
+	Reorder(ctx context.Context, in *ImageOrder, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type gRPCImageClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGRPCImageClient(cc grpc.ClientConnInterface) GRPCImageClient {
+	return &gRPCImageClient{cc}
+}
+
+func (c *gRPCImageClient) Upload(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImageUploadChunk, Image], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GRPCImage_ServiceDesc.Streams[0], GRPCImage_Upload_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImageUploadChunk, Image]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GRPCImage_UploadClient = grpc.ClientStreamingClient[ImageUploadChunk, Image]
+
+func (c *gRPCImageClient) List(ctx context.Context, in *ProductRef, opts ...grpc.CallOption) (*ImageList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImageList)
+	err := c.cc.Invoke(ctx, GRPCImage_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCImageClient) Delete(ctx context.Context, in *ImageID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCImage_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCImageClient) SetPrimary(ctx context.Context, in *ImageID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCImage_SetPrimary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCImageClient) Reorder(ctx context.Context, in *ImageOrder, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCImage_Reorder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GRPCImageServer is the server API for GRPCImage service.
+// All implementations must embed UnimplementedGRPCImageServer
+// for forward compatibility.
+//
+// Картинки товаров. Upload - клиентский поток: первое сообщение содержит info,
+// следующие - куски файла (до 64 КБ удобнее всего). Тип определяется по содержимому
+// (jpeg, png, gif), превью строятся на сервере. Первая картинка товара становится главной.
+type GRPCImageServer interface {
+	Upload(grpc.ClientStreamingServer[ImageUploadChunk, Image]) error
+	List(context.Context, *ProductRef) (*ImageList, error)
+	Delete(context.Context, *ImageID) (*emptypb.Empty, error)
+	SetPrimary(context.Context, *ImageID) (*emptypb.Empty, error)
+	// Reorder - новый порядок; должны быть перечислены все картинки товара
+	Reorder(context.Context, *ImageOrder) (*emptypb.Empty, error)
+	mustEmbedUnimplementedGRPCImageServer()
+}
+
+// UnimplementedGRPCImageServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGRPCImageServer struct{}
+
+func (UnimplementedGRPCImageServer) Upload(grpc.ClientStreamingServer[ImageUploadChunk, Image]) error {
+	return status.Errorf(codes.Unimplemented, "method Upload not implemented")
+}
+func (UnimplementedGRPCImageServer) List(context.Context, *ProductRef) (*ImageList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedGRPCImageServer) Delete(context.Context, *ImageID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedGRPCImageServer) SetPrimary(context.Context, *ImageID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrimary not implemented")
+}
+func (UnimplementedGRPCImageServer) Reorder(context.Context, *ImageOrder) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reorder not implemented")
+}
+func (UnimplementedGRPCImageServer) mustEmbedUnimplementedGRPCImageServer() {}
+func (UnimplementedGRPCImageServer) testEmbeddedByValue()                   {}
+
+// UnsafeGRPCImageServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GRPCImageServer will
+// result in compilation errors.
+type UnsafeGRPCImageServer interface {
+	mustEmbedUnimplementedGRPCImageServer()
+}
+
+func RegisterGRPCImageServer(s grpc.ServiceRegistrar, srv GRPCImageServer) {
+	// If the following call pancis, it indicates UnimplementedGRPCImageServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GRPCImage_ServiceDesc, srv)
+}
+
+func _GRPCImage_Upload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GRPCImageServer).Upload(&grpc.GenericServerStream[ImageUploadChunk, Image]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GRPCImage_UploadServer = grpc.ClientStreamingServer[ImageUploadChunk, Image]
+
+func _GRPCImage_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCImageServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCImage_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCImageServer).List(ctx, req.(*ProductRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCImage_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCImageServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCImage_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCImageServer).Delete(ctx, req.(*ImageID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCImage_SetPrimary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCImageServer).SetPrimary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCImage_SetPrimary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCImageServer).SetPrimary(ctx, req.(*ImageID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCImage_Reorder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCImageServer).Reorder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCImage_Reorder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCImageServer).Reorder(ctx, req.(*ImageOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GRPCImage_ServiceDesc is the grpc.ServiceDesc for GRPCImage service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GRPCImage_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "catalog.GRPCImage",
+	HandlerType: (*GRPCImageServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _GRPCImage_List_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _GRPCImage_Delete_Handler,
+		},
+		{
+			MethodName: "SetPrimary",
+			Handler:    _GRPCImage_SetPrimary_Handler,
+		},
+		{
+			MethodName: "Reorder",
+			Handler:    _GRPCImage_Reorder_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Upload",
+			Handler:       _GRPCImage_Upload_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "catalog/image.proto",
+}
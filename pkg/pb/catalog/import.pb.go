@@ -0,0 +1,537 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: catalog/import.proto
+
+package catalog
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ImportFormat int32
+
+const (
+	ImportFormat_IMPORT_FORMAT_UNSPECIFIED ImportFormat = 0
+	// заголовок обязателен: sku, name, description, price, currency в любом порядке;
+	// price в мажорных единицах ("120.50"), currency по умолчанию RUB
+	ImportFormat_IMPORT_FORMAT_CSV ImportFormat = 1
+	// по объекту на строку с теми же ключами
+	ImportFormat_IMPORT_FORMAT_NDJSON ImportFormat = 2
+)
+
+// Enum value maps for ImportFormat.
+var (
+	ImportFormat_name = map[int32]string{
+		0: "IMPORT_FORMAT_UNSPECIFIED",
+		1: "IMPORT_FORMAT_CSV",
+		2: "IMPORT_FORMAT_NDJSON",
+	}
+	ImportFormat_value = map[string]int32{
+		"IMPORT_FORMAT_UNSPECIFIED": 0,
+		"IMPORT_FORMAT_CSV":         1,
+		"IMPORT_FORMAT_NDJSON":      2,
+	}
+)
+
+func (x ImportFormat) Enum() *ImportFormat {
+	p := new(ImportFormat)
+	*p = x
+	return p
+}
+
+func (x ImportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_catalog_import_proto_enumTypes[0].Descriptor()
+}
+
+func (ImportFormat) Type() protoreflect.EnumType {
+	return &file_catalog_import_proto_enumTypes[0]
+}
+
+func (x ImportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportFormat.Descriptor instead.
+func (ImportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_catalog_import_proto_rawDescGZIP(), []int{0}
+}
+
+type ImportStatus int32
+
+const (
+	ImportStatus_IMPORT_STATUS_UNSPECIFIED ImportStatus = 0
+	ImportStatus_IMPORT_STATUS_CREATED     ImportStatus = 1
+	ImportStatus_IMPORT_STATUS_UPDATED     ImportStatus = 2
+	ImportStatus_IMPORT_STATUS_UNCHANGED   ImportStatus = 3
+	ImportStatus_IMPORT_STATUS_FAILED      ImportStatus = 4
+)
+
+// Enum value maps for ImportStatus.
+var (
+	ImportStatus_name = map[int32]string{
+		0: "IMPORT_STATUS_UNSPECIFIED",
+		1: "IMPORT_STATUS_CREATED",
+		2: "IMPORT_STATUS_UPDATED",
+		3: "IMPORT_STATUS_UNCHANGED",
+		4: "IMPORT_STATUS_FAILED",
+	}
+	ImportStatus_value = map[string]int32{
+		"IMPORT_STATUS_UNSPECIFIED": 0,
+		"IMPORT_STATUS_CREATED":     1,
+		"IMPORT_STATUS_UPDATED":     2,
+		"IMPORT_STATUS_UNCHANGED":   3,
+		"IMPORT_STATUS_FAILED":      4,
+	}
+)
+
+func (x ImportStatus) Enum() *ImportStatus {
+	p := new(ImportStatus)
+	*p = x
+	return p
+}
+
+func (x ImportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_catalog_import_proto_enumTypes[1].Descriptor()
+}
+
+func (ImportStatus) Type() protoreflect.EnumType {
+	return &file_catalog_import_proto_enumTypes[1]
+}
+
+func (x ImportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportStatus.Descriptor instead.
+func (ImportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_catalog_import_proto_rawDescGZIP(), []int{1}
+}
+
+type ImportOptions struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Format ImportFormat           `protobuf:"varint,1,opt,name=format,proto3,enum=catalog.ImportFormat" json:"format,omitempty"`
+	// проверить и посчитать, но ничего не записывать
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOptions) Reset() {
+	*x = ImportOptions{}
+	mi := &file_catalog_import_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOptions) ProtoMessage() {}
+
+func (x *ImportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_import_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOptions.ProtoReflect.Descriptor instead.
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return file_catalog_import_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ImportOptions) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_IMPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*ImportChunk_Options
+	//	*ImportChunk_Chunk
+	Data          isImportChunk_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportChunk) Reset() {
+	*x = ImportChunk{}
+	mi := &file_catalog_import_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportChunk) ProtoMessage() {}
+
+func (x *ImportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_import_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportChunk.ProtoReflect.Descriptor instead.
+func (*ImportChunk) Descriptor() ([]byte, []int) {
+	return file_catalog_import_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ImportChunk) GetData() isImportChunk_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImportChunk) GetOptions() *ImportOptions {
+	if x != nil {
+		if x, ok := x.Data.(*ImportChunk_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+func (x *ImportChunk) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*ImportChunk_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImportChunk_Data interface {
+	isImportChunk_Data()
+}
+
+type ImportChunk_Options struct {
+	Options *ImportOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type ImportChunk_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ImportChunk_Options) isImportChunk_Data() {}
+
+func (*ImportChunk_Chunk) isImportChunk_Data() {}
+
+type ImportRowResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"` // номер строки в файле
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Id            string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"` // пусто у ошибок и у создаваемых при dry_run
+	Status        ImportStatus           `protobuf:"varint,5,opt,name=status,proto3,enum=catalog.ImportStatus" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	mi := &file_catalog_import_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowResult) ProtoMessage() {}
+
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_import_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_catalog_import_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ImportRowResult) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetStatus() ImportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportStatus_IMPORT_STATUS_UNSPECIFIED
+}
+
+func (x *ImportRowResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*ImportRowResult     `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	Created       int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                  `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	Unchanged     int32                  `protobuf:"varint,4,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	Failed        int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	DryRun        bool                   `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportReport) Reset() {
+	*x = ImportReport{}
+	mi := &file_catalog_import_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportReport) ProtoMessage() {}
+
+func (x *ImportReport) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_import_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportReport.ProtoReflect.Descriptor instead.
+func (*ImportReport) Descriptor() ([]byte, []int) {
+	return file_catalog_import_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ImportReport) GetRows() []*ImportRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ImportReport) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportReport) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportReport) GetUnchanged() int32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *ImportReport) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportReport) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+var File_catalog_import_proto protoreflect.FileDescriptor
+
+const file_catalog_import_proto_rawDesc = "" +
+	"\n" +
+	"\x14catalog/import.proto\x12\acatalog\"W\n" +
+	"\rImportOptions\x12-\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x15.catalog.ImportFormatR\x06format\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"a\n" +
+	"\vImportChunk\x122\n" +
+	"\aoptions\x18\x01 \x01(\v2\x16.catalog.ImportOptionsH\x00R\aoptions\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"\xa0\x01\n" +
+	"\x0fImportRowResult\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\tR\x02id\x12-\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x15.catalog.ImportStatusR\x06status\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"\xbf\x01\n" +
+	"\fImportReport\x12,\n" +
+	"\x04rows\x18\x01 \x03(\v2\x18.catalog.ImportRowResultR\x04rows\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x03 \x01(\x05R\aupdated\x12\x1c\n" +
+	"\tunchanged\x18\x04 \x01(\x05R\tunchanged\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun*^\n" +
+	"\fImportFormat\x12\x1d\n" +
+	"\x19IMPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11IMPORT_FORMAT_CSV\x10\x01\x12\x18\n" +
+	"\x14IMPORT_FORMAT_NDJSON\x10\x02*\x9a\x01\n" +
+	"\fImportStatus\x12\x1d\n" +
+	"\x19IMPORT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15IMPORT_STATUS_CREATED\x10\x01\x12\x19\n" +
+	"\x15IMPORT_STATUS_UPDATED\x10\x02\x12\x1b\n" +
+	"\x17IMPORT_STATUS_UNCHANGED\x10\x03\x12\x18\n" +
+	"\x14IMPORT_STATUS_FAILED\x10\x042E\n" +
+	"\n" +
+	"GRPCImport\x127\n" +
+	"\x06Import\x12\x14.catalog.ImportChunk\x1a\x15.catalog.ImportReport(\x01B6Z4github.com/glekoz/online-shop_product/pkg/pb/catalogb\x06proto3"
+
+var (
+	file_catalog_import_proto_rawDescOnce sync.Once
+	file_catalog_import_proto_rawDescData []byte
+)
+
+func file_catalog_import_proto_rawDescGZIP() []byte {
+	file_catalog_import_proto_rawDescOnce.Do(func() {
+		file_catalog_import_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_catalog_import_proto_rawDesc), len(file_catalog_import_proto_rawDesc)))
+	})
+	return file_catalog_import_proto_rawDescData
+}
+
+var file_catalog_import_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_catalog_import_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_catalog_import_proto_goTypes = []any{
+	(ImportFormat)(0),       // 0: catalog.ImportFormat
+	(ImportStatus)(0),       // 1: catalog.ImportStatus
+	(*ImportOptions)(nil),   // 2: catalog.ImportOptions
+	(*ImportChunk)(nil),     // 3: catalog.ImportChunk
+	(*ImportRowResult)(nil), // 4: catalog.ImportRowResult
+	(*ImportReport)(nil),    // 5: catalog.ImportReport
+}
+var file_catalog_import_proto_depIdxs = []int32{
+	0, // 0: catalog.ImportOptions.format:type_name -> catalog.ImportFormat
+	2, // 1: catalog.ImportChunk.options:type_name -> catalog.ImportOptions
+	1, // 2: catalog.ImportRowResult.status:type_name -> catalog.ImportStatus
+	4, // 3: catalog.ImportReport.rows:type_name -> catalog.ImportRowResult
+	3, // 4: catalog.GRPCImport.Import:input_type -> catalog.ImportChunk
+	5, // 5: catalog.GRPCImport.Import:output_type -> catalog.ImportReport
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_catalog_import_proto_init() }
+func file_catalog_import_proto_init() {
+	if File_catalog_import_proto != nil {
+		return
+	}
+	file_catalog_import_proto_msgTypes[1].OneofWrappers = []any{
+		(*ImportChunk_Options)(nil),
+		(*ImportChunk_Chunk)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_import_proto_rawDesc), len(file_catalog_import_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_catalog_import_proto_goTypes,
+		DependencyIndexes: file_catalog_import_proto_depIdxs,
+		EnumInfos:         file_catalog_import_proto_enumTypes,
+		MessageInfos:      file_catalog_import_proto_msgTypes,
+	}.Build()
+	File_catalog_import_proto = out.File
+	file_catalog_import_proto_goTypes = nil
+	file_catalog_import_proto_depIdxs = nil
+}
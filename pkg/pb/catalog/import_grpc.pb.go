@@ -0,0 +1,126 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: catalog/import.proto
+
+package catalog
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GRPCImport_Import_FullMethodName = "/catalog.GRPCImport/Import"
+)
+
+// GRPCImportClient is the client API for GRPCImport service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Массовая загрузка товаров из файла поставщика. Import - клиентский поток:
+// первое сообщение содержит options, следующие - куски файла. Товар ищется
+// по sku, а без него по названию; найденный обновляется, иначе создается.
+// Созданные товары - черновики, статус найденных не меняется.
+// Все изменения пишутся одной транзакцией.
+type GRPCImportClient interface {
+	Import(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportChunk, ImportReport], error)
+}
+
+type gRPCImportClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGRPCImportClient(cc grpc.ClientConnInterface) GRPCImportClient {
+	return &gRPCImportClient{cc}
+}
+
+func (c *gRPCImportClient) Import(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportChunk, ImportReport], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GRPCImport_ServiceDesc.Streams[0], GRPCImport_Import_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportChunk, ImportReport]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GRPCImport_ImportClient = grpc.ClientStreamingClient[ImportChunk, ImportReport]
+
+// GRPCImportServer is the server API for GRPCImport service.
+// All implementations must embed UnimplementedGRPCImportServer
+// for forward compatibility.
+//
+// Массовая загрузка товаров из файла поставщика. Import - клиентский поток:
+// первое сообщение содержит options, следующие - куски файла. Товар ищется
+// по sku, а без него по названию; найденный обновляется, иначе создается.
+// Созданные товары - черновики, статус найденных не меняется.
+// Все изменения пишутся одной транзакцией.
+type GRPCImportServer interface {
+	Import(grpc.ClientStreamingServer[ImportChunk, ImportReport]) error
+	mustEmbedUnimplementedGRPCImportServer()
+}
+
+// UnimplementedGRPCImportServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGRPCImportServer struct{}
+
+func (UnimplementedGRPCImportServer) Import(grpc.ClientStreamingServer[ImportChunk, ImportReport]) error {
+	return status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (UnimplementedGRPCImportServer) mustEmbedUnimplementedGRPCImportServer() {}
+func (UnimplementedGRPCImportServer) testEmbeddedByValue()                    {}
+
+// UnsafeGRPCImportServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GRPCImportServer will
+// result in compilation errors.
+type UnsafeGRPCImportServer interface {
+	mustEmbedUnimplementedGRPCImportServer()
+}
+
+func RegisterGRPCImportServer(s grpc.ServiceRegistrar, srv GRPCImportServer) {
+	// If the following call pancis, it indicates UnimplementedGRPCImportServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GRPCImport_ServiceDesc, srv)
+}
+
+func _GRPCImport_Import_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GRPCImportServer).Import(&grpc.GenericServerStream[ImportChunk, ImportReport]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GRPCImport_ImportServer = grpc.ClientStreamingServer[ImportChunk, ImportReport]
+
+// GRPCImport_ServiceDesc is the grpc.ServiceDesc for GRPCImport service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GRPCImport_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "catalog.GRPCImport",
+	HandlerType: (*GRPCImportServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Import",
+			Handler:       _GRPCImport_Import_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "catalog/import.proto",
+}
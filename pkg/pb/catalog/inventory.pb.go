@@ -0,0 +1,719 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: catalog/inventory.proto
+
+package catalog
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StockAdjustment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Location      string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"` // пустая строка - основной склад
+	Delta         int32                  `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockAdjustment) Reset() {
+	*x = StockAdjustment{}
+	mi := &file_catalog_inventory_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockAdjustment) ProtoMessage() {}
+
+func (x *StockAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_inventory_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockAdjustment.ProtoReflect.Descriptor instead.
+func (*StockAdjustment) Descriptor() ([]byte, []int) {
+	return file_catalog_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StockAdjustment) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *StockAdjustment) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *StockAdjustment) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *StockAdjustment) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type StockLevel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      string                 `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reserved      int32                  `protobuf:"varint,3,opt,name=reserved,proto3" json:"reserved,omitempty"` // входит в quantity
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockLevel) Reset() {
+	*x = StockLevel{}
+	mi := &file_catalog_inventory_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockLevel) ProtoMessage() {}
+
+func (x *StockLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_inventory_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockLevel.ProtoReflect.Descriptor instead.
+func (*StockLevel) Descriptor() ([]byte, []int) {
+	return file_catalog_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StockLevel) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *StockLevel) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockLevel) GetReserved() int32 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+type Stock struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProductId         string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Levels            []*StockLevel          `protobuf:"bytes,2,rep,name=levels,proto3" json:"levels,omitempty"`
+	Total             int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	LowStockThreshold int32                  `protobuf:"varint,4,opt,name=low_stock_threshold,json=lowStockThreshold,proto3" json:"low_stock_threshold,omitempty"`
+	Available         bool                   `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
+	LowStock          bool                   `protobuf:"varint,6,opt,name=low_stock,json=lowStock,proto3" json:"low_stock,omitempty"`
+	Reserved          int32                  `protobuf:"varint,7,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Stock) Reset() {
+	*x = Stock{}
+	mi := &file_catalog_inventory_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stock) ProtoMessage() {}
+
+func (x *Stock) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_inventory_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stock.ProtoReflect.Descriptor instead.
+func (*Stock) Descriptor() ([]byte, []int) {
+	return file_catalog_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Stock) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *Stock) GetLevels() []*StockLevel {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+func (x *Stock) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Stock) GetLowStockThreshold() int32 {
+	if x != nil {
+		return x.LowStockThreshold
+	}
+	return 0
+}
+
+func (x *Stock) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *Stock) GetLowStock() bool {
+	if x != nil {
+		return x.LowStock
+	}
+	return false
+}
+
+func (x *Stock) GetReserved() int32 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+type LowStockThreshold struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Threshold     int32                  `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"` // 0 - не следить за остатком
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LowStockThreshold) Reset() {
+	*x = LowStockThreshold{}
+	mi := &file_catalog_inventory_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LowStockThreshold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LowStockThreshold) ProtoMessage() {}
+
+func (x *LowStockThreshold) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_inventory_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LowStockThreshold.ProtoReflect.Descriptor instead.
+func (*LowStockThreshold) Descriptor() ([]byte, []int) {
+	return file_catalog_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LowStockThreshold) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *LowStockThreshold) GetThreshold() int32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+type ListLowStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLowStockRequest) Reset() {
+	*x = ListLowStockRequest{}
+	mi := &file_catalog_inventory_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLowStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLowStockRequest) ProtoMessage() {}
+
+func (x *ListLowStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_inventory_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLowStockRequest.ProtoReflect.Descriptor instead.
+func (*ListLowStockRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListLowStockRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListLowStockRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type StockList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Stock               `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockList) Reset() {
+	*x = StockList{}
+	mi := &file_catalog_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockList) ProtoMessage() {}
+
+func (x *StockList) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockList.ProtoReflect.Descriptor instead.
+func (*StockList) Descriptor() ([]byte, []int) {
+	return file_catalog_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StockList) GetItems() []*Stock {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type LedgerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerRequest) Reset() {
+	*x = LedgerRequest{}
+	mi := &file_catalog_inventory_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerRequest) ProtoMessage() {}
+
+func (x *LedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_inventory_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerRequest.ProtoReflect.Descriptor instead.
+func (*LedgerRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_inventory_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LedgerRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *LedgerRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *LedgerRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type LedgerEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Location      string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Delta         int32                  `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	QuantityAfter int32                  `protobuf:"varint,4,opt,name=quantity_after,json=quantityAfter,proto3" json:"quantity_after,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Actor         string                 `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	mi := &file_catalog_inventory_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_inventory_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_catalog_inventory_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LedgerEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetQuantityAfter() int32 {
+	if x != nil {
+		return x.QuantityAfter
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type LedgerEntries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*LedgerEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerEntries) Reset() {
+	*x = LedgerEntries{}
+	mi := &file_catalog_inventory_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerEntries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntries) ProtoMessage() {}
+
+func (x *LedgerEntries) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_inventory_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntries.ProtoReflect.Descriptor instead.
+func (*LedgerEntries) Descriptor() ([]byte, []int) {
+	return file_catalog_inventory_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LedgerEntries) GetEntries() []*LedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+var File_catalog_inventory_proto protoreflect.FileDescriptor
+
+const file_catalog_inventory_proto_rawDesc = "" +
+	"\n" +
+	"\x17catalog/inventory.proto\x12\acatalog\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16catalog/category.proto\"z\n" +
+	"\x0fStockAdjustment\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\tR\blocation\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x05R\x05delta\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"`\n" +
+	"\n" +
+	"StockLevel\x12\x1a\n" +
+	"\blocation\x18\x01 \x01(\tR\blocation\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x1a\n" +
+	"\breserved\x18\x03 \x01(\x05R\breserved\"\xf0\x01\n" +
+	"\x05Stock\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12+\n" +
+	"\x06levels\x18\x02 \x03(\v2\x13.catalog.StockLevelR\x06levels\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12.\n" +
+	"\x13low_stock_threshold\x18\x04 \x01(\x05R\x11lowStockThreshold\x12\x1c\n" +
+	"\tavailable\x18\x05 \x01(\bR\tavailable\x12\x1b\n" +
+	"\tlow_stock\x18\x06 \x01(\bR\blowStock\x12\x1a\n" +
+	"\breserved\x18\a \x01(\x05R\breserved\"P\n" +
+	"\x11LowStockThreshold\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1c\n" +
+	"\tthreshold\x18\x02 \x01(\x05R\tthreshold\"C\n" +
+	"\x13ListLowStockRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\"1\n" +
+	"\tStockList\x12$\n" +
+	"\x05items\x18\x01 \x03(\v2\x0e.catalog.StockR\x05items\"\\\n" +
+	"\rLedgerRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"\xdf\x01\n" +
+	"\vLedgerEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\tR\blocation\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x05R\x05delta\x12%\n" +
+	"\x0equantity_after\x18\x04 \x01(\x05R\rquantityAfter\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\x06 \x01(\tR\x05actor\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"?\n" +
+	"\rLedgerEntries\x12.\n" +
+	"\aentries\x18\x01 \x03(\v2\x14.catalog.LedgerEntryR\aentries2\xbc\x02\n" +
+	"\rGRPCInventory\x127\n" +
+	"\x06Adjust\x12\x18.catalog.StockAdjustment\x1a\x13.catalog.StockLevel\x12*\n" +
+	"\x03Get\x12\x13.catalog.ProductRef\x1a\x0e.catalog.Stock\x12J\n" +
+	"\x14SetLowStockThreshold\x12\x1a.catalog.LowStockThreshold\x1a\x16.google.protobuf.Empty\x12@\n" +
+	"\fListLowStock\x12\x1c.catalog.ListLowStockRequest\x1a\x12.catalog.StockList\x128\n" +
+	"\x06Ledger\x12\x16.catalog.LedgerRequest\x1a\x16.catalog.LedgerEntriesB6Z4github.com/glekoz/online-shop_product/pkg/pb/catalogb\x06proto3"
+
+var (
+	file_catalog_inventory_proto_rawDescOnce sync.Once
+	file_catalog_inventory_proto_rawDescData []byte
+)
+
+func file_catalog_inventory_proto_rawDescGZIP() []byte {
+	file_catalog_inventory_proto_rawDescOnce.Do(func() {
+		file_catalog_inventory_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_catalog_inventory_proto_rawDesc), len(file_catalog_inventory_proto_rawDesc)))
+	})
+	return file_catalog_inventory_proto_rawDescData
+}
+
+var file_catalog_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_catalog_inventory_proto_goTypes = []any{
+	(*StockAdjustment)(nil),       // 0: catalog.StockAdjustment
+	(*StockLevel)(nil),            // 1: catalog.StockLevel
+	(*Stock)(nil),                 // 2: catalog.Stock
+	(*LowStockThreshold)(nil),     // 3: catalog.LowStockThreshold
+	(*ListLowStockRequest)(nil),   // 4: catalog.ListLowStockRequest
+	(*StockList)(nil),             // 5: catalog.StockList
+	(*LedgerRequest)(nil),         // 6: catalog.LedgerRequest
+	(*LedgerEntry)(nil),           // 7: catalog.LedgerEntry
+	(*LedgerEntries)(nil),         // 8: catalog.LedgerEntries
+	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+	(*ProductRef)(nil),            // 10: catalog.ProductRef
+	(*emptypb.Empty)(nil),         // 11: google.protobuf.Empty
+}
+var file_catalog_inventory_proto_depIdxs = []int32{
+	1,  // 0: catalog.Stock.levels:type_name -> catalog.StockLevel
+	2,  // 1: catalog.StockList.items:type_name -> catalog.Stock
+	9,  // 2: catalog.LedgerEntry.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 3: catalog.LedgerEntries.entries:type_name -> catalog.LedgerEntry
+	0,  // 4: catalog.GRPCInventory.Adjust:input_type -> catalog.StockAdjustment
+	10, // 5: catalog.GRPCInventory.Get:input_type -> catalog.ProductRef
+	3,  // 6: catalog.GRPCInventory.SetLowStockThreshold:input_type -> catalog.LowStockThreshold
+	4,  // 7: catalog.GRPCInventory.ListLowStock:input_type -> catalog.ListLowStockRequest
+	6,  // 8: catalog.GRPCInventory.Ledger:input_type -> catalog.LedgerRequest
+	1,  // 9: catalog.GRPCInventory.Adjust:output_type -> catalog.StockLevel
+	2,  // 10: catalog.GRPCInventory.Get:output_type -> catalog.Stock
+	11, // 11: catalog.GRPCInventory.SetLowStockThreshold:output_type -> google.protobuf.Empty
+	5,  // 12: catalog.GRPCInventory.ListLowStock:output_type -> catalog.StockList
+	8,  // 13: catalog.GRPCInventory.Ledger:output_type -> catalog.LedgerEntries
+	9,  // [9:14] is the sub-list for method output_type
+	4,  // [4:9] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_catalog_inventory_proto_init() }
+func file_catalog_inventory_proto_init() {
+	if File_catalog_inventory_proto != nil {
+		return
+	}
+	file_catalog_category_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_inventory_proto_rawDesc), len(file_catalog_inventory_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_catalog_inventory_proto_goTypes,
+		DependencyIndexes: file_catalog_inventory_proto_depIdxs,
+		MessageInfos:      file_catalog_inventory_proto_msgTypes,
+	}.Build()
+	File_catalog_inventory_proto = out.File
+	file_catalog_inventory_proto_goTypes = nil
+	file_catalog_inventory_proto_depIdxs = nil
+}
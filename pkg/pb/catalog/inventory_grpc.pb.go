@@ -0,0 +1,282 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: catalog/inventory.proto
+
+package catalog
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GRPCInventory_Adjust_FullMethodName               = "/catalog.GRPCInventory/Adjust"
+	GRPCInventory_Get_FullMethodName                  = "/catalog.GRPCInventory/Get"
+	GRPCInventory_SetLowStockThreshold_FullMethodName = "/catalog.GRPCInventory/SetLowStockThreshold"
+	GRPCInventory_ListLowStock_FullMethodName         = "/catalog.GRPCInventory/ListLowStock"
+	GRPCInventory_Ledger_FullMethodName               = "/catalog.GRPCInventory/Ledger"
+)
+
+// GRPCInventoryClient is the client API for GRPCInventory service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Остатки хранятся по складам; каждое изменение пишется в журнал движений.
+type GRPCInventoryClient interface {
+	// Adjust атомарно увеличивает или уменьшает остаток; списание больше
+	// остатка отклоняется с FAILED_PRECONDITION
+	Adjust(ctx context.Context, in *StockAdjustment, opts ...grpc.CallOption) (*StockLevel, error)
+	Get(ctx context.Context, in *ProductRef, opts ...grpc.CallOption) (*Stock, error)
+	SetLowStockThreshold(ctx context.Context, in *LowStockThreshold, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListLowStock(ctx context.Context, in *ListLowStockRequest, opts ...grpc.CallOption) (*StockList, error)
+	Ledger(ctx context.Context, in *LedgerRequest, opts ...grpc.CallOption) (*LedgerEntries, error)
+}
+
+type gRPCInventoryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGRPCInventoryClient(cc grpc.ClientConnInterface) GRPCInventoryClient {
+	return &gRPCInventoryClient{cc}
+}
+
+func (c *gRPCInventoryClient) Adjust(ctx context.Context, in *StockAdjustment, opts ...grpc.CallOption) (*StockLevel, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockLevel)
+	err := c.cc.Invoke(ctx, GRPCInventory_Adjust_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCInventoryClient) Get(ctx context.Context, in *ProductRef, opts ...grpc.CallOption) (*Stock, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Stock)
+	err := c.cc.Invoke(ctx, GRPCInventory_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCInventoryClient) SetLowStockThreshold(ctx context.Context, in *LowStockThreshold, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCInventory_SetLowStockThreshold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCInventoryClient) ListLowStock(ctx context.Context, in *ListLowStockRequest, opts ...grpc.CallOption) (*StockList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockList)
+	err := c.cc.Invoke(ctx, GRPCInventory_ListLowStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCInventoryClient) Ledger(ctx context.Context, in *LedgerRequest, opts ...grpc.CallOption) (*LedgerEntries, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LedgerEntries)
+	err := c.cc.Invoke(ctx, GRPCInventory_Ledger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GRPCInventoryServer is the server API for GRPCInventory service.
+// All implementations must embed UnimplementedGRPCInventoryServer
+// for forward compatibility.
+//
+// Остатки хранятся по складам; каждое изменение пишется в журнал движений.
+type GRPCInventoryServer interface {
+	// Adjust атомарно увеличивает или уменьшает остаток; списание больше
+	// остатка отклоняется с FAILED_PRECONDITION
+	Adjust(context.Context, *StockAdjustment) (*StockLevel, error)
+	Get(context.Context, *ProductRef) (*Stock, error)
+	SetLowStockThreshold(context.Context, *LowStockThreshold) (*emptypb.Empty, error)
+	ListLowStock(context.Context, *ListLowStockRequest) (*StockList, error)
+	Ledger(context.Context, *LedgerRequest) (*LedgerEntries, error)
+	mustEmbedUnimplementedGRPCInventoryServer()
+}
+
+// UnimplementedGRPCInventoryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGRPCInventoryServer struct{}
+
+func (UnimplementedGRPCInventoryServer) Adjust(context.Context, *StockAdjustment) (*StockLevel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Adjust not implemented")
+}
+func (UnimplementedGRPCInventoryServer) Get(context.Context, *ProductRef) (*Stock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedGRPCInventoryServer) SetLowStockThreshold(context.Context, *LowStockThreshold) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLowStockThreshold not implemented")
+}
+func (UnimplementedGRPCInventoryServer) ListLowStock(context.Context, *ListLowStockRequest) (*StockList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLowStock not implemented")
+}
+func (UnimplementedGRPCInventoryServer) Ledger(context.Context, *LedgerRequest) (*LedgerEntries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ledger not implemented")
+}
+func (UnimplementedGRPCInventoryServer) mustEmbedUnimplementedGRPCInventoryServer() {}
+func (UnimplementedGRPCInventoryServer) testEmbeddedByValue()                       {}
+
+// UnsafeGRPCInventoryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GRPCInventoryServer will
+// result in compilation errors.
+type UnsafeGRPCInventoryServer interface {
+	mustEmbedUnimplementedGRPCInventoryServer()
+}
+
+func RegisterGRPCInventoryServer(s grpc.ServiceRegistrar, srv GRPCInventoryServer) {
+	// If the following call pancis, it indicates UnimplementedGRPCInventoryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GRPCInventory_ServiceDesc, srv)
+}
+
+func _GRPCInventory_Adjust_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockAdjustment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCInventoryServer).Adjust(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCInventory_Adjust_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCInventoryServer).Adjust(ctx, req.(*StockAdjustment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCInventory_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCInventoryServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCInventory_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCInventoryServer).Get(ctx, req.(*ProductRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCInventory_SetLowStockThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LowStockThreshold)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCInventoryServer).SetLowStockThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCInventory_SetLowStockThreshold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCInventoryServer).SetLowStockThreshold(ctx, req.(*LowStockThreshold))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCInventory_ListLowStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLowStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCInventoryServer).ListLowStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCInventory_ListLowStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCInventoryServer).ListLowStock(ctx, req.(*ListLowStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCInventory_Ledger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCInventoryServer).Ledger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCInventory_Ledger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCInventoryServer).Ledger(ctx, req.(*LedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GRPCInventory_ServiceDesc is the grpc.ServiceDesc for GRPCInventory service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GRPCInventory_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "catalog.GRPCInventory",
+	HandlerType: (*GRPCInventoryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Adjust",
+			Handler:    _GRPCInventory_Adjust_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _GRPCInventory_Get_Handler,
+		},
+		{
+			MethodName: "SetLowStockThreshold",
+			Handler:    _GRPCInventory_SetLowStockThreshold_Handler,
+		},
+		{
+			MethodName: "ListLowStock",
+			Handler:    _GRPCInventory_ListLowStock_Handler,
+		},
+		{
+			MethodName: "Ledger",
+			Handler:    _GRPCInventory_Ledger_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "catalog/inventory.proto",
+}
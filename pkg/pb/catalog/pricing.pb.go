@@ -0,0 +1,1068 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: catalog/pricing.proto
+
+package catalog
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PriceSource int32
+
+const (
+	PriceSource_PRICE_SOURCE_UNSPECIFIED PriceSource = 0
+	PriceSource_PRICE_SOURCE_BASE        PriceSource = 1
+	PriceSource_PRICE_SOURCE_EXPLICIT    PriceSource = 2
+	PriceSource_PRICE_SOURCE_CONVERTED   PriceSource = 3
+)
+
+// Enum value maps for PriceSource.
+var (
+	PriceSource_name = map[int32]string{
+		0: "PRICE_SOURCE_UNSPECIFIED",
+		1: "PRICE_SOURCE_BASE",
+		2: "PRICE_SOURCE_EXPLICIT",
+		3: "PRICE_SOURCE_CONVERTED",
+	}
+	PriceSource_value = map[string]int32{
+		"PRICE_SOURCE_UNSPECIFIED": 0,
+		"PRICE_SOURCE_BASE":        1,
+		"PRICE_SOURCE_EXPLICIT":    2,
+		"PRICE_SOURCE_CONVERTED":   3,
+	}
+)
+
+func (x PriceSource) Enum() *PriceSource {
+	p := new(PriceSource)
+	*p = x
+	return p
+}
+
+func (x PriceSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PriceSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_catalog_pricing_proto_enumTypes[0].Descriptor()
+}
+
+func (PriceSource) Type() protoreflect.EnumType {
+	return &file_catalog_pricing_proto_enumTypes[0]
+}
+
+func (x PriceSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PriceSource.Descriptor instead.
+func (PriceSource) EnumDescriptor() ([]byte, []int) {
+	return file_catalog_pricing_proto_rawDescGZIP(), []int{0}
+}
+
+type Rounding int32
+
+const (
+	Rounding_ROUNDING_UNSPECIFIED Rounding = 0 // half_up
+	Rounding_ROUNDING_HALF_UP     Rounding = 1
+	Rounding_ROUNDING_HALF_EVEN   Rounding = 2
+	Rounding_ROUNDING_DOWN        Rounding = 3
+	Rounding_ROUNDING_UP          Rounding = 4
+)
+
+// Enum value maps for Rounding.
+var (
+	Rounding_name = map[int32]string{
+		0: "ROUNDING_UNSPECIFIED",
+		1: "ROUNDING_HALF_UP",
+		2: "ROUNDING_HALF_EVEN",
+		3: "ROUNDING_DOWN",
+		4: "ROUNDING_UP",
+	}
+	Rounding_value = map[string]int32{
+		"ROUNDING_UNSPECIFIED": 0,
+		"ROUNDING_HALF_UP":     1,
+		"ROUNDING_HALF_EVEN":   2,
+		"ROUNDING_DOWN":        3,
+		"ROUNDING_UP":          4,
+	}
+)
+
+func (x Rounding) Enum() *Rounding {
+	p := new(Rounding)
+	*p = x
+	return p
+}
+
+func (x Rounding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Rounding) Descriptor() protoreflect.EnumDescriptor {
+	return file_catalog_pricing_proto_enumTypes[1].Descriptor()
+}
+
+func (Rounding) Type() protoreflect.EnumType {
+	return &file_catalog_pricing_proto_enumTypes[1]
+}
+
+func (x Rounding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Rounding.Descriptor instead.
+func (Rounding) EnumDescriptor() ([]byte, []int) {
+	return file_catalog_pricing_proto_rawDescGZIP(), []int{1}
+}
+
+type ProductPrice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Price         *Money                 `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductPrice) Reset() {
+	*x = ProductPrice{}
+	mi := &file_catalog_pricing_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductPrice) ProtoMessage() {}
+
+func (x *ProductPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_pricing_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductPrice.ProtoReflect.Descriptor instead.
+func (*ProductPrice) Descriptor() ([]byte, []int) {
+	return file_catalog_pricing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProductPrice) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductPrice) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type ProductPriceRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductPriceRef) Reset() {
+	*x = ProductPriceRef{}
+	mi := &file_catalog_pricing_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductPriceRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductPriceRef) ProtoMessage() {}
+
+func (x *ProductPriceRef) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_pricing_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductPriceRef.ProtoReflect.Descriptor instead.
+func (*ProductPriceRef) Descriptor() ([]byte, []int) {
+	return file_catalog_pricing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProductPriceRef) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductPriceRef) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ProductPrices struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Base          *Money                 `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Prices        []*Money               `protobuf:"bytes,3,rep,name=prices,proto3" json:"prices,omitempty"` // заданные вручную
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductPrices) Reset() {
+	*x = ProductPrices{}
+	mi := &file_catalog_pricing_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductPrices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductPrices) ProtoMessage() {}
+
+func (x *ProductPrices) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_pricing_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductPrices.ProtoReflect.Descriptor instead.
+func (*ProductPrices) Descriptor() ([]byte, []int) {
+	return file_catalog_pricing_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProductPrices) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductPrices) GetBase() *Money {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ProductPrices) GetPrices() []*Money {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+type PriceQuote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Price         *Money                 `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	Source        PriceSource            `protobuf:"varint,2,opt,name=source,proto3,enum=catalog.PriceSource" json:"source,omitempty"`
+	Rate          string                 `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"` // курс, если цена пересчитана
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceQuote) Reset() {
+	*x = PriceQuote{}
+	mi := &file_catalog_pricing_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceQuote) ProtoMessage() {}
+
+func (x *PriceQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_pricing_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceQuote.ProtoReflect.Descriptor instead.
+func (*PriceQuote) Descriptor() ([]byte, []int) {
+	return file_catalog_pricing_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PriceQuote) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *PriceQuote) GetSource() PriceSource {
+	if x != nil {
+		return x.Source
+	}
+	return PriceSource_PRICE_SOURCE_UNSPECIFIED
+}
+
+func (x *PriceQuote) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+// 1 base = rate quote; rate - десятичная строка, чтобы не терять точность
+type CurrencyRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          string                 `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Quote         string                 `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	Rate          string                 `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Rounding      Rounding               `protobuf:"varint,4,opt,name=rounding,proto3,enum=catalog.Rounding" json:"rounding,omitempty"`
+	Increment     int64                  `protobuf:"varint,5,opt,name=increment,proto3" json:"increment,omitempty"`                 // шаг округления в минорных единицах quote, 0 - без шага
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // только в ответах
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyRate) Reset() {
+	*x = CurrencyRate{}
+	mi := &file_catalog_pricing_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyRate) ProtoMessage() {}
+
+func (x *CurrencyRate) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_pricing_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyRate.ProtoReflect.Descriptor instead.
+func (*CurrencyRate) Descriptor() ([]byte, []int) {
+	return file_catalog_pricing_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CurrencyRate) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *CurrencyRate) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+func (x *CurrencyRate) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *CurrencyRate) GetRounding() Rounding {
+	if x != nil {
+		return x.Rounding
+	}
+	return Rounding_ROUNDING_UNSPECIFIED
+}
+
+func (x *CurrencyRate) GetIncrement() int64 {
+	if x != nil {
+		return x.Increment
+	}
+	return 0
+}
+
+func (x *CurrencyRate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CurrencyPair struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          string                 `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Quote         string                 `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyPair) Reset() {
+	*x = CurrencyPair{}
+	mi := &file_catalog_pricing_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyPair) ProtoMessage() {}
+
+func (x *CurrencyPair) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_pricing_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyPair.ProtoReflect.Descriptor instead.
+func (*CurrencyPair) Descriptor() ([]byte, []int) {
+	return file_catalog_pricing_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CurrencyPair) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *CurrencyPair) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+type CurrencyRateList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*CurrencyRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyRateList) Reset() {
+	*x = CurrencyRateList{}
+	mi := &file_catalog_pricing_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyRateList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyRateList) ProtoMessage() {}
+
+func (x *CurrencyRateList) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_pricing_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyRateList.ProtoReflect.Descriptor instead.
+func (*CurrencyRateList) Descriptor() ([]byte, []int) {
+	return file_catalog_pricing_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CurrencyRateList) GetRates() []*CurrencyRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type ScheduledPrice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Price         *Money                 `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledPrice) Reset() {
+	*x = ScheduledPrice{}
+	mi := &file_catalog_pricing_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledPrice) ProtoMessage() {}
+
+func (x *ScheduledPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_pricing_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledPrice.ProtoReflect.Descriptor instead.
+func (*ScheduledPrice) Descriptor() ([]byte, []int) {
+	return file_catalog_pricing_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ScheduledPrice) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ScheduledPrice) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *ScheduledPrice) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+type PriceChangeID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceChangeID) Reset() {
+	*x = PriceChangeID{}
+	mi := &file_catalog_pricing_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceChangeID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceChangeID) ProtoMessage() {}
+
+func (x *PriceChangeID) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_pricing_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceChangeID.ProtoReflect.Descriptor instead.
+func (*PriceChangeID) Descriptor() ([]byte, []int) {
+	return file_catalog_pricing_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PriceChangeID) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PriceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceHistoryRequest) Reset() {
+	*x = PriceHistoryRequest{}
+	mi := &file_catalog_pricing_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceHistoryRequest) ProtoMessage() {}
+
+func (x *PriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_pricing_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*PriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_pricing_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PriceHistoryRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PriceHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *PriceHistoryRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type PriceChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Price         *Money                 `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	EffectiveTo   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to,omitempty"` // пусто - цена действует сейчас или еще не наступила
+	Scheduled     bool                   `protobuf:"varint,5,opt,name=scheduled,proto3" json:"scheduled,omitempty"`                       // изменение еще не применено
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceChange) Reset() {
+	*x = PriceChange{}
+	mi := &file_catalog_pricing_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceChange) ProtoMessage() {}
+
+func (x *PriceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_pricing_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceChange.ProtoReflect.Descriptor instead.
+func (*PriceChange) Descriptor() ([]byte, []int) {
+	return file_catalog_pricing_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PriceChange) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PriceChange) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *PriceChange) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *PriceChange) GetEffectiveTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return nil
+}
+
+func (x *PriceChange) GetScheduled() bool {
+	if x != nil {
+		return x.Scheduled
+	}
+	return false
+}
+
+type PriceHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*PriceChange         `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceHistory) Reset() {
+	*x = PriceHistory{}
+	mi := &file_catalog_pricing_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceHistory) ProtoMessage() {}
+
+func (x *PriceHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_pricing_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceHistory.ProtoReflect.Descriptor instead.
+func (*PriceHistory) Descriptor() ([]byte, []int) {
+	return file_catalog_pricing_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PriceHistory) GetChanges() []*PriceChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type PriceAtRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceAtRequest) Reset() {
+	*x = PriceAtRequest{}
+	mi := &file_catalog_pricing_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceAtRequest) ProtoMessage() {}
+
+func (x *PriceAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_pricing_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceAtRequest.ProtoReflect.Descriptor instead.
+func (*PriceAtRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_pricing_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PriceAtRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PriceAtRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+var File_catalog_pricing_proto protoreflect.FileDescriptor
+
+const file_catalog_pricing_proto_rawDesc = "" +
+	"\n" +
+	"\x15catalog/pricing.proto\x12\acatalog\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16catalog/category.proto\x1a\x14catalog/common.proto\"S\n" +
+	"\fProductPrice\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12$\n" +
+	"\x05price\x18\x02 \x01(\v2\x0e.catalog.MoneyR\x05price\"L\n" +
+	"\x0fProductPriceRef\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"z\n" +
+	"\rProductPrices\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\"\n" +
+	"\x04base\x18\x02 \x01(\v2\x0e.catalog.MoneyR\x04base\x12&\n" +
+	"\x06prices\x18\x03 \x03(\v2\x0e.catalog.MoneyR\x06prices\"t\n" +
+	"\n" +
+	"PriceQuote\x12$\n" +
+	"\x05price\x18\x01 \x01(\v2\x0e.catalog.MoneyR\x05price\x12,\n" +
+	"\x06source\x18\x02 \x01(\x0e2\x14.catalog.PriceSourceR\x06source\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\tR\x04rate\"\xd4\x01\n" +
+	"\fCurrencyRate\x12\x12\n" +
+	"\x04base\x18\x01 \x01(\tR\x04base\x12\x14\n" +
+	"\x05quote\x18\x02 \x01(\tR\x05quote\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\tR\x04rate\x12-\n" +
+	"\brounding\x18\x04 \x01(\x0e2\x11.catalog.RoundingR\brounding\x12\x1c\n" +
+	"\tincrement\x18\x05 \x01(\x03R\tincrement\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"8\n" +
+	"\fCurrencyPair\x12\x12\n" +
+	"\x04base\x18\x01 \x01(\tR\x04base\x12\x14\n" +
+	"\x05quote\x18\x02 \x01(\tR\x05quote\"?\n" +
+	"\x10CurrencyRateList\x12+\n" +
+	"\x05rates\x18\x01 \x03(\v2\x15.catalog.CurrencyRateR\x05rates\"\x98\x01\n" +
+	"\x0eScheduledPrice\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12$\n" +
+	"\x05price\x18\x02 \x01(\v2\x0e.catalog.MoneyR\x05price\x12A\n" +
+	"\x0eeffective_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\"\x1f\n" +
+	"\rPriceChangeID\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"b\n" +
+	"\x13PriceHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"\xe3\x01\n" +
+	"\vPriceChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12$\n" +
+	"\x05price\x18\x02 \x01(\v2\x0e.catalog.MoneyR\x05price\x12A\n" +
+	"\x0eeffective_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x12=\n" +
+	"\feffective_to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveTo\x12\x1c\n" +
+	"\tscheduled\x18\x05 \x01(\bR\tscheduled\">\n" +
+	"\fPriceHistory\x12.\n" +
+	"\achanges\x18\x01 \x03(\v2\x14.catalog.PriceChangeR\achanges\"[\n" +
+	"\x0ePriceAtRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at*y\n" +
+	"\vPriceSource\x12\x1c\n" +
+	"\x18PRICE_SOURCE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11PRICE_SOURCE_BASE\x10\x01\x12\x19\n" +
+	"\x15PRICE_SOURCE_EXPLICIT\x10\x02\x12\x1a\n" +
+	"\x16PRICE_SOURCE_CONVERTED\x10\x03*v\n" +
+	"\bRounding\x12\x18\n" +
+	"\x14ROUNDING_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10ROUNDING_HALF_UP\x10\x01\x12\x16\n" +
+	"\x12ROUNDING_HALF_EVEN\x10\x02\x12\x11\n" +
+	"\rROUNDING_DOWN\x10\x03\x12\x0f\n" +
+	"\vROUNDING_UP\x10\x042\xf0\x05\n" +
+	"\vGRPCPricing\x12=\n" +
+	"\fSetBasePrice\x12\x15.catalog.ProductPrice\x1a\x16.google.protobuf.Empty\x129\n" +
+	"\bSetPrice\x12\x15.catalog.ProductPrice\x1a\x16.google.protobuf.Empty\x12?\n" +
+	"\vDeletePrice\x12\x18.catalog.ProductPriceRef\x1a\x16.google.protobuf.Empty\x129\n" +
+	"\n" +
+	"ListPrices\x12\x13.catalog.ProductRef\x1a\x16.catalog.ProductPrices\x126\n" +
+	"\x05Quote\x12\x18.catalog.ProductPriceRef\x1a\x13.catalog.PriceQuote\x128\n" +
+	"\aSetRate\x12\x15.catalog.CurrencyRate\x1a\x16.google.protobuf.Empty\x12;\n" +
+	"\n" +
+	"DeleteRate\x12\x15.catalog.CurrencyPair\x1a\x16.google.protobuf.Empty\x12>\n" +
+	"\tListRates\x12\x16.google.protobuf.Empty\x1a\x19.catalog.CurrencyRateList\x12@\n" +
+	"\rSchedulePrice\x12\x17.catalog.ScheduledPrice\x1a\x16.catalog.PriceChangeID\x12F\n" +
+	"\x14CancelScheduledPrice\x12\x16.catalog.PriceChangeID\x1a\x16.google.protobuf.Empty\x12>\n" +
+	"\aHistory\x12\x1c.catalog.PriceHistoryRequest\x1a\x15.catalog.PriceHistory\x122\n" +
+	"\aPriceAt\x12\x17.catalog.PriceAtRequest\x1a\x0e.catalog.MoneyB6Z4github.com/glekoz/online-shop_product/pkg/pb/catalogb\x06proto3"
+
+var (
+	file_catalog_pricing_proto_rawDescOnce sync.Once
+	file_catalog_pricing_proto_rawDescData []byte
+)
+
+func file_catalog_pricing_proto_rawDescGZIP() []byte {
+	file_catalog_pricing_proto_rawDescOnce.Do(func() {
+		file_catalog_pricing_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_catalog_pricing_proto_rawDesc), len(file_catalog_pricing_proto_rawDesc)))
+	})
+	return file_catalog_pricing_proto_rawDescData
+}
+
+var file_catalog_pricing_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_catalog_pricing_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_catalog_pricing_proto_goTypes = []any{
+	(PriceSource)(0),              // 0: catalog.PriceSource
+	(Rounding)(0),                 // 1: catalog.Rounding
+	(*ProductPrice)(nil),          // 2: catalog.ProductPrice
+	(*ProductPriceRef)(nil),       // 3: catalog.ProductPriceRef
+	(*ProductPrices)(nil),         // 4: catalog.ProductPrices
+	(*PriceQuote)(nil),            // 5: catalog.PriceQuote
+	(*CurrencyRate)(nil),          // 6: catalog.CurrencyRate
+	(*CurrencyPair)(nil),          // 7: catalog.CurrencyPair
+	(*CurrencyRateList)(nil),      // 8: catalog.CurrencyRateList
+	(*ScheduledPrice)(nil),        // 9: catalog.ScheduledPrice
+	(*PriceChangeID)(nil),         // 10: catalog.PriceChangeID
+	(*PriceHistoryRequest)(nil),   // 11: catalog.PriceHistoryRequest
+	(*PriceChange)(nil),           // 12: catalog.PriceChange
+	(*PriceHistory)(nil),          // 13: catalog.PriceHistory
+	(*PriceAtRequest)(nil),        // 14: catalog.PriceAtRequest
+	(*Money)(nil),                 // 15: catalog.Money
+	(*timestamppb.Timestamp)(nil), // 16: google.protobuf.Timestamp
+	(*ProductRef)(nil),            // 17: catalog.ProductRef
+	(*emptypb.Empty)(nil),         // 18: google.protobuf.Empty
+}
+var file_catalog_pricing_proto_depIdxs = []int32{
+	15, // 0: catalog.ProductPrice.price:type_name -> catalog.Money
+	15, // 1: catalog.ProductPrices.base:type_name -> catalog.Money
+	15, // 2: catalog.ProductPrices.prices:type_name -> catalog.Money
+	15, // 3: catalog.PriceQuote.price:type_name -> catalog.Money
+	0,  // 4: catalog.PriceQuote.source:type_name -> catalog.PriceSource
+	1,  // 5: catalog.CurrencyRate.rounding:type_name -> catalog.Rounding
+	16, // 6: catalog.CurrencyRate.updated_at:type_name -> google.protobuf.Timestamp
+	6,  // 7: catalog.CurrencyRateList.rates:type_name -> catalog.CurrencyRate
+	15, // 8: catalog.ScheduledPrice.price:type_name -> catalog.Money
+	16, // 9: catalog.ScheduledPrice.effective_from:type_name -> google.protobuf.Timestamp
+	15, // 10: catalog.PriceChange.price:type_name -> catalog.Money
+	16, // 11: catalog.PriceChange.effective_from:type_name -> google.protobuf.Timestamp
+	16, // 12: catalog.PriceChange.effective_to:type_name -> google.protobuf.Timestamp
+	12, // 13: catalog.PriceHistory.changes:type_name -> catalog.PriceChange
+	16, // 14: catalog.PriceAtRequest.at:type_name -> google.protobuf.Timestamp
+	2,  // 15: catalog.GRPCPricing.SetBasePrice:input_type -> catalog.ProductPrice
+	2,  // 16: catalog.GRPCPricing.SetPrice:input_type -> catalog.ProductPrice
+	3,  // 17: catalog.GRPCPricing.DeletePrice:input_type -> catalog.ProductPriceRef
+	17, // 18: catalog.GRPCPricing.ListPrices:input_type -> catalog.ProductRef
+	3,  // 19: catalog.GRPCPricing.Quote:input_type -> catalog.ProductPriceRef
+	6,  // 20: catalog.GRPCPricing.SetRate:input_type -> catalog.CurrencyRate
+	7,  // 21: catalog.GRPCPricing.DeleteRate:input_type -> catalog.CurrencyPair
+	18, // 22: catalog.GRPCPricing.ListRates:input_type -> google.protobuf.Empty
+	9,  // 23: catalog.GRPCPricing.SchedulePrice:input_type -> catalog.ScheduledPrice
+	10, // 24: catalog.GRPCPricing.CancelScheduledPrice:input_type -> catalog.PriceChangeID
+	11, // 25: catalog.GRPCPricing.History:input_type -> catalog.PriceHistoryRequest
+	14, // 26: catalog.GRPCPricing.PriceAt:input_type -> catalog.PriceAtRequest
+	18, // 27: catalog.GRPCPricing.SetBasePrice:output_type -> google.protobuf.Empty
+	18, // 28: catalog.GRPCPricing.SetPrice:output_type -> google.protobuf.Empty
+	18, // 29: catalog.GRPCPricing.DeletePrice:output_type -> google.protobuf.Empty
+	4,  // 30: catalog.GRPCPricing.ListPrices:output_type -> catalog.ProductPrices
+	5,  // 31: catalog.GRPCPricing.Quote:output_type -> catalog.PriceQuote
+	18, // 32: catalog.GRPCPricing.SetRate:output_type -> google.protobuf.Empty
+	18, // 33: catalog.GRPCPricing.DeleteRate:output_type -> google.protobuf.Empty
+	8,  // 34: catalog.GRPCPricing.ListRates:output_type -> catalog.CurrencyRateList
+	10, // 35: catalog.GRPCPricing.SchedulePrice:output_type -> catalog.PriceChangeID
+	18, // 36: catalog.GRPCPricing.CancelScheduledPrice:output_type -> google.protobuf.Empty
+	13, // 37: catalog.GRPCPricing.History:output_type -> catalog.PriceHistory
+	15, // 38: catalog.GRPCPricing.PriceAt:output_type -> catalog.Money
+	27, // [27:39] is the sub-list for method output_type
+	15, // [15:27] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_catalog_pricing_proto_init() }
+func file_catalog_pricing_proto_init() {
+	if File_catalog_pricing_proto != nil {
+		return
+	}
+	file_catalog_category_proto_init()
+	file_catalog_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_pricing_proto_rawDesc), len(file_catalog_pricing_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_catalog_pricing_proto_goTypes,
+		DependencyIndexes: file_catalog_pricing_proto_depIdxs,
+		EnumInfos:         file_catalog_pricing_proto_enumTypes,
+		MessageInfos:      file_catalog_pricing_proto_msgTypes,
+	}.Build()
+	File_catalog_pricing_proto = out.File
+	file_catalog_pricing_proto_goTypes = nil
+	file_catalog_pricing_proto_depIdxs = nil
+}
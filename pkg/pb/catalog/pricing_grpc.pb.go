@@ -0,0 +1,556 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: catalog/pricing.proto
+
+package catalog
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GRPCPricing_SetBasePrice_FullMethodName         = "/catalog.GRPCPricing/SetBasePrice"
+	GRPCPricing_SetPrice_FullMethodName             = "/catalog.GRPCPricing/SetPrice"
+	GRPCPricing_DeletePrice_FullMethodName          = "/catalog.GRPCPricing/DeletePrice"
+	GRPCPricing_ListPrices_FullMethodName           = "/catalog.GRPCPricing/ListPrices"
+	GRPCPricing_Quote_FullMethodName                = "/catalog.GRPCPricing/Quote"
+	GRPCPricing_SetRate_FullMethodName              = "/catalog.GRPCPricing/SetRate"
+	GRPCPricing_DeleteRate_FullMethodName           = "/catalog.GRPCPricing/DeleteRate"
+	GRPCPricing_ListRates_FullMethodName            = "/catalog.GRPCPricing/ListRates"
+	GRPCPricing_SchedulePrice_FullMethodName        = "/catalog.GRPCPricing/SchedulePrice"
+	GRPCPricing_CancelScheduledPrice_FullMethodName = "/catalog.GRPCPricing/CancelScheduledPrice"
+	GRPCPricing_History_FullMethodName              = "/catalog.GRPCPricing/History"
+	GRPCPricing_PriceAt_FullMethodName              = "/catalog.GRPCPricing/PriceAt"
+)
+
+// GRPCPricingClient is the client API for GRPCPricing service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// У товара одна базовая цена в своей валюте. Цены в других валютах либо
+// задаются вручную, либо считаются по курсу с правилом округления.
+type GRPCPricingClient interface {
+	SetBasePrice(ctx context.Context, in *ProductPrice, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SetPrice задает цену в валюте; цена в валюте товара меняет базовую цену
+	SetPrice(ctx context.Context, in *ProductPrice, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeletePrice(ctx context.Context, in *ProductPriceRef, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListPrices(ctx context.Context, in *ProductRef, opts ...grpc.CallOption) (*ProductPrices, error)
+	// Quote возвращает цену в валюте; если ни цены, ни курса нет - FAILED_PRECONDITION
+	Quote(ctx context.Context, in *ProductPriceRef, opts ...grpc.CallOption) (*PriceQuote, error)
+	SetRate(ctx context.Context, in *CurrencyRate, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteRate(ctx context.Context, in *CurrencyPair, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListRates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CurrencyRateList, error)
+	// SchedulePrice планирует смену базовой цены; в effective_from ее применит планировщик
+	SchedulePrice(ctx context.Context, in *ScheduledPrice, opts ...grpc.CallOption) (*PriceChangeID, error)
+	CancelScheduledPrice(ctx context.Context, in *PriceChangeID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// History - прошлые, текущая и запланированные базовые цены, новые сверху
+	History(ctx context.Context, in *PriceHistoryRequest, opts ...grpc.CallOption) (*PriceHistory, error)
+	// PriceAt - базовая цена, действовавшая в момент at
+	PriceAt(ctx context.Context, in *PriceAtRequest, opts ...grpc.CallOption) (*Money, error)
+}
+
+type gRPCPricingClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGRPCPricingClient(cc grpc.ClientConnInterface) GRPCPricingClient {
+	return &gRPCPricingClient{cc}
+}
+
+func (c *gRPCPricingClient) SetBasePrice(ctx context.Context, in *ProductPrice, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCPricing_SetBasePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCPricingClient) SetPrice(ctx context.Context, in *ProductPrice, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCPricing_SetPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCPricingClient) DeletePrice(ctx context.Context, in *ProductPriceRef, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCPricing_DeletePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCPricingClient) ListPrices(ctx context.Context, in *ProductRef, opts ...grpc.CallOption) (*ProductPrices, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductPrices)
+	err := c.cc.Invoke(ctx, GRPCPricing_ListPrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCPricingClient) Quote(ctx context.Context, in *ProductPriceRef, opts ...grpc.CallOption) (*PriceQuote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceQuote)
+	err := c.cc.Invoke(ctx, GRPCPricing_Quote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCPricingClient) SetRate(ctx context.Context, in *CurrencyRate, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCPricing_SetRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCPricingClient) DeleteRate(ctx context.Context, in *CurrencyPair, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCPricing_DeleteRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCPricingClient) ListRates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CurrencyRateList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CurrencyRateList)
+	err := c.cc.Invoke(ctx, GRPCPricing_ListRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCPricingClient) SchedulePrice(ctx context.Context, in *ScheduledPrice, opts ...grpc.CallOption) (*PriceChangeID, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceChangeID)
+	err := c.cc.Invoke(ctx, GRPCPricing_SchedulePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCPricingClient) CancelScheduledPrice(ctx context.Context, in *PriceChangeID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCPricing_CancelScheduledPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCPricingClient) History(ctx context.Context, in *PriceHistoryRequest, opts ...grpc.CallOption) (*PriceHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceHistory)
+	err := c.cc.Invoke(ctx, GRPCPricing_History_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCPricingClient) PriceAt(ctx context.Context, in *PriceAtRequest, opts ...grpc.CallOption) (*Money, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Money)
+	err := c.cc.Invoke(ctx, GRPCPricing_PriceAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GRPCPricingServer is the server API for GRPCPricing service.
+// All implementations must embed UnimplementedGRPCPricingServer
+// for forward compatibility.
+//
+// У товара одна базовая цена в своей валюте. Цены в других валютах либо
+// задаются вручную, либо считаются по курсу с правилом округления.
+type GRPCPricingServer interface {
+	SetBasePrice(context.Context, *ProductPrice) (*emptypb.Empty, error)
+	// SetPrice задает цену в валюте; цена в валюте товара меняет базовую цену
+	SetPrice(context.Context, *ProductPrice) (*emptypb.Empty, error)
+	DeletePrice(context.Context, *ProductPriceRef) (*emptypb.Empty, error)
+	ListPrices(context.Context, *ProductRef) (*ProductPrices, error)
+	// Quote возвращает цену в валюте; если ни цены, ни курса нет - FAILED_PRECONDITION
+	Quote(context.Context, *ProductPriceRef) (*PriceQuote, error)
+	SetRate(context.Context, *CurrencyRate) (*emptypb.Empty, error)
+	DeleteRate(context.Context, *CurrencyPair) (*emptypb.Empty, error)
+	ListRates(context.Context, *emptypb.Empty) (*CurrencyRateList, error)
+	// SchedulePrice планирует смену базовой цены; в effective_from ее применит планировщик
+	SchedulePrice(context.Context, *ScheduledPrice) (*PriceChangeID, error)
+	CancelScheduledPrice(context.Context, *PriceChangeID) (*emptypb.Empty, error)
+	// History - прошлые, текущая и запланированные базовые цены, новые сверху
+	History(context.Context, *PriceHistoryRequest) (*PriceHistory, error)
+	// PriceAt - базовая цена, действовавшая в момент at
+	PriceAt(context.Context, *PriceAtRequest) (*Money, error)
+	mustEmbedUnimplementedGRPCPricingServer()
+}
+
+// UnimplementedGRPCPricingServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGRPCPricingServer struct{}
+
+func (UnimplementedGRPCPricingServer) SetBasePrice(context.Context, *ProductPrice) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBasePrice not implemented")
+}
+func (UnimplementedGRPCPricingServer) SetPrice(context.Context, *ProductPrice) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrice not implemented")
+}
+func (UnimplementedGRPCPricingServer) DeletePrice(context.Context, *ProductPriceRef) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePrice not implemented")
+}
+func (UnimplementedGRPCPricingServer) ListPrices(context.Context, *ProductRef) (*ProductPrices, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPrices not implemented")
+}
+func (UnimplementedGRPCPricingServer) Quote(context.Context, *ProductPriceRef) (*PriceQuote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Quote not implemented")
+}
+func (UnimplementedGRPCPricingServer) SetRate(context.Context, *CurrencyRate) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRate not implemented")
+}
+func (UnimplementedGRPCPricingServer) DeleteRate(context.Context, *CurrencyPair) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRate not implemented")
+}
+func (UnimplementedGRPCPricingServer) ListRates(context.Context, *emptypb.Empty) (*CurrencyRateList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRates not implemented")
+}
+func (UnimplementedGRPCPricingServer) SchedulePrice(context.Context, *ScheduledPrice) (*PriceChangeID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePrice not implemented")
+}
+func (UnimplementedGRPCPricingServer) CancelScheduledPrice(context.Context, *PriceChangeID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledPrice not implemented")
+}
+func (UnimplementedGRPCPricingServer) History(context.Context, *PriceHistoryRequest) (*PriceHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method History not implemented")
+}
+func (UnimplementedGRPCPricingServer) PriceAt(context.Context, *PriceAtRequest) (*Money, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceAt not implemented")
+}
+func (UnimplementedGRPCPricingServer) mustEmbedUnimplementedGRPCPricingServer() {}
+func (UnimplementedGRPCPricingServer) testEmbeddedByValue()                     {}
+
+// UnsafeGRPCPricingServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GRPCPricingServer will
+// result in compilation errors.
+type UnsafeGRPCPricingServer interface {
+	mustEmbedUnimplementedGRPCPricingServer()
+}
+
+func RegisterGRPCPricingServer(s grpc.ServiceRegistrar, srv GRPCPricingServer) {
+	// If the following call pancis, it indicates UnimplementedGRPCPricingServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GRPCPricing_ServiceDesc, srv)
+}
+
+func _GRPCPricing_SetBasePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCPricingServer).SetBasePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCPricing_SetBasePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCPricingServer).SetBasePrice(ctx, req.(*ProductPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCPricing_SetPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCPricingServer).SetPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCPricing_SetPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCPricingServer).SetPrice(ctx, req.(*ProductPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCPricing_DeletePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductPriceRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCPricingServer).DeletePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCPricing_DeletePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCPricingServer).DeletePrice(ctx, req.(*ProductPriceRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCPricing_ListPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCPricingServer).ListPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCPricing_ListPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCPricingServer).ListPrices(ctx, req.(*ProductRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCPricing_Quote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductPriceRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCPricingServer).Quote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCPricing_Quote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCPricingServer).Quote(ctx, req.(*ProductPriceRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCPricing_SetRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrencyRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCPricingServer).SetRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCPricing_SetRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCPricingServer).SetRate(ctx, req.(*CurrencyRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCPricing_DeleteRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrencyPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCPricingServer).DeleteRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCPricing_DeleteRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCPricingServer).DeleteRate(ctx, req.(*CurrencyPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCPricing_ListRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCPricingServer).ListRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCPricing_ListRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCPricingServer).ListRates(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCPricing_SchedulePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCPricingServer).SchedulePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCPricing_SchedulePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCPricingServer).SchedulePrice(ctx, req.(*ScheduledPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCPricing_CancelScheduledPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceChangeID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCPricingServer).CancelScheduledPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCPricing_CancelScheduledPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCPricingServer).CancelScheduledPrice(ctx, req.(*PriceChangeID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCPricing_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCPricingServer).History(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCPricing_History_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCPricingServer).History(ctx, req.(*PriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCPricing_PriceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCPricingServer).PriceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCPricing_PriceAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCPricingServer).PriceAt(ctx, req.(*PriceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GRPCPricing_ServiceDesc is the grpc.ServiceDesc for GRPCPricing service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GRPCPricing_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "catalog.GRPCPricing",
+	HandlerType: (*GRPCPricingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetBasePrice",
+			Handler:    _GRPCPricing_SetBasePrice_Handler,
+		},
+		{
+			MethodName: "SetPrice",
+			Handler:    _GRPCPricing_SetPrice_Handler,
+		},
+		{
+			MethodName: "DeletePrice",
+			Handler:    _GRPCPricing_DeletePrice_Handler,
+		},
+		{
+			MethodName: "ListPrices",
+			Handler:    _GRPCPricing_ListPrices_Handler,
+		},
+		{
+			MethodName: "Quote",
+			Handler:    _GRPCPricing_Quote_Handler,
+		},
+		{
+			MethodName: "SetRate",
+			Handler:    _GRPCPricing_SetRate_Handler,
+		},
+		{
+			MethodName: "DeleteRate",
+			Handler:    _GRPCPricing_DeleteRate_Handler,
+		},
+		{
+			MethodName: "ListRates",
+			Handler:    _GRPCPricing_ListRates_Handler,
+		},
+		{
+			MethodName: "SchedulePrice",
+			Handler:    _GRPCPricing_SchedulePrice_Handler,
+		},
+		{
+			MethodName: "CancelScheduledPrice",
+			Handler:    _GRPCPricing_CancelScheduledPrice_Handler,
+		},
+		{
+			MethodName: "History",
+			Handler:    _GRPCPricing_History_Handler,
+		},
+		{
+			MethodName: "PriceAt",
+			Handler:    _GRPCPricing_PriceAt_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "catalog/pricing.proto",
+}
@@ -0,0 +1,1198 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: catalog/product.proto
+
+package catalog
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ProductStatus int32
+
+const (
+	ProductStatus_PRODUCT_STATUS_UNSPECIFIED  ProductStatus = 0
+	ProductStatus_PRODUCT_STATUS_DRAFT        ProductStatus = 1
+	ProductStatus_PRODUCT_STATUS_IN_REVIEW    ProductStatus = 2
+	ProductStatus_PRODUCT_STATUS_PUBLISHED    ProductStatus = 3
+	ProductStatus_PRODUCT_STATUS_DISCONTINUED ProductStatus = 4
+)
+
+// Enum value maps for ProductStatus.
+var (
+	ProductStatus_name = map[int32]string{
+		0: "PRODUCT_STATUS_UNSPECIFIED",
+		1: "PRODUCT_STATUS_DRAFT",
+		2: "PRODUCT_STATUS_IN_REVIEW",
+		3: "PRODUCT_STATUS_PUBLISHED",
+		4: "PRODUCT_STATUS_DISCONTINUED",
+	}
+	ProductStatus_value = map[string]int32{
+		"PRODUCT_STATUS_UNSPECIFIED":  0,
+		"PRODUCT_STATUS_DRAFT":        1,
+		"PRODUCT_STATUS_IN_REVIEW":    2,
+		"PRODUCT_STATUS_PUBLISHED":    3,
+		"PRODUCT_STATUS_DISCONTINUED": 4,
+	}
+)
+
+func (x ProductStatus) Enum() *ProductStatus {
+	p := new(ProductStatus)
+	*p = x
+	return p
+}
+
+func (x ProductStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProductStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_catalog_product_proto_enumTypes[0].Descriptor()
+}
+
+func (ProductStatus) Type() protoreflect.EnumType {
+	return &file_catalog_product_proto_enumTypes[0]
+}
+
+func (x ProductStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProductStatus.Descriptor instead.
+func (ProductStatus) EnumDescriptor() ([]byte, []int) {
+	return file_catalog_product_proto_rawDescGZIP(), []int{0}
+}
+
+type BulkMode int32
+
+const (
+	// то же, что ATOMIC
+	BulkMode_BULK_MODE_UNSPECIFIED BulkMode = 0
+	// все или ничего: при ошибке хотя бы в одном элементе не применяется ни один
+	BulkMode_BULK_MODE_ATOMIC BulkMode = 1
+	// применяется все, что можно, ошибки остаются в отчете
+	BulkMode_BULK_MODE_BEST_EFFORT BulkMode = 2
+)
+
+// Enum value maps for BulkMode.
+var (
+	BulkMode_name = map[int32]string{
+		0: "BULK_MODE_UNSPECIFIED",
+		1: "BULK_MODE_ATOMIC",
+		2: "BULK_MODE_BEST_EFFORT",
+	}
+	BulkMode_value = map[string]int32{
+		"BULK_MODE_UNSPECIFIED": 0,
+		"BULK_MODE_ATOMIC":      1,
+		"BULK_MODE_BEST_EFFORT": 2,
+	}
+)
+
+func (x BulkMode) Enum() *BulkMode {
+	p := new(BulkMode)
+	*p = x
+	return p
+}
+
+func (x BulkMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BulkMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_catalog_product_proto_enumTypes[1].Descriptor()
+}
+
+func (BulkMode) Type() protoreflect.EnumType {
+	return &file_catalog_product_proto_enumTypes[1]
+}
+
+func (x BulkMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BulkMode.Descriptor instead.
+func (BulkMode) EnumDescriptor() ([]byte, []int) {
+	return file_catalog_product_proto_rawDescGZIP(), []int{1}
+}
+
+type BulkStatus int32
+
+const (
+	BulkStatus_BULK_STATUS_UNSPECIFIED BulkStatus = 0
+	BulkStatus_BULK_STATUS_APPLIED     BulkStatus = 1
+	BulkStatus_BULK_STATUS_FAILED      BulkStatus = 2
+	// в атомарном режиме: элемент в порядке, но не применен из-за ошибок в других
+	BulkStatus_BULK_STATUS_SKIPPED BulkStatus = 3
+)
+
+// Enum value maps for BulkStatus.
+var (
+	BulkStatus_name = map[int32]string{
+		0: "BULK_STATUS_UNSPECIFIED",
+		1: "BULK_STATUS_APPLIED",
+		2: "BULK_STATUS_FAILED",
+		3: "BULK_STATUS_SKIPPED",
+	}
+	BulkStatus_value = map[string]int32{
+		"BULK_STATUS_UNSPECIFIED": 0,
+		"BULK_STATUS_APPLIED":     1,
+		"BULK_STATUS_FAILED":      2,
+		"BULK_STATUS_SKIPPED":     3,
+	}
+)
+
+func (x BulkStatus) Enum() *BulkStatus {
+	p := new(BulkStatus)
+	*p = x
+	return p
+}
+
+func (x BulkStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BulkStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_catalog_product_proto_enumTypes[2].Descriptor()
+}
+
+func (BulkStatus) Type() protoreflect.EnumType {
+	return &file_catalog_product_proto_enumTypes[2]
+}
+
+func (x BulkStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BulkStatus.Descriptor instead.
+func (BulkStatus) EnumDescriptor() ([]byte, []int) {
+	return file_catalog_product_proto_rawDescGZIP(), []int{2}
+}
+
+type Product struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price          *Money                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	EffectivePrice *Money                 `protobuf:"bytes,5,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"` // с учетом акций
+	PromotionIds   []string               `protobuf:"bytes,6,rep,name=promotion_ids,json=promotionIds,proto3" json:"promotion_ids,omitempty"`
+	Locale         string                 `protobuf:"bytes,7,opt,name=locale,proto3" json:"locale,omitempty"` // язык name и description, выбранный по accept-language
+	Slug           string                 `protobuf:"bytes,8,opt,name=slug,proto3" json:"slug,omitempty"`     // текущий, меняется вместе с названием
+	Status         ProductStatus          `protobuf:"varint,9,opt,name=status,proto3,enum=catalog.ProductStatus" json:"status,omitempty"`
+	PublishAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"` // не задан, если не запланировано
+	UnpublishAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=unpublish_at,json=unpublishAt,proto3" json:"unpublish_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_catalog_product_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_product_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_catalog_product_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Product) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Product) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Product) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *Product) GetEffectivePrice() *Money {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return nil
+}
+
+func (x *Product) GetPromotionIds() []string {
+	if x != nil {
+		return x.PromotionIds
+	}
+	return nil
+}
+
+func (x *Product) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Product) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Product) GetStatus() ProductStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProductStatus_PRODUCT_STATUS_UNSPECIFIED
+}
+
+func (x *Product) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *Product) GetUnpublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnpublishAt
+	}
+	return nil
+}
+
+type GetBySlugRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBySlugRequest) Reset() {
+	*x = GetBySlugRequest{}
+	mi := &file_catalog_product_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBySlugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBySlugRequest) ProtoMessage() {}
+
+func (x *GetBySlugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_product_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBySlugRequest.ProtoReflect.Descriptor instead.
+func (*GetBySlugRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_product_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetBySlugRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type GetBySlugResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Product *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	// запрошен старый слаг: витрине стоит ответить 301 на product.slug
+	Moved         bool `protobuf:"varint,2,opt,name=moved,proto3" json:"moved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBySlugResponse) Reset() {
+	*x = GetBySlugResponse{}
+	mi := &file_catalog_product_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBySlugResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBySlugResponse) ProtoMessage() {}
+
+func (x *GetBySlugResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_product_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBySlugResponse.ProtoReflect.Descriptor instead.
+func (*GetBySlugResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_product_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetBySlugResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *GetBySlugResponse) GetMoved() bool {
+	if x != nil {
+		return x.Moved
+	}
+	return false
+}
+
+type SetStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        ProductStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=catalog.ProductStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetStatusRequest) Reset() {
+	*x = SetStatusRequest{}
+	mi := &file_catalog_product_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStatusRequest) ProtoMessage() {}
+
+func (x *SetStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_product_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetStatusRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_product_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetStatusRequest) GetStatus() ProductStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProductStatus_PRODUCT_STATUS_UNSPECIFIED
+}
+
+// publish_at - только для товара в in_review, unpublish_at - в in_review или published
+type SetScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	UnpublishAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=unpublish_at,json=unpublishAt,proto3" json:"unpublish_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetScheduleRequest) Reset() {
+	*x = SetScheduleRequest{}
+	mi := &file_catalog_product_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetScheduleRequest) ProtoMessage() {}
+
+func (x *SetScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_product_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetScheduleRequest.ProtoReflect.Descriptor instead.
+func (*SetScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_product_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetScheduleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetScheduleRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *SetScheduleRequest) GetUnpublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnpublishAt
+	}
+	return nil
+}
+
+type BatchGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"` // не больше 100, повторы допустимы
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetRequest) Reset() {
+	*x = BatchGetRequest{}
+	mi := &file_catalog_product_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetRequest) ProtoMessage() {}
+
+func (x *BatchGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_product_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_product_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchGetRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchGetResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Found         bool                   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	Product       *Product               `protobuf:"bytes,3,opt,name=product,proto3" json:"product,omitempty"` // не задан, если found = false
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetResult) Reset() {
+	*x = BatchGetResult{}
+	mi := &file_catalog_product_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetResult) ProtoMessage() {}
+
+func (x *BatchGetResult) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_product_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetResult.ProtoReflect.Descriptor instead.
+func (*BatchGetResult) Descriptor() ([]byte, []int) {
+	return file_catalog_product_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatchGetResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BatchGetResult) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *BatchGetResult) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type BatchGetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchGetResult      `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // в порядке ids запроса
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetResponse) Reset() {
+	*x = BatchGetResponse{}
+	mi := &file_catalog_product_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetResponse) ProtoMessage() {}
+
+func (x *BatchGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_product_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_product_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BatchGetResponse) GetResults() []*BatchGetResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type PriceUpdate struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Types that are valid to be assigned to Change:
+	//
+	//	*PriceUpdate_Price
+	//	*PriceUpdate_ChangeBp
+	Change        isPriceUpdate_Change `protobuf_oneof:"change"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceUpdate) Reset() {
+	*x = PriceUpdate{}
+	mi := &file_catalog_product_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceUpdate) ProtoMessage() {}
+
+func (x *PriceUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_product_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceUpdate.ProtoReflect.Descriptor instead.
+func (*PriceUpdate) Descriptor() ([]byte, []int) {
+	return file_catalog_product_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PriceUpdate) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PriceUpdate) GetChange() isPriceUpdate_Change {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+func (x *PriceUpdate) GetPrice() *Money {
+	if x != nil {
+		if x, ok := x.Change.(*PriceUpdate_Price); ok {
+			return x.Price
+		}
+	}
+	return nil
+}
+
+func (x *PriceUpdate) GetChangeBp() int32 {
+	if x != nil {
+		if x, ok := x.Change.(*PriceUpdate_ChangeBp); ok {
+			return x.ChangeBp
+		}
+	}
+	return 0
+}
+
+type isPriceUpdate_Change interface {
+	isPriceUpdate_Change()
+}
+
+type PriceUpdate_Price struct {
+	Price *Money `protobuf:"bytes,2,opt,name=price,proto3,oneof"` // новая базовая цена
+}
+
+type PriceUpdate_ChangeBp struct {
+	ChangeBp int32 `protobuf:"varint,3,opt,name=change_bp,json=changeBp,proto3,oneof"` // изменение текущей в сотых долях процента: 500 - +5%
+}
+
+func (*PriceUpdate_Price) isPriceUpdate_Change() {}
+
+func (*PriceUpdate_ChangeBp) isPriceUpdate_Change() {}
+
+type BulkUpdatePricesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Updates       []*PriceUpdate         `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+	Mode          BulkMode               `protobuf:"varint,2,opt,name=mode,proto3,enum=catalog.BulkMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdatePricesRequest) Reset() {
+	*x = BulkUpdatePricesRequest{}
+	mi := &file_catalog_product_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdatePricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdatePricesRequest) ProtoMessage() {}
+
+func (x *BulkUpdatePricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_product_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdatePricesRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdatePricesRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_product_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BulkUpdatePricesRequest) GetUpdates() []*PriceUpdate {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+func (x *BulkUpdatePricesRequest) GetMode() BulkMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BulkMode_BULK_MODE_UNSPECIFIED
+}
+
+type BulkDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Mode          BulkMode               `protobuf:"varint,2,opt,name=mode,proto3,enum=catalog.BulkMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkDeleteRequest) Reset() {
+	*x = BulkDeleteRequest{}
+	mi := &file_catalog_product_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkDeleteRequest) ProtoMessage() {}
+
+func (x *BulkDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_product_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkDeleteRequest.ProtoReflect.Descriptor instead.
+func (*BulkDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_product_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BulkDeleteRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BulkDeleteRequest) GetMode() BulkMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BulkMode_BULK_MODE_UNSPECIFIED
+}
+
+type BulkItemResult struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status BulkStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=catalog.BulkStatus" json:"status,omitempty"`
+	Error  string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// только у BulkUpdatePrices
+	OldPrice      *Money `protobuf:"bytes,4,opt,name=old_price,json=oldPrice,proto3" json:"old_price,omitempty"`
+	NewPrice      *Money `protobuf:"bytes,5,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkItemResult) Reset() {
+	*x = BulkItemResult{}
+	mi := &file_catalog_product_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkItemResult) ProtoMessage() {}
+
+func (x *BulkItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_product_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkItemResult.ProtoReflect.Descriptor instead.
+func (*BulkItemResult) Descriptor() ([]byte, []int) {
+	return file_catalog_product_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BulkItemResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BulkItemResult) GetStatus() BulkStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BulkStatus_BULK_STATUS_UNSPECIFIED
+}
+
+func (x *BulkItemResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BulkItemResult) GetOldPrice() *Money {
+	if x != nil {
+		return x.OldPrice
+	}
+	return nil
+}
+
+func (x *BulkItemResult) GetNewPrice() *Money {
+	if x != nil {
+		return x.NewPrice
+	}
+	return nil
+}
+
+type BulkReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BulkItemResult      `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // в порядке запроса
+	Applied       int32                  `protobuf:"varint,2,opt,name=applied,proto3" json:"applied,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Skipped       int32                  `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkReport) Reset() {
+	*x = BulkReport{}
+	mi := &file_catalog_product_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkReport) ProtoMessage() {}
+
+func (x *BulkReport) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_product_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkReport.ProtoReflect.Descriptor instead.
+func (*BulkReport) Descriptor() ([]byte, []int) {
+	return file_catalog_product_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BulkReport) GetResults() []*BulkItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkReport) GetApplied() int32 {
+	if x != nil {
+		return x.Applied
+	}
+	return 0
+}
+
+func (x *BulkReport) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BulkReport) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+var File_catalog_product_proto protoreflect.FileDescriptor
+
+const file_catalog_product_proto_rawDesc = "" +
+	"\n" +
+	"\x15catalog/product.proto\x12\acatalog\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14catalog/common.proto\"\xa9\x03\n" +
+	"\aProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12$\n" +
+	"\x05price\x18\x04 \x01(\v2\x0e.catalog.MoneyR\x05price\x127\n" +
+	"\x0feffective_price\x18\x05 \x01(\v2\x0e.catalog.MoneyR\x0eeffectivePrice\x12#\n" +
+	"\rpromotion_ids\x18\x06 \x03(\tR\fpromotionIds\x12\x16\n" +
+	"\x06locale\x18\a \x01(\tR\x06locale\x12\x12\n" +
+	"\x04slug\x18\b \x01(\tR\x04slug\x12.\n" +
+	"\x06status\x18\t \x01(\x0e2\x16.catalog.ProductStatusR\x06status\x129\n" +
+	"\n" +
+	"publish_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12=\n" +
+	"\funpublish_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vunpublishAt\"&\n" +
+	"\x10GetBySlugRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\"U\n" +
+	"\x11GetBySlugResponse\x12*\n" +
+	"\aproduct\x18\x01 \x01(\v2\x10.catalog.ProductR\aproduct\x12\x14\n" +
+	"\x05moved\x18\x02 \x01(\bR\x05moved\"R\n" +
+	"\x10SetStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x16.catalog.ProductStatusR\x06status\"\x9e\x01\n" +
+	"\x12SetScheduleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"publish_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12=\n" +
+	"\funpublish_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vunpublishAt\"#\n" +
+	"\x0fBatchGetRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"b\n" +
+	"\x0eBatchGetResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05found\x18\x02 \x01(\bR\x05found\x12*\n" +
+	"\aproduct\x18\x03 \x01(\v2\x10.catalog.ProductR\aproduct\"E\n" +
+	"\x10BatchGetResponse\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.catalog.BatchGetResultR\aresults\"}\n" +
+	"\vPriceUpdate\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12&\n" +
+	"\x05price\x18\x02 \x01(\v2\x0e.catalog.MoneyH\x00R\x05price\x12\x1d\n" +
+	"\tchange_bp\x18\x03 \x01(\x05H\x00R\bchangeBpB\b\n" +
+	"\x06change\"p\n" +
+	"\x17BulkUpdatePricesRequest\x12.\n" +
+	"\aupdates\x18\x01 \x03(\v2\x14.catalog.PriceUpdateR\aupdates\x12%\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x11.catalog.BulkModeR\x04mode\"L\n" +
+	"\x11BulkDeleteRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12%\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x11.catalog.BulkModeR\x04mode\"\xbd\x01\n" +
+	"\x0eBulkItemResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x13.catalog.BulkStatusR\x06status\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12+\n" +
+	"\told_price\x18\x04 \x01(\v2\x0e.catalog.MoneyR\boldPrice\x12+\n" +
+	"\tnew_price\x18\x05 \x01(\v2\x0e.catalog.MoneyR\bnewPrice\"\x8b\x01\n" +
+	"\n" +
+	"BulkReport\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.catalog.BulkItemResultR\aresults\x12\x18\n" +
+	"\aapplied\x18\x02 \x01(\x05R\aapplied\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\x12\x18\n" +
+	"\askipped\x18\x04 \x01(\x05R\askipped*\xa6\x01\n" +
+	"\rProductStatus\x12\x1e\n" +
+	"\x1aPRODUCT_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14PRODUCT_STATUS_DRAFT\x10\x01\x12\x1c\n" +
+	"\x18PRODUCT_STATUS_IN_REVIEW\x10\x02\x12\x1c\n" +
+	"\x18PRODUCT_STATUS_PUBLISHED\x10\x03\x12\x1f\n" +
+	"\x1bPRODUCT_STATUS_DISCONTINUED\x10\x04*V\n" +
+	"\bBulkMode\x12\x19\n" +
+	"\x15BULK_MODE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10BULK_MODE_ATOMIC\x10\x01\x12\x19\n" +
+	"\x15BULK_MODE_BEST_EFFORT\x10\x02*s\n" +
+	"\n" +
+	"BulkStatus\x12\x1b\n" +
+	"\x17BULK_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13BULK_STATUS_APPLIED\x10\x01\x12\x16\n" +
+	"\x12BULK_STATUS_FAILED\x10\x02\x12\x17\n" +
+	"\x13BULK_STATUS_SKIPPED\x10\x032\xa1\x03\n" +
+	"\fGRPCProducts\x12?\n" +
+	"\bBatchGet\x12\x18.catalog.BatchGetRequest\x1a\x19.catalog.BatchGetResponse\x12I\n" +
+	"\x10BulkUpdatePrices\x12 .catalog.BulkUpdatePricesRequest\x1a\x13.catalog.BulkReport\x12=\n" +
+	"\n" +
+	"BulkDelete\x12\x1a.catalog.BulkDeleteRequest\x1a\x13.catalog.BulkReport\x12B\n" +
+	"\tGetBySlug\x12\x19.catalog.GetBySlugRequest\x1a\x1a.catalog.GetBySlugResponse\x12>\n" +
+	"\tSetStatus\x12\x19.catalog.SetStatusRequest\x1a\x16.google.protobuf.Empty\x12B\n" +
+	"\vSetSchedule\x12\x1b.catalog.SetScheduleRequest\x1a\x16.google.protobuf.EmptyB6Z4github.com/glekoz/online-shop_product/pkg/pb/catalogb\x06proto3"
+
+var (
+	file_catalog_product_proto_rawDescOnce sync.Once
+	file_catalog_product_proto_rawDescData []byte
+)
+
+func file_catalog_product_proto_rawDescGZIP() []byte {
+	file_catalog_product_proto_rawDescOnce.Do(func() {
+		file_catalog_product_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_catalog_product_proto_rawDesc), len(file_catalog_product_proto_rawDesc)))
+	})
+	return file_catalog_product_proto_rawDescData
+}
+
+var file_catalog_product_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_catalog_product_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_catalog_product_proto_goTypes = []any{
+	(ProductStatus)(0),              // 0: catalog.ProductStatus
+	(BulkMode)(0),                   // 1: catalog.BulkMode
+	(BulkStatus)(0),                 // 2: catalog.BulkStatus
+	(*Product)(nil),                 // 3: catalog.Product
+	(*GetBySlugRequest)(nil),        // 4: catalog.GetBySlugRequest
+	(*GetBySlugResponse)(nil),       // 5: catalog.GetBySlugResponse
+	(*SetStatusRequest)(nil),        // 6: catalog.SetStatusRequest
+	(*SetScheduleRequest)(nil),      // 7: catalog.SetScheduleRequest
+	(*BatchGetRequest)(nil),         // 8: catalog.BatchGetRequest
+	(*BatchGetResult)(nil),          // 9: catalog.BatchGetResult
+	(*BatchGetResponse)(nil),        // 10: catalog.BatchGetResponse
+	(*PriceUpdate)(nil),             // 11: catalog.PriceUpdate
+	(*BulkUpdatePricesRequest)(nil), // 12: catalog.BulkUpdatePricesRequest
+	(*BulkDeleteRequest)(nil),       // 13: catalog.BulkDeleteRequest
+	(*BulkItemResult)(nil),          // 14: catalog.BulkItemResult
+	(*BulkReport)(nil),              // 15: catalog.BulkReport
+	(*Money)(nil),                   // 16: catalog.Money
+	(*timestamppb.Timestamp)(nil),   // 17: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),           // 18: google.protobuf.Empty
+}
+var file_catalog_product_proto_depIdxs = []int32{
+	16, // 0: catalog.Product.price:type_name -> catalog.Money
+	16, // 1: catalog.Product.effective_price:type_name -> catalog.Money
+	0,  // 2: catalog.Product.status:type_name -> catalog.ProductStatus
+	17, // 3: catalog.Product.publish_at:type_name -> google.protobuf.Timestamp
+	17, // 4: catalog.Product.unpublish_at:type_name -> google.protobuf.Timestamp
+	3,  // 5: catalog.GetBySlugResponse.product:type_name -> catalog.Product
+	0,  // 6: catalog.SetStatusRequest.status:type_name -> catalog.ProductStatus
+	17, // 7: catalog.SetScheduleRequest.publish_at:type_name -> google.protobuf.Timestamp
+	17, // 8: catalog.SetScheduleRequest.unpublish_at:type_name -> google.protobuf.Timestamp
+	3,  // 9: catalog.BatchGetResult.product:type_name -> catalog.Product
+	9,  // 10: catalog.BatchGetResponse.results:type_name -> catalog.BatchGetResult
+	16, // 11: catalog.PriceUpdate.price:type_name -> catalog.Money
+	11, // 12: catalog.BulkUpdatePricesRequest.updates:type_name -> catalog.PriceUpdate
+	1,  // 13: catalog.BulkUpdatePricesRequest.mode:type_name -> catalog.BulkMode
+	1,  // 14: catalog.BulkDeleteRequest.mode:type_name -> catalog.BulkMode
+	2,  // 15: catalog.BulkItemResult.status:type_name -> catalog.BulkStatus
+	16, // 16: catalog.BulkItemResult.old_price:type_name -> catalog.Money
+	16, // 17: catalog.BulkItemResult.new_price:type_name -> catalog.Money
+	14, // 18: catalog.BulkReport.results:type_name -> catalog.BulkItemResult
+	8,  // 19: catalog.GRPCProducts.BatchGet:input_type -> catalog.BatchGetRequest
+	12, // 20: catalog.GRPCProducts.BulkUpdatePrices:input_type -> catalog.BulkUpdatePricesRequest
+	13, // 21: catalog.GRPCProducts.BulkDelete:input_type -> catalog.BulkDeleteRequest
+	4,  // 22: catalog.GRPCProducts.GetBySlug:input_type -> catalog.GetBySlugRequest
+	6,  // 23: catalog.GRPCProducts.SetStatus:input_type -> catalog.SetStatusRequest
+	7,  // 24: catalog.GRPCProducts.SetSchedule:input_type -> catalog.SetScheduleRequest
+	10, // 25: catalog.GRPCProducts.BatchGet:output_type -> catalog.BatchGetResponse
+	15, // 26: catalog.GRPCProducts.BulkUpdatePrices:output_type -> catalog.BulkReport
+	15, // 27: catalog.GRPCProducts.BulkDelete:output_type -> catalog.BulkReport
+	5,  // 28: catalog.GRPCProducts.GetBySlug:output_type -> catalog.GetBySlugResponse
+	18, // 29: catalog.GRPCProducts.SetStatus:output_type -> google.protobuf.Empty
+	18, // 30: catalog.GRPCProducts.SetSchedule:output_type -> google.protobuf.Empty
+	25, // [25:31] is the sub-list for method output_type
+	19, // [19:25] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_catalog_product_proto_init() }
+func file_catalog_product_proto_init() {
+	if File_catalog_product_proto != nil {
+		return
+	}
+	file_catalog_common_proto_init()
+	file_catalog_product_proto_msgTypes[8].OneofWrappers = []any{
+		(*PriceUpdate_Price)(nil),
+		(*PriceUpdate_ChangeBp)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_product_proto_rawDesc), len(file_catalog_product_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_catalog_product_proto_goTypes,
+		DependencyIndexes: file_catalog_product_proto_depIdxs,
+		EnumInfos:         file_catalog_product_proto_enumTypes,
+		MessageInfos:      file_catalog_product_proto_msgTypes,
+	}.Build()
+	File_catalog_product_proto = out.File
+	file_catalog_product_proto_goTypes = nil
+	file_catalog_product_proto_depIdxs = nil
+}
@@ -0,0 +1,334 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: catalog/product.proto
+
+package catalog
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GRPCProducts_BatchGet_FullMethodName         = "/catalog.GRPCProducts/BatchGet"
+	GRPCProducts_BulkUpdatePrices_FullMethodName = "/catalog.GRPCProducts/BulkUpdatePrices"
+	GRPCProducts_BulkDelete_FullMethodName       = "/catalog.GRPCProducts/BulkDelete"
+	GRPCProducts_GetBySlug_FullMethodName        = "/catalog.GRPCProducts/GetBySlug"
+	GRPCProducts_SetStatus_FullMethodName        = "/catalog.GRPCProducts/SetStatus"
+	GRPCProducts_SetSchedule_FullMethodName      = "/catalog.GRPCProducts/SetSchedule"
+)
+
+// GRPCProductsClient is the client API for GRPCProducts service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Операции сразу над многими товарами, которых нет в старом GRPCProduct.
+// BatchGet - для корзины и заказов: один запрос вместо Get на каждую позицию.
+// BulkUpdatePrices и BulkDelete - для администраторов, до 5000 товаров
+// одной транзакцией; итог сообщается по каждому элементу.
+// GetBySlug - для витрины с адресами вида /p/shokoladnyi-ponchik.
+// SetStatus и SetSchedule - жизненный цикл товара: draft -> in_review ->
+// published -> discontinued. Витрина видит только published, администраторы
+// каталога - все.
+type GRPCProductsClient interface {
+	BatchGet(ctx context.Context, in *BatchGetRequest, opts ...grpc.CallOption) (*BatchGetResponse, error)
+	BulkUpdatePrices(ctx context.Context, in *BulkUpdatePricesRequest, opts ...grpc.CallOption) (*BulkReport, error)
+	BulkDelete(ctx context.Context, in *BulkDeleteRequest, opts ...grpc.CallOption) (*BulkReport, error)
+	GetBySlug(ctx context.Context, in *GetBySlugRequest, opts ...grpc.CallOption) (*GetBySlugResponse, error)
+	// недопустимый переход - FAILED_PRECONDITION
+	SetStatus(ctx context.Context, in *SetStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// заменяет расписание целиком; незаданное время отменяет запланированное
+	SetSchedule(ctx context.Context, in *SetScheduleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type gRPCProductsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGRPCProductsClient(cc grpc.ClientConnInterface) GRPCProductsClient {
+	return &gRPCProductsClient{cc}
+}
+
+func (c *gRPCProductsClient) BatchGet(ctx context.Context, in *BatchGetRequest, opts ...grpc.CallOption) (*BatchGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetResponse)
+	err := c.cc.Invoke(ctx, GRPCProducts_BatchGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCProductsClient) BulkUpdatePrices(ctx context.Context, in *BulkUpdatePricesRequest, opts ...grpc.CallOption) (*BulkReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkReport)
+	err := c.cc.Invoke(ctx, GRPCProducts_BulkUpdatePrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCProductsClient) BulkDelete(ctx context.Context, in *BulkDeleteRequest, opts ...grpc.CallOption) (*BulkReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkReport)
+	err := c.cc.Invoke(ctx, GRPCProducts_BulkDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCProductsClient) GetBySlug(ctx context.Context, in *GetBySlugRequest, opts ...grpc.CallOption) (*GetBySlugResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBySlugResponse)
+	err := c.cc.Invoke(ctx, GRPCProducts_GetBySlug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCProductsClient) SetStatus(ctx context.Context, in *SetStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCProducts_SetStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCProductsClient) SetSchedule(ctx context.Context, in *SetScheduleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCProducts_SetSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GRPCProductsServer is the server API for GRPCProducts service.
+// All implementations must embed UnimplementedGRPCProductsServer
+// for forward compatibility.
+//
+// Операции сразу над многими товарами, которых нет в старом GRPCProduct.
+// BatchGet - для корзины и заказов: один запрос вместо Get на каждую позицию.
+// BulkUpdatePrices и BulkDelete - для администраторов, до 5000 товаров
+// одной транзакцией; итог сообщается по каждому элементу.
+// GetBySlug - для витрины с адресами вида /p/shokoladnyi-ponchik.
+// SetStatus и SetSchedule - жизненный цикл товара: draft -> in_review ->
+// published -> discontinued. Витрина видит только published, администраторы
+// каталога - все.
+type GRPCProductsServer interface {
+	BatchGet(context.Context, *BatchGetRequest) (*BatchGetResponse, error)
+	BulkUpdatePrices(context.Context, *BulkUpdatePricesRequest) (*BulkReport, error)
+	BulkDelete(context.Context, *BulkDeleteRequest) (*BulkReport, error)
+	GetBySlug(context.Context, *GetBySlugRequest) (*GetBySlugResponse, error)
+	// недопустимый переход - FAILED_PRECONDITION
+	SetStatus(context.Context, *SetStatusRequest) (*emptypb.Empty, error)
+	// заменяет расписание целиком; незаданное время отменяет запланированное
+	SetSchedule(context.Context, *SetScheduleRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedGRPCProductsServer()
+}
+
+// UnimplementedGRPCProductsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGRPCProductsServer struct{}
+
+func (UnimplementedGRPCProductsServer) BatchGet(context.Context, *BatchGetRequest) (*BatchGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGet not implemented")
+}
+func (UnimplementedGRPCProductsServer) BulkUpdatePrices(context.Context, *BulkUpdatePricesRequest) (*BulkReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdatePrices not implemented")
+}
+func (UnimplementedGRPCProductsServer) BulkDelete(context.Context, *BulkDeleteRequest) (*BulkReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkDelete not implemented")
+}
+func (UnimplementedGRPCProductsServer) GetBySlug(context.Context, *GetBySlugRequest) (*GetBySlugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBySlug not implemented")
+}
+func (UnimplementedGRPCProductsServer) SetStatus(context.Context, *SetStatusRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStatus not implemented")
+}
+func (UnimplementedGRPCProductsServer) SetSchedule(context.Context, *SetScheduleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSchedule not implemented")
+}
+func (UnimplementedGRPCProductsServer) mustEmbedUnimplementedGRPCProductsServer() {}
+func (UnimplementedGRPCProductsServer) testEmbeddedByValue()                      {}
+
+// UnsafeGRPCProductsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GRPCProductsServer will
+// result in compilation errors.
+type UnsafeGRPCProductsServer interface {
+	mustEmbedUnimplementedGRPCProductsServer()
+}
+
+func RegisterGRPCProductsServer(s grpc.ServiceRegistrar, srv GRPCProductsServer) {
+	// If the following call pancis, it indicates UnimplementedGRPCProductsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GRPCProducts_ServiceDesc, srv)
+}
+
+func _GRPCProducts_BatchGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCProductsServer).BatchGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCProducts_BatchGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCProductsServer).BatchGet(ctx, req.(*BatchGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCProducts_BulkUpdatePrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdatePricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCProductsServer).BulkUpdatePrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCProducts_BulkUpdatePrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCProductsServer).BulkUpdatePrices(ctx, req.(*BulkUpdatePricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCProducts_BulkDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCProductsServer).BulkDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCProducts_BulkDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCProductsServer).BulkDelete(ctx, req.(*BulkDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCProducts_GetBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCProductsServer).GetBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCProducts_GetBySlug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCProductsServer).GetBySlug(ctx, req.(*GetBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCProducts_SetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCProductsServer).SetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCProducts_SetStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCProductsServer).SetStatus(ctx, req.(*SetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCProducts_SetSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCProductsServer).SetSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCProducts_SetSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCProductsServer).SetSchedule(ctx, req.(*SetScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GRPCProducts_ServiceDesc is the grpc.ServiceDesc for GRPCProducts service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GRPCProducts_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "catalog.GRPCProducts",
+	HandlerType: (*GRPCProductsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BatchGet",
+			Handler:    _GRPCProducts_BatchGet_Handler,
+		},
+		{
+			MethodName: "BulkUpdatePrices",
+			Handler:    _GRPCProducts_BulkUpdatePrices_Handler,
+		},
+		{
+			MethodName: "BulkDelete",
+			Handler:    _GRPCProducts_BulkDelete_Handler,
+		},
+		{
+			MethodName: "GetBySlug",
+			Handler:    _GRPCProducts_GetBySlug_Handler,
+		},
+		{
+			MethodName: "SetStatus",
+			Handler:    _GRPCProducts_SetStatus_Handler,
+		},
+		{
+			MethodName: "SetSchedule",
+			Handler:    _GRPCProducts_SetSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "catalog/product.proto",
+}
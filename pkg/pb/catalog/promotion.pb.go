@@ -0,0 +1,524 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: catalog/promotion.proto
+
+package catalog
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PromotionKind int32
+
+const (
+	PromotionKind_PROMOTION_KIND_UNSPECIFIED PromotionKind = 0
+	PromotionKind_PROMOTION_KIND_PERCENT     PromotionKind = 1
+	PromotionKind_PROMOTION_KIND_FIXED       PromotionKind = 2
+)
+
+// Enum value maps for PromotionKind.
+var (
+	PromotionKind_name = map[int32]string{
+		0: "PROMOTION_KIND_UNSPECIFIED",
+		1: "PROMOTION_KIND_PERCENT",
+		2: "PROMOTION_KIND_FIXED",
+	}
+	PromotionKind_value = map[string]int32{
+		"PROMOTION_KIND_UNSPECIFIED": 0,
+		"PROMOTION_KIND_PERCENT":     1,
+		"PROMOTION_KIND_FIXED":       2,
+	}
+)
+
+func (x PromotionKind) Enum() *PromotionKind {
+	p := new(PromotionKind)
+	*p = x
+	return p
+}
+
+func (x PromotionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PromotionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_catalog_promotion_proto_enumTypes[0].Descriptor()
+}
+
+func (PromotionKind) Type() protoreflect.EnumType {
+	return &file_catalog_promotion_proto_enumTypes[0]
+}
+
+func (x PromotionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PromotionKind.Descriptor instead.
+func (PromotionKind) EnumDescriptor() ([]byte, []int) {
+	return file_catalog_promotion_proto_rawDescGZIP(), []int{0}
+}
+
+type PromotionTarget int32
+
+const (
+	PromotionTarget_PROMOTION_TARGET_UNSPECIFIED PromotionTarget = 0
+	PromotionTarget_PROMOTION_TARGET_ALL         PromotionTarget = 1
+	PromotionTarget_PROMOTION_TARGET_PRODUCT     PromotionTarget = 2
+	PromotionTarget_PROMOTION_TARGET_CATEGORY    PromotionTarget = 3
+)
+
+// Enum value maps for PromotionTarget.
+var (
+	PromotionTarget_name = map[int32]string{
+		0: "PROMOTION_TARGET_UNSPECIFIED",
+		1: "PROMOTION_TARGET_ALL",
+		2: "PROMOTION_TARGET_PRODUCT",
+		3: "PROMOTION_TARGET_CATEGORY",
+	}
+	PromotionTarget_value = map[string]int32{
+		"PROMOTION_TARGET_UNSPECIFIED": 0,
+		"PROMOTION_TARGET_ALL":         1,
+		"PROMOTION_TARGET_PRODUCT":     2,
+		"PROMOTION_TARGET_CATEGORY":    3,
+	}
+)
+
+func (x PromotionTarget) Enum() *PromotionTarget {
+	p := new(PromotionTarget)
+	*p = x
+	return p
+}
+
+func (x PromotionTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PromotionTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_catalog_promotion_proto_enumTypes[1].Descriptor()
+}
+
+func (PromotionTarget) Type() protoreflect.EnumType {
+	return &file_catalog_promotion_proto_enumTypes[1]
+}
+
+func (x PromotionTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PromotionTarget.Descriptor instead.
+func (PromotionTarget) EnumDescriptor() ([]byte, []int) {
+	return file_catalog_promotion_proto_rawDescGZIP(), []int{1}
+}
+
+type Promotion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          PromotionKind          `protobuf:"varint,3,opt,name=kind,proto3,enum=catalog.PromotionKind" json:"kind,omitempty"`
+	Percent       int64                  `protobuf:"varint,4,opt,name=percent,proto3" json:"percent,omitempty"` // для PERCENT, в сотых долях процента: 1250 = 12.5%
+	Amount        *Money                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`    // для FIXED, в валюте товара
+	Target        PromotionTarget        `protobuf:"varint,6,opt,name=target,proto3,enum=catalog.PromotionTarget" json:"target,omitempty"`
+	TargetId      string                 `protobuf:"bytes,7,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"` // товар или категория
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"` // пусто - без ограничения
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Priority      int32                  `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`
+	Stackable     bool                   `protobuf:"varint,11,opt,name=stackable,proto3" json:"stackable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Promotion) Reset() {
+	*x = Promotion{}
+	mi := &file_catalog_promotion_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Promotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Promotion) ProtoMessage() {}
+
+func (x *Promotion) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_promotion_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Promotion.ProtoReflect.Descriptor instead.
+func (*Promotion) Descriptor() ([]byte, []int) {
+	return file_catalog_promotion_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Promotion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Promotion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Promotion) GetKind() PromotionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PromotionKind_PROMOTION_KIND_UNSPECIFIED
+}
+
+func (x *Promotion) GetPercent() int64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *Promotion) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Promotion) GetTarget() PromotionTarget {
+	if x != nil {
+		return x.Target
+	}
+	return PromotionTarget_PROMOTION_TARGET_UNSPECIFIED
+}
+
+func (x *Promotion) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *Promotion) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *Promotion) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *Promotion) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Promotion) GetStackable() bool {
+	if x != nil {
+		return x.Stackable
+	}
+	return false
+}
+
+type PromotionID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromotionID) Reset() {
+	*x = PromotionID{}
+	mi := &file_catalog_promotion_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionID) ProtoMessage() {}
+
+func (x *PromotionID) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_promotion_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionID.ProtoReflect.Descriptor instead.
+func (*PromotionID) Descriptor() ([]byte, []int) {
+	return file_catalog_promotion_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PromotionID) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PromotionList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotions    []*Promotion           `protobuf:"bytes,1,rep,name=promotions,proto3" json:"promotions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromotionList) Reset() {
+	*x = PromotionList{}
+	mi := &file_catalog_promotion_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionList) ProtoMessage() {}
+
+func (x *PromotionList) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_promotion_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionList.ProtoReflect.Descriptor instead.
+func (*PromotionList) Descriptor() ([]byte, []int) {
+	return file_catalog_promotion_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PromotionList) GetPromotions() []*Promotion {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
+type EffectivePrice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListPrice     *Money                 `protobuf:"bytes,1,opt,name=list_price,json=listPrice,proto3" json:"list_price,omitempty"`
+	Price         *Money                 `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	PromotionIds  []string               `protobuf:"bytes,3,rep,name=promotion_ids,json=promotionIds,proto3" json:"promotion_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EffectivePrice) Reset() {
+	*x = EffectivePrice{}
+	mi := &file_catalog_promotion_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EffectivePrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffectivePrice) ProtoMessage() {}
+
+func (x *EffectivePrice) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_promotion_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EffectivePrice.ProtoReflect.Descriptor instead.
+func (*EffectivePrice) Descriptor() ([]byte, []int) {
+	return file_catalog_promotion_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EffectivePrice) GetListPrice() *Money {
+	if x != nil {
+		return x.ListPrice
+	}
+	return nil
+}
+
+func (x *EffectivePrice) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *EffectivePrice) GetPromotionIds() []string {
+	if x != nil {
+		return x.PromotionIds
+	}
+	return nil
+}
+
+var File_catalog_promotion_proto protoreflect.FileDescriptor
+
+const file_catalog_promotion_proto_rawDesc = "" +
+	"\n" +
+	"\x17catalog/promotion.proto\x12\acatalog\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16catalog/category.proto\x1a\x14catalog/common.proto\"\x94\x03\n" +
+	"\tPromotion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12*\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x16.catalog.PromotionKindR\x04kind\x12\x18\n" +
+	"\apercent\x18\x04 \x01(\x03R\apercent\x12&\n" +
+	"\x06amount\x18\x05 \x01(\v2\x0e.catalog.MoneyR\x06amount\x120\n" +
+	"\x06target\x18\x06 \x01(\x0e2\x18.catalog.PromotionTargetR\x06target\x12\x1b\n" +
+	"\ttarget_id\x18\a \x01(\tR\btargetId\x127\n" +
+	"\tstarts_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x1a\n" +
+	"\bpriority\x18\n" +
+	" \x01(\x05R\bpriority\x12\x1c\n" +
+	"\tstackable\x18\v \x01(\bR\tstackable\"\x1d\n" +
+	"\vPromotionID\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"C\n" +
+	"\rPromotionList\x122\n" +
+	"\n" +
+	"promotions\x18\x01 \x03(\v2\x12.catalog.PromotionR\n" +
+	"promotions\"\x8a\x01\n" +
+	"\x0eEffectivePrice\x12-\n" +
+	"\n" +
+	"list_price\x18\x01 \x01(\v2\x0e.catalog.MoneyR\tlistPrice\x12$\n" +
+	"\x05price\x18\x02 \x01(\v2\x0e.catalog.MoneyR\x05price\x12#\n" +
+	"\rpromotion_ids\x18\x03 \x03(\tR\fpromotionIds*e\n" +
+	"\rPromotionKind\x12\x1e\n" +
+	"\x1aPROMOTION_KIND_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16PROMOTION_KIND_PERCENT\x10\x01\x12\x18\n" +
+	"\x14PROMOTION_KIND_FIXED\x10\x02*\x8a\x01\n" +
+	"\x0fPromotionTarget\x12 \n" +
+	"\x1cPROMOTION_TARGET_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14PROMOTION_TARGET_ALL\x10\x01\x12\x1c\n" +
+	"\x18PROMOTION_TARGET_PRODUCT\x10\x02\x12\x1d\n" +
+	"\x19PROMOTION_TARGET_CATEGORY\x10\x032\xd4\x02\n" +
+	"\rGRPCPromotion\x122\n" +
+	"\x06Create\x12\x12.catalog.Promotion\x1a\x14.catalog.PromotionID\x124\n" +
+	"\x06Update\x12\x12.catalog.Promotion\x1a\x16.google.protobuf.Empty\x126\n" +
+	"\x06Delete\x12\x14.catalog.PromotionID\x1a\x16.google.protobuf.Empty\x12/\n" +
+	"\x03Get\x12\x14.catalog.PromotionID\x1a\x12.catalog.Promotion\x126\n" +
+	"\x04List\x12\x16.google.protobuf.Empty\x1a\x16.catalog.PromotionList\x128\n" +
+	"\bGetPrice\x12\x13.catalog.ProductRef\x1a\x17.catalog.EffectivePriceB6Z4github.com/glekoz/online-shop_product/pkg/pb/catalogb\x06proto3"
+
+var (
+	file_catalog_promotion_proto_rawDescOnce sync.Once
+	file_catalog_promotion_proto_rawDescData []byte
+)
+
+func file_catalog_promotion_proto_rawDescGZIP() []byte {
+	file_catalog_promotion_proto_rawDescOnce.Do(func() {
+		file_catalog_promotion_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_catalog_promotion_proto_rawDesc), len(file_catalog_promotion_proto_rawDesc)))
+	})
+	return file_catalog_promotion_proto_rawDescData
+}
+
+var file_catalog_promotion_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_catalog_promotion_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_catalog_promotion_proto_goTypes = []any{
+	(PromotionKind)(0),            // 0: catalog.PromotionKind
+	(PromotionTarget)(0),          // 1: catalog.PromotionTarget
+	(*Promotion)(nil),             // 2: catalog.Promotion
+	(*PromotionID)(nil),           // 3: catalog.PromotionID
+	(*PromotionList)(nil),         // 4: catalog.PromotionList
+	(*EffectivePrice)(nil),        // 5: catalog.EffectivePrice
+	(*Money)(nil),                 // 6: catalog.Money
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 8: google.protobuf.Empty
+	(*ProductRef)(nil),            // 9: catalog.ProductRef
+}
+var file_catalog_promotion_proto_depIdxs = []int32{
+	0,  // 0: catalog.Promotion.kind:type_name -> catalog.PromotionKind
+	6,  // 1: catalog.Promotion.amount:type_name -> catalog.Money
+	1,  // 2: catalog.Promotion.target:type_name -> catalog.PromotionTarget
+	7,  // 3: catalog.Promotion.starts_at:type_name -> google.protobuf.Timestamp
+	7,  // 4: catalog.Promotion.ends_at:type_name -> google.protobuf.Timestamp
+	2,  // 5: catalog.PromotionList.promotions:type_name -> catalog.Promotion
+	6,  // 6: catalog.EffectivePrice.list_price:type_name -> catalog.Money
+	6,  // 7: catalog.EffectivePrice.price:type_name -> catalog.Money
+	2,  // 8: catalog.GRPCPromotion.Create:input_type -> catalog.Promotion
+	2,  // 9: catalog.GRPCPromotion.Update:input_type -> catalog.Promotion
+	3,  // 10: catalog.GRPCPromotion.Delete:input_type -> catalog.PromotionID
+	3,  // 11: catalog.GRPCPromotion.Get:input_type -> catalog.PromotionID
+	8,  // 12: catalog.GRPCPromotion.List:input_type -> google.protobuf.Empty
+	9,  // 13: catalog.GRPCPromotion.GetPrice:input_type -> catalog.ProductRef
+	3,  // 14: catalog.GRPCPromotion.Create:output_type -> catalog.PromotionID
+	8,  // 15: catalog.GRPCPromotion.Update:output_type -> google.protobuf.Empty
+	8,  // 16: catalog.GRPCPromotion.Delete:output_type -> google.protobuf.Empty
+	2,  // 17: catalog.GRPCPromotion.Get:output_type -> catalog.Promotion
+	4,  // 18: catalog.GRPCPromotion.List:output_type -> catalog.PromotionList
+	5,  // 19: catalog.GRPCPromotion.GetPrice:output_type -> catalog.EffectivePrice
+	14, // [14:20] is the sub-list for method output_type
+	8,  // [8:14] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_catalog_promotion_proto_init() }
+func file_catalog_promotion_proto_init() {
+	if File_catalog_promotion_proto != nil {
+		return
+	}
+	file_catalog_category_proto_init()
+	file_catalog_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_promotion_proto_rawDesc), len(file_catalog_promotion_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_catalog_promotion_proto_goTypes,
+		DependencyIndexes: file_catalog_promotion_proto_depIdxs,
+		EnumInfos:         file_catalog_promotion_proto_enumTypes,
+		MessageInfos:      file_catalog_promotion_proto_msgTypes,
+	}.Build()
+	File_catalog_promotion_proto = out.File
+	file_catalog_promotion_proto_goTypes = nil
+	file_catalog_promotion_proto_depIdxs = nil
+}
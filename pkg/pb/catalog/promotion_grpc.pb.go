@@ -0,0 +1,322 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: catalog/promotion.proto
+
+package catalog
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GRPCPromotion_Create_FullMethodName   = "/catalog.GRPCPromotion/Create"
+	GRPCPromotion_Update_FullMethodName   = "/catalog.GRPCPromotion/Update"
+	GRPCPromotion_Delete_FullMethodName   = "/catalog.GRPCPromotion/Delete"
+	GRPCPromotion_Get_FullMethodName      = "/catalog.GRPCPromotion/Get"
+	GRPCPromotion_List_FullMethodName     = "/catalog.GRPCPromotion/List"
+	GRPCPromotion_GetPrice_FullMethodName = "/catalog.GRPCPromotion/GetPrice"
+)
+
+// GRPCPromotionClient is the client API for GRPCPromotion service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Акции: процентные и фиксированные скидки на товар, категорию (с потомками)
+// или весь каталог. Акции применяются по убыванию приоритета; первая применяется
+// всегда, следующие - только если и она, и они совместимые (stackable).
+type GRPCPromotionClient interface {
+	Create(ctx context.Context, in *Promotion, opts ...grpc.CallOption) (*PromotionID, error)
+	Update(ctx context.Context, in *Promotion, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Delete(ctx context.Context, in *PromotionID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Get(ctx context.Context, in *PromotionID, opts ...grpc.CallOption) (*Promotion, error)
+	List(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PromotionList, error)
+	// GetPrice - цена товара по прайсу и с учетом действующих акций
+	GetPrice(ctx context.Context, in *ProductRef, opts ...grpc.CallOption) (*EffectivePrice, error)
+}
+
+type gRPCPromotionClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGRPCPromotionClient(cc grpc.ClientConnInterface) GRPCPromotionClient {
+	return &gRPCPromotionClient{cc}
+}
+
+func (c *gRPCPromotionClient) Create(ctx context.Context, in *Promotion, opts ...grpc.CallOption) (*PromotionID, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromotionID)
+	err := c.cc.Invoke(ctx, GRPCPromotion_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCPromotionClient) Update(ctx context.Context, in *Promotion, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCPromotion_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCPromotionClient) Delete(ctx context.Context, in *PromotionID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCPromotion_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCPromotionClient) Get(ctx context.Context, in *PromotionID, opts ...grpc.CallOption) (*Promotion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Promotion)
+	err := c.cc.Invoke(ctx, GRPCPromotion_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCPromotionClient) List(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PromotionList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromotionList)
+	err := c.cc.Invoke(ctx, GRPCPromotion_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCPromotionClient) GetPrice(ctx context.Context, in *ProductRef, opts ...grpc.CallOption) (*EffectivePrice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EffectivePrice)
+	err := c.cc.Invoke(ctx, GRPCPromotion_GetPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GRPCPromotionServer is the server API for GRPCPromotion service.
+// All implementations must embed UnimplementedGRPCPromotionServer
+// for forward compatibility.
+//
+// Акции: процентные и фиксированные скидки на товар, категорию (с потомками)
+// или весь каталог. Акции применяются по убыванию приоритета; первая применяется
+// всегда, следующие - только если и она, и они совместимые (stackable).
+type GRPCPromotionServer interface {
+	Create(context.Context, *Promotion) (*PromotionID, error)
+	Update(context.Context, *Promotion) (*emptypb.Empty, error)
+	Delete(context.Context, *PromotionID) (*emptypb.Empty, error)
+	Get(context.Context, *PromotionID) (*Promotion, error)
+	List(context.Context, *emptypb.Empty) (*PromotionList, error)
+	// GetPrice - цена товара по прайсу и с учетом действующих акций
+	GetPrice(context.Context, *ProductRef) (*EffectivePrice, error)
+	mustEmbedUnimplementedGRPCPromotionServer()
+}
+
+// UnimplementedGRPCPromotionServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGRPCPromotionServer struct{}
+
+func (UnimplementedGRPCPromotionServer) Create(context.Context, *Promotion) (*PromotionID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedGRPCPromotionServer) Update(context.Context, *Promotion) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedGRPCPromotionServer) Delete(context.Context, *PromotionID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedGRPCPromotionServer) Get(context.Context, *PromotionID) (*Promotion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedGRPCPromotionServer) List(context.Context, *emptypb.Empty) (*PromotionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedGRPCPromotionServer) GetPrice(context.Context, *ProductRef) (*EffectivePrice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrice not implemented")
+}
+func (UnimplementedGRPCPromotionServer) mustEmbedUnimplementedGRPCPromotionServer() {}
+func (UnimplementedGRPCPromotionServer) testEmbeddedByValue()                       {}
+
+// UnsafeGRPCPromotionServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GRPCPromotionServer will
+// result in compilation errors.
+type UnsafeGRPCPromotionServer interface {
+	mustEmbedUnimplementedGRPCPromotionServer()
+}
+
+func RegisterGRPCPromotionServer(s grpc.ServiceRegistrar, srv GRPCPromotionServer) {
+	// If the following call pancis, it indicates UnimplementedGRPCPromotionServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GRPCPromotion_ServiceDesc, srv)
+}
+
+func _GRPCPromotion_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Promotion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCPromotionServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCPromotion_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCPromotionServer).Create(ctx, req.(*Promotion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCPromotion_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Promotion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCPromotionServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCPromotion_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCPromotionServer).Update(ctx, req.(*Promotion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCPromotion_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromotionID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCPromotionServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCPromotion_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCPromotionServer).Delete(ctx, req.(*PromotionID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCPromotion_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromotionID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCPromotionServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCPromotion_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCPromotionServer).Get(ctx, req.(*PromotionID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCPromotion_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCPromotionServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCPromotion_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCPromotionServer).List(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCPromotion_GetPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCPromotionServer).GetPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCPromotion_GetPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCPromotionServer).GetPrice(ctx, req.(*ProductRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GRPCPromotion_ServiceDesc is the grpc.ServiceDesc for GRPCPromotion service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GRPCPromotion_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "catalog.GRPCPromotion",
+	HandlerType: (*GRPCPromotionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _GRPCPromotion_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _GRPCPromotion_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _GRPCPromotion_Delete_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _GRPCPromotion_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _GRPCPromotion_List_Handler,
+		},
+		{
+			MethodName: "GetPrice",
+			Handler:    _GRPCPromotion_GetPrice_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "catalog/promotion.proto",
+}
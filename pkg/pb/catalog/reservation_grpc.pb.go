@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: catalog/reservation.proto
+
+package catalog
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GRPCReservation_Reserve_FullMethodName = "/catalog.GRPCReservation/Reserve"
+	GRPCReservation_Commit_FullMethodName  = "/catalog.GRPCReservation/Commit"
+	GRPCReservation_Release_FullMethodName = "/catalog.GRPCReservation/Release"
+	GRPCReservation_Get_FullMethodName     = "/catalog.GRPCReservation/Get"
+)
+
+// GRPCReservationClient is the client API for GRPCReservation service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Резервы удерживают товар, пока покупатель оплачивает заказ. reservation_id
+// выдает сервис заказов; все методы идемпотентны, их можно повторять.
+type GRPCReservationClient interface {
+	// Reserve резервирует все позиции или ни одной (FAILED_PRECONDITION, если не хватает)
+	Reserve(ctx context.Context, in *ReserveRequest, opts ...grpc.CallOption) (*Reservation, error)
+	// Commit списывает товар со склада
+	Commit(ctx context.Context, in *ReservationID, opts ...grpc.CallOption) (*Reservation, error)
+	// Release возвращает товар в свободный остаток
+	Release(ctx context.Context, in *ReservationID, opts ...grpc.CallOption) (*Reservation, error)
+	Get(ctx context.Context, in *ReservationID, opts ...grpc.CallOption) (*Reservation, error)
+}
+
+type gRPCReservationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGRPCReservationClient(cc grpc.ClientConnInterface) GRPCReservationClient {
+	return &gRPCReservationClient{cc}
+}
+
+func (c *gRPCReservationClient) Reserve(ctx context.Context, in *ReserveRequest, opts ...grpc.CallOption) (*Reservation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reservation)
+	err := c.cc.Invoke(ctx, GRPCReservation_Reserve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCReservationClient) Commit(ctx context.Context, in *ReservationID, opts ...grpc.CallOption) (*Reservation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reservation)
+	err := c.cc.Invoke(ctx, GRPCReservation_Commit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCReservationClient) Release(ctx context.Context, in *ReservationID, opts ...grpc.CallOption) (*Reservation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reservation)
+	err := c.cc.Invoke(ctx, GRPCReservation_Release_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCReservationClient) Get(ctx context.Context, in *ReservationID, opts ...grpc.CallOption) (*Reservation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reservation)
+	err := c.cc.Invoke(ctx, GRPCReservation_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GRPCReservationServer is the server API for GRPCReservation service.
+// All implementations must embed UnimplementedGRPCReservationServer
+// for forward compatibility.
+//
+// Резервы удерживают товар, пока покупатель оплачивает заказ. reservation_id
+// выдает сервис заказов; все методы идемпотентны, их можно повторять.
+type GRPCReservationServer interface {
+	// Reserve резервирует все позиции или ни одной (FAILED_PRECONDITION, если не хватает)
+	Reserve(context.Context, *ReserveRequest) (*Reservation, error)
+	// Commit списывает товар со склада
+	Commit(context.Context, *ReservationID) (*Reservation, error)
+	// Release возвращает товар в свободный остаток
+	Release(context.Context, *ReservationID) (*Reservation, error)
+	Get(context.Context, *ReservationID) (*Reservation, error)
+	mustEmbedUnimplementedGRPCReservationServer()
+}
+
+// UnimplementedGRPCReservationServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGRPCReservationServer struct{}
+
+func (UnimplementedGRPCReservationServer) Reserve(context.Context, *ReserveRequest) (*Reservation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reserve not implemented")
+}
+func (UnimplementedGRPCReservationServer) Commit(context.Context, *ReservationID) (*Reservation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Commit not implemented")
+}
+func (UnimplementedGRPCReservationServer) Release(context.Context, *ReservationID) (*Reservation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Release not implemented")
+}
+func (UnimplementedGRPCReservationServer) Get(context.Context, *ReservationID) (*Reservation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedGRPCReservationServer) mustEmbedUnimplementedGRPCReservationServer() {}
+func (UnimplementedGRPCReservationServer) testEmbeddedByValue()                         {}
+
+// UnsafeGRPCReservationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GRPCReservationServer will
+// result in compilation errors.
+type UnsafeGRPCReservationServer interface {
+	mustEmbedUnimplementedGRPCReservationServer()
+}
+
+func RegisterGRPCReservationServer(s grpc.ServiceRegistrar, srv GRPCReservationServer) {
+	// If the following call pancis, it indicates UnimplementedGRPCReservationServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GRPCReservation_ServiceDesc, srv)
+}
+
+func _GRPCReservation_Reserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCReservationServer).Reserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCReservation_Reserve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCReservationServer).Reserve(ctx, req.(*ReserveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCReservation_Commit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReservationID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCReservationServer).Commit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCReservation_Commit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCReservationServer).Commit(ctx, req.(*ReservationID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCReservation_Release_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReservationID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCReservationServer).Release(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCReservation_Release_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCReservationServer).Release(ctx, req.(*ReservationID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCReservation_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReservationID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCReservationServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCReservation_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCReservationServer).Get(ctx, req.(*ReservationID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GRPCReservation_ServiceDesc is the grpc.ServiceDesc for GRPCReservation service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GRPCReservation_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "catalog.GRPCReservation",
+	HandlerType: (*GRPCReservationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Reserve",
+			Handler:    _GRPCReservation_Reserve_Handler,
+		},
+		{
+			MethodName: "Commit",
+			Handler:    _GRPCReservation_Commit_Handler,
+		},
+		{
+			MethodName: "Release",
+			Handler:    _GRPCReservation_Release_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _GRPCReservation_Get_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "catalog/reservation.proto",
+}
@@ -0,0 +1,593 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: catalog/search.proto
+
+package catalog
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// без prefix - синтаксис веб-поиска: "точная фраза", or, -исключить
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// поиск по мере ввода: последнее слово ищется как префикс
+	Prefix bool `protobuf:"varint,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// словарь для разбора запроса (russian, english, simple, ...), пусто - язык сервиса
+	Language string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	// диапазон цены по прайсу, в минорных единицах currency
+	MinPrice *int64 `protobuf:"varint,4,opt,name=min_price,json=minPrice,proto3,oneof" json:"min_price,omitempty"`
+	MaxPrice *int64 `protobuf:"varint,5,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`
+	// только товары в этой валюте; с диапазоном цен по умолчанию RUB
+	Currency      string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	Limit         int32  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32  `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_catalog_search_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_search_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetPrefix() bool {
+	if x != nil {
+		return x.Prefix
+	}
+	return false
+}
+
+func (x *SearchRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetMinPrice() int64 {
+	if x != nil && x.MinPrice != nil {
+		return *x.MinPrice
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetMaxPrice() int64 {
+	if x != nil && x.MaxPrice != nil {
+		return *x.MaxPrice
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type FuzzySearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Query string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// минимальное сходство от 0 до 1, 0 - порог сервиса
+	Threshold     float32 `protobuf:"fixed32,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Limit         int32   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32   `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FuzzySearchRequest) Reset() {
+	*x = FuzzySearchRequest{}
+	mi := &file_catalog_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuzzySearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuzzySearchRequest) ProtoMessage() {}
+
+func (x *FuzzySearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuzzySearchRequest.ProtoReflect.Descriptor instead.
+func (*FuzzySearchRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FuzzySearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *FuzzySearchRequest) GetThreshold() float32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *FuzzySearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FuzzySearchRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SuggestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // 0 - 5, не больше 20
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestRequest) Reset() {
+	*x = SuggestRequest{}
+	mi := &file_catalog_search_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestRequest) ProtoMessage() {}
+
+func (x *SuggestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_search_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestRequest.ProtoReflect.Descriptor instead.
+func (*SuggestRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_search_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SuggestRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SuggestRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type Suggestions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Names         []string               `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Suggestions) Reset() {
+	*x = Suggestions{}
+	mi := &file_catalog_search_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Suggestions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestions) ProtoMessage() {}
+
+func (x *Suggestions) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_search_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestions.ProtoReflect.Descriptor instead.
+func (*Suggestions) Descriptor() ([]byte, []int) {
+	return file_catalog_search_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Suggestions) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type SearchHit struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Product *ProductDigest         `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	// ts_rank в Search, сходство от 0 до 1 в Fuzzy
+	Rank float32 `protobuf:"fixed32,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// совпадения обернуты в <mark></mark>
+	NameHighlight string `protobuf:"bytes,3,opt,name=name_highlight,json=nameHighlight,proto3" json:"name_highlight,omitempty"`
+	Snippet       string `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"` // фрагменты описания
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_catalog_search_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_search_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_catalog_search_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchHit) GetProduct() *ProductDigest {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *SearchHit) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SearchHit) GetNameHighlight() string {
+	if x != nil {
+		return x.NameHighlight
+	}
+	return ""
+}
+
+func (x *SearchHit) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+type SearchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Hits  []*SearchHit           `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	Total int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // всего найдено
+	// похожие названия товаров, если Search ничего не нашел
+	DidYouMean    []string `protobuf:"bytes,3,rep,name=did_you_mean,json=didYouMean,proto3" json:"did_you_mean,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_catalog_search_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_search_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_search_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchResponse) GetDidYouMean() []string {
+	if x != nil {
+		return x.DidYouMean
+	}
+	return nil
+}
+
+type ProductLanguage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Language      string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductLanguage) Reset() {
+	*x = ProductLanguage{}
+	mi := &file_catalog_search_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductLanguage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductLanguage) ProtoMessage() {}
+
+func (x *ProductLanguage) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_search_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductLanguage.ProtoReflect.Descriptor instead.
+func (*ProductLanguage) Descriptor() ([]byte, []int) {
+	return file_catalog_search_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProductLanguage) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductLanguage) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+var File_catalog_search_proto protoreflect.FileDescriptor
+
+const file_catalog_search_proto_rawDesc = "" +
+	"\n" +
+	"\x14catalog/search.proto\x12\acatalog\x1a\x1bgoogle/protobuf/empty.proto\x1a\x14catalog/common.proto\"\x83\x02\n" +
+	"\rSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\bR\x06prefix\x12\x1a\n" +
+	"\blanguage\x18\x03 \x01(\tR\blanguage\x12 \n" +
+	"\tmin_price\x18\x04 \x01(\x03H\x00R\bminPrice\x88\x01\x01\x12 \n" +
+	"\tmax_price\x18\x05 \x01(\x03H\x01R\bmaxPrice\x88\x01\x01\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\b \x01(\x05R\x06offsetB\f\n" +
+	"\n" +
+	"_min_priceB\f\n" +
+	"\n" +
+	"_max_price\"v\n" +
+	"\x12FuzzySearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1c\n" +
+	"\tthreshold\x18\x02 \x01(\x02R\tthreshold\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"<\n" +
+	"\x0eSuggestRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"#\n" +
+	"\vSuggestions\x12\x14\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\"\x92\x01\n" +
+	"\tSearchHit\x120\n" +
+	"\aproduct\x18\x01 \x01(\v2\x16.catalog.ProductDigestR\aproduct\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x02R\x04rank\x12%\n" +
+	"\x0ename_highlight\x18\x03 \x01(\tR\rnameHighlight\x12\x18\n" +
+	"\asnippet\x18\x04 \x01(\tR\asnippet\"p\n" +
+	"\x0eSearchResponse\x12&\n" +
+	"\x04hits\x18\x01 \x03(\v2\x12.catalog.SearchHitR\x04hits\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12 \n" +
+	"\fdid_you_mean\x18\x03 \x03(\tR\n" +
+	"didYouMean\"L\n" +
+	"\x0fProductLanguage\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage2\x88\x02\n" +
+	"\n" +
+	"GRPCSearch\x129\n" +
+	"\x06Search\x12\x16.catalog.SearchRequest\x1a\x17.catalog.SearchResponse\x12=\n" +
+	"\x05Fuzzy\x12\x1b.catalog.FuzzySearchRequest\x1a\x17.catalog.SearchResponse\x128\n" +
+	"\aSuggest\x12\x17.catalog.SuggestRequest\x1a\x14.catalog.Suggestions\x12F\n" +
+	"\x12SetProductLanguage\x12\x18.catalog.ProductLanguage\x1a\x16.google.protobuf.EmptyB6Z4github.com/glekoz/online-shop_product/pkg/pb/catalogb\x06proto3"
+
+var (
+	file_catalog_search_proto_rawDescOnce sync.Once
+	file_catalog_search_proto_rawDescData []byte
+)
+
+func file_catalog_search_proto_rawDescGZIP() []byte {
+	file_catalog_search_proto_rawDescOnce.Do(func() {
+		file_catalog_search_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_catalog_search_proto_rawDesc), len(file_catalog_search_proto_rawDesc)))
+	})
+	return file_catalog_search_proto_rawDescData
+}
+
+var file_catalog_search_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_catalog_search_proto_goTypes = []any{
+	(*SearchRequest)(nil),      // 0: catalog.SearchRequest
+	(*FuzzySearchRequest)(nil), // 1: catalog.FuzzySearchRequest
+	(*SuggestRequest)(nil),     // 2: catalog.SuggestRequest
+	(*Suggestions)(nil),        // 3: catalog.Suggestions
+	(*SearchHit)(nil),          // 4: catalog.SearchHit
+	(*SearchResponse)(nil),     // 5: catalog.SearchResponse
+	(*ProductLanguage)(nil),    // 6: catalog.ProductLanguage
+	(*ProductDigest)(nil),      // 7: catalog.ProductDigest
+	(*emptypb.Empty)(nil),      // 8: google.protobuf.Empty
+}
+var file_catalog_search_proto_depIdxs = []int32{
+	7, // 0: catalog.SearchHit.product:type_name -> catalog.ProductDigest
+	4, // 1: catalog.SearchResponse.hits:type_name -> catalog.SearchHit
+	0, // 2: catalog.GRPCSearch.Search:input_type -> catalog.SearchRequest
+	1, // 3: catalog.GRPCSearch.Fuzzy:input_type -> catalog.FuzzySearchRequest
+	2, // 4: catalog.GRPCSearch.Suggest:input_type -> catalog.SuggestRequest
+	6, // 5: catalog.GRPCSearch.SetProductLanguage:input_type -> catalog.ProductLanguage
+	5, // 6: catalog.GRPCSearch.Search:output_type -> catalog.SearchResponse
+	5, // 7: catalog.GRPCSearch.Fuzzy:output_type -> catalog.SearchResponse
+	3, // 8: catalog.GRPCSearch.Suggest:output_type -> catalog.Suggestions
+	8, // 9: catalog.GRPCSearch.SetProductLanguage:output_type -> google.protobuf.Empty
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_catalog_search_proto_init() }
+func file_catalog_search_proto_init() {
+	if File_catalog_search_proto != nil {
+		return
+	}
+	file_catalog_common_proto_init()
+	file_catalog_search_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_search_proto_rawDesc), len(file_catalog_search_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_catalog_search_proto_goTypes,
+		DependencyIndexes: file_catalog_search_proto_depIdxs,
+		MessageInfos:      file_catalog_search_proto_msgTypes,
+	}.Build()
+	File_catalog_search_proto = out.File
+	file_catalog_search_proto_goTypes = nil
+	file_catalog_search_proto_depIdxs = nil
+}
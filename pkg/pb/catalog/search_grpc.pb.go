@@ -0,0 +1,248 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: catalog/search.proto
+
+package catalog
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GRPCSearch_Search_FullMethodName             = "/catalog.GRPCSearch/Search"
+	GRPCSearch_Fuzzy_FullMethodName              = "/catalog.GRPCSearch/Fuzzy"
+	GRPCSearch_Suggest_FullMethodName            = "/catalog.GRPCSearch/Suggest"
+	GRPCSearch_SetProductLanguage_FullMethodName = "/catalog.GRPCSearch/SetProductLanguage"
+)
+
+// GRPCSearchClient is the client API for GRPCSearch service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Полнотекстовый поиск по названию и описанию товара. Совпадение в названии
+// весит больше, чем в описании.
+type GRPCSearchClient interface {
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// Fuzzy ищет по названию с опечатками: "donutt" найдет "Glazed Donut"
+	Fuzzy(ctx context.Context, in *FuzzySearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// Suggest - "возможно, вы имели в виду": похожие названия товаров
+	Suggest(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*Suggestions, error)
+	// SetProductLanguage - словарь Postgres, по которому индексируется товар
+	SetProductLanguage(ctx context.Context, in *ProductLanguage, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type gRPCSearchClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGRPCSearchClient(cc grpc.ClientConnInterface) GRPCSearchClient {
+	return &gRPCSearchClient{cc}
+}
+
+func (c *gRPCSearchClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, GRPCSearch_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCSearchClient) Fuzzy(ctx context.Context, in *FuzzySearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, GRPCSearch_Fuzzy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCSearchClient) Suggest(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*Suggestions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Suggestions)
+	err := c.cc.Invoke(ctx, GRPCSearch_Suggest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCSearchClient) SetProductLanguage(ctx context.Context, in *ProductLanguage, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCSearch_SetProductLanguage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GRPCSearchServer is the server API for GRPCSearch service.
+// All implementations must embed UnimplementedGRPCSearchServer
+// for forward compatibility.
+//
+// Полнотекстовый поиск по названию и описанию товара. Совпадение в названии
+// весит больше, чем в описании.
+type GRPCSearchServer interface {
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// Fuzzy ищет по названию с опечатками: "donutt" найдет "Glazed Donut"
+	Fuzzy(context.Context, *FuzzySearchRequest) (*SearchResponse, error)
+	// Suggest - "возможно, вы имели в виду": похожие названия товаров
+	Suggest(context.Context, *SuggestRequest) (*Suggestions, error)
+	// SetProductLanguage - словарь Postgres, по которому индексируется товар
+	SetProductLanguage(context.Context, *ProductLanguage) (*emptypb.Empty, error)
+	mustEmbedUnimplementedGRPCSearchServer()
+}
+
+// UnimplementedGRPCSearchServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGRPCSearchServer struct{}
+
+func (UnimplementedGRPCSearchServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedGRPCSearchServer) Fuzzy(context.Context, *FuzzySearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fuzzy not implemented")
+}
+func (UnimplementedGRPCSearchServer) Suggest(context.Context, *SuggestRequest) (*Suggestions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Suggest not implemented")
+}
+func (UnimplementedGRPCSearchServer) SetProductLanguage(context.Context, *ProductLanguage) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProductLanguage not implemented")
+}
+func (UnimplementedGRPCSearchServer) mustEmbedUnimplementedGRPCSearchServer() {}
+func (UnimplementedGRPCSearchServer) testEmbeddedByValue()                    {}
+
+// UnsafeGRPCSearchServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GRPCSearchServer will
+// result in compilation errors.
+type UnsafeGRPCSearchServer interface {
+	mustEmbedUnimplementedGRPCSearchServer()
+}
+
+func RegisterGRPCSearchServer(s grpc.ServiceRegistrar, srv GRPCSearchServer) {
+	// If the following call pancis, it indicates UnimplementedGRPCSearchServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GRPCSearch_ServiceDesc, srv)
+}
+
+func _GRPCSearch_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCSearchServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCSearch_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCSearchServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCSearch_Fuzzy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FuzzySearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCSearchServer).Fuzzy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCSearch_Fuzzy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCSearchServer).Fuzzy(ctx, req.(*FuzzySearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCSearch_Suggest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCSearchServer).Suggest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCSearch_Suggest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCSearchServer).Suggest(ctx, req.(*SuggestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCSearch_SetProductLanguage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductLanguage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCSearchServer).SetProductLanguage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCSearch_SetProductLanguage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCSearchServer).SetProductLanguage(ctx, req.(*ProductLanguage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GRPCSearch_ServiceDesc is the grpc.ServiceDesc for GRPCSearch service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GRPCSearch_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "catalog.GRPCSearch",
+	HandlerType: (*GRPCSearchServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Search",
+			Handler:    _GRPCSearch_Search_Handler,
+		},
+		{
+			MethodName: "Fuzzy",
+			Handler:    _GRPCSearch_Fuzzy_Handler,
+		},
+		{
+			MethodName: "Suggest",
+			Handler:    _GRPCSearch_Suggest_Handler,
+		},
+		{
+			MethodName: "SetProductLanguage",
+			Handler:    _GRPCSearch_SetProductLanguage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "catalog/search.proto",
+}
@@ -0,0 +1,548 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: catalog/translation.proto
+
+package catalog
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Translation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locale        string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"` // en, en-US, sr-Latn-RS
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // только в ответах
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Translation) Reset() {
+	*x = Translation{}
+	mi := &file_catalog_translation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Translation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Translation) ProtoMessage() {}
+
+func (x *Translation) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_translation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Translation.ProtoReflect.Descriptor instead.
+func (*Translation) Descriptor() ([]byte, []int) {
+	return file_catalog_translation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Translation) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Translation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Translation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Translation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetTranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Translation   *Translation           `protobuf:"bytes,2,opt,name=translation,proto3" json:"translation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTranslationRequest) Reset() {
+	*x = SetTranslationRequest{}
+	mi := &file_catalog_translation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTranslationRequest) ProtoMessage() {}
+
+func (x *SetTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_translation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTranslationRequest.ProtoReflect.Descriptor instead.
+func (*SetTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_translation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetTranslationRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetTranslationRequest) GetTranslation() *Translation {
+	if x != nil {
+		return x.Translation
+	}
+	return nil
+}
+
+type DeleteTranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTranslationRequest) Reset() {
+	*x = DeleteTranslationRequest{}
+	mi := &file_catalog_translation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTranslationRequest) ProtoMessage() {}
+
+func (x *DeleteTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_translation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTranslationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_translation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeleteTranslationRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DeleteTranslationRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type ListTranslationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTranslationsRequest) Reset() {
+	*x = ListTranslationsRequest{}
+	mi := &file_catalog_translation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTranslationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTranslationsRequest) ProtoMessage() {}
+
+func (x *ListTranslationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_translation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTranslationsRequest.ProtoReflect.Descriptor instead.
+func (*ListTranslationsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_translation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListTranslationsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type Translations struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Translations  []*Translation         `protobuf:"bytes,1,rep,name=translations,proto3" json:"translations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Translations) Reset() {
+	*x = Translations{}
+	mi := &file_catalog_translation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Translations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Translations) ProtoMessage() {}
+
+func (x *Translations) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_translation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Translations.ProtoReflect.Descriptor instead.
+func (*Translations) Descriptor() ([]byte, []int) {
+	return file_catalog_translation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Translations) GetTranslations() []*Translation {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
+type ListMissingTranslationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locale        string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMissingTranslationsRequest) Reset() {
+	*x = ListMissingTranslationsRequest{}
+	mi := &file_catalog_translation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMissingTranslationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMissingTranslationsRequest) ProtoMessage() {}
+
+func (x *ListMissingTranslationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_translation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMissingTranslationsRequest.ProtoReflect.Descriptor instead.
+func (*ListMissingTranslationsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_translation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListMissingTranslationsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *ListMissingTranslationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListMissingTranslationsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type UntranslatedProduct struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // на языке по умолчанию
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UntranslatedProduct) Reset() {
+	*x = UntranslatedProduct{}
+	mi := &file_catalog_translation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UntranslatedProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UntranslatedProduct) ProtoMessage() {}
+
+func (x *UntranslatedProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_translation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UntranslatedProduct.ProtoReflect.Descriptor instead.
+func (*UntranslatedProduct) Descriptor() ([]byte, []int) {
+	return file_catalog_translation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UntranslatedProduct) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UntranslatedProduct) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UntranslatedProducts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*UntranslatedProduct `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UntranslatedProducts) Reset() {
+	*x = UntranslatedProducts{}
+	mi := &file_catalog_translation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UntranslatedProducts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UntranslatedProducts) ProtoMessage() {}
+
+func (x *UntranslatedProducts) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_translation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UntranslatedProducts.ProtoReflect.Descriptor instead.
+func (*UntranslatedProducts) Descriptor() ([]byte, []int) {
+	return file_catalog_translation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UntranslatedProducts) GetProducts() []*UntranslatedProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+var File_catalog_translation_proto protoreflect.FileDescriptor
+
+const file_catalog_translation_proto_rawDesc = "" +
+	"\n" +
+	"\x19catalog/translation.proto\x12\acatalog\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x96\x01\n" +
+	"\vTranslation\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"n\n" +
+	"\x15SetTranslationRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x126\n" +
+	"\vtranslation\x18\x02 \x01(\v2\x14.catalog.TranslationR\vtranslation\"Q\n" +
+	"\x18DeleteTranslationRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\"8\n" +
+	"\x17ListTranslationsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"H\n" +
+	"\fTranslations\x128\n" +
+	"\ftranslations\x18\x01 \x03(\v2\x14.catalog.TranslationR\ftranslations\"f\n" +
+	"\x1eListMissingTranslationsRequest\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"9\n" +
+	"\x13UntranslatedProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"P\n" +
+	"\x14UntranslatedProducts\x128\n" +
+	"\bproducts\x18\x01 \x03(\v2\x1c.catalog.UntranslatedProductR\bproducts2\xad\x02\n" +
+	"\x0fGRPCTranslation\x12=\n" +
+	"\x03Set\x12\x1e.catalog.SetTranslationRequest\x1a\x16.google.protobuf.Empty\x12C\n" +
+	"\x06Delete\x12!.catalog.DeleteTranslationRequest\x1a\x16.google.protobuf.Empty\x12?\n" +
+	"\x04List\x12 .catalog.ListTranslationsRequest\x1a\x15.catalog.Translations\x12U\n" +
+	"\vListMissing\x12'.catalog.ListMissingTranslationsRequest\x1a\x1d.catalog.UntranslatedProductsB6Z4github.com/glekoz/online-shop_product/pkg/pb/catalogb\x06proto3"
+
+var (
+	file_catalog_translation_proto_rawDescOnce sync.Once
+	file_catalog_translation_proto_rawDescData []byte
+)
+
+func file_catalog_translation_proto_rawDescGZIP() []byte {
+	file_catalog_translation_proto_rawDescOnce.Do(func() {
+		file_catalog_translation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_catalog_translation_proto_rawDesc), len(file_catalog_translation_proto_rawDesc)))
+	})
+	return file_catalog_translation_proto_rawDescData
+}
+
+var file_catalog_translation_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_catalog_translation_proto_goTypes = []any{
+	(*Translation)(nil),                    // 0: catalog.Translation
+	(*SetTranslationRequest)(nil),          // 1: catalog.SetTranslationRequest
+	(*DeleteTranslationRequest)(nil),       // 2: catalog.DeleteTranslationRequest
+	(*ListTranslationsRequest)(nil),        // 3: catalog.ListTranslationsRequest
+	(*Translations)(nil),                   // 4: catalog.Translations
+	(*ListMissingTranslationsRequest)(nil), // 5: catalog.ListMissingTranslationsRequest
+	(*UntranslatedProduct)(nil),            // 6: catalog.UntranslatedProduct
+	(*UntranslatedProducts)(nil),           // 7: catalog.UntranslatedProducts
+	(*timestamppb.Timestamp)(nil),          // 8: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                  // 9: google.protobuf.Empty
+}
+var file_catalog_translation_proto_depIdxs = []int32{
+	8, // 0: catalog.Translation.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 1: catalog.SetTranslationRequest.translation:type_name -> catalog.Translation
+	0, // 2: catalog.Translations.translations:type_name -> catalog.Translation
+	6, // 3: catalog.UntranslatedProducts.products:type_name -> catalog.UntranslatedProduct
+	1, // 4: catalog.GRPCTranslation.Set:input_type -> catalog.SetTranslationRequest
+	2, // 5: catalog.GRPCTranslation.Delete:input_type -> catalog.DeleteTranslationRequest
+	3, // 6: catalog.GRPCTranslation.List:input_type -> catalog.ListTranslationsRequest
+	5, // 7: catalog.GRPCTranslation.ListMissing:input_type -> catalog.ListMissingTranslationsRequest
+	9, // 8: catalog.GRPCTranslation.Set:output_type -> google.protobuf.Empty
+	9, // 9: catalog.GRPCTranslation.Delete:output_type -> google.protobuf.Empty
+	4, // 10: catalog.GRPCTranslation.List:output_type -> catalog.Translations
+	7, // 11: catalog.GRPCTranslation.ListMissing:output_type -> catalog.UntranslatedProducts
+	8, // [8:12] is the sub-list for method output_type
+	4, // [4:8] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_catalog_translation_proto_init() }
+func file_catalog_translation_proto_init() {
+	if File_catalog_translation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_translation_proto_rawDesc), len(file_catalog_translation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_catalog_translation_proto_goTypes,
+		DependencyIndexes: file_catalog_translation_proto_depIdxs,
+		MessageInfos:      file_catalog_translation_proto_msgTypes,
+	}.Build()
+	File_catalog_translation_proto = out.File
+	file_catalog_translation_proto_goTypes = nil
+	file_catalog_translation_proto_depIdxs = nil
+}
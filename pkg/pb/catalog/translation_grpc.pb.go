@@ -0,0 +1,256 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: catalog/translation.proto
+
+package catalog
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GRPCTranslation_Set_FullMethodName         = "/catalog.GRPCTranslation/Set"
+	GRPCTranslation_Delete_FullMethodName      = "/catalog.GRPCTranslation/Delete"
+	GRPCTranslation_List_FullMethodName        = "/catalog.GRPCTranslation/List"
+	GRPCTranslation_ListMissing_FullMethodName = "/catalog.GRPCTranslation/ListMissing"
+)
+
+// GRPCTranslationClient is the client API for GRPCTranslation service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Переводы названия и описания товаров. Язык ответа выбирается по metadata
+// accept-language (как HTTP Accept-Language: "de-CH, de;q=0.9, en;q=0.5"):
+// сначала локаль, потом ее родитель (de-CH -> de), потом следующая локаль,
+// и в конце язык по умолчанию, на котором заведен сам товар. Выбранный язык
+// возвращается в поле locale или, для старого GRPCProduct.Get, в заголовке
+// content-language. Управление переводами - только для администраторов.
+type GRPCTranslationClient interface {
+	// Set создает или заменяет перевод; для языка по умолчанию - InvalidArgument,
+	// его меняют через Update самого товара
+	Set(ctx context.Context, in *SetTranslationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Delete(ctx context.Context, in *DeleteTranslationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListTranslationsRequest, opts ...grpc.CallOption) (*Translations, error)
+	// ListMissing - товары без перевода в locale, по названию
+	ListMissing(ctx context.Context, in *ListMissingTranslationsRequest, opts ...grpc.CallOption) (*UntranslatedProducts, error)
+}
+
+type gRPCTranslationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGRPCTranslationClient(cc grpc.ClientConnInterface) GRPCTranslationClient {
+	return &gRPCTranslationClient{cc}
+}
+
+func (c *gRPCTranslationClient) Set(ctx context.Context, in *SetTranslationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCTranslation_Set_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCTranslationClient) Delete(ctx context.Context, in *DeleteTranslationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCTranslation_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCTranslationClient) List(ctx context.Context, in *ListTranslationsRequest, opts ...grpc.CallOption) (*Translations, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Translations)
+	err := c.cc.Invoke(ctx, GRPCTranslation_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCTranslationClient) ListMissing(ctx context.Context, in *ListMissingTranslationsRequest, opts ...grpc.CallOption) (*UntranslatedProducts, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UntranslatedProducts)
+	err := c.cc.Invoke(ctx, GRPCTranslation_ListMissing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GRPCTranslationServer is the server API for GRPCTranslation service.
+// All implementations must embed UnimplementedGRPCTranslationServer
+// for forward compatibility.
+//
+// Переводы названия и описания товаров. Язык ответа выбирается по metadata
+// accept-language (как HTTP Accept-Language: "de-CH, de;q=0.9, en;q=0.5"):
+// сначала локаль, потом ее родитель (de-CH -> de), потом следующая локаль,
+// и в конце язык по умолчанию, на котором заведен сам товар. Выбранный язык
+// возвращается в поле locale или, для старого GRPCProduct.Get, в заголовке
+// content-language. Управление переводами - только для администраторов.
+type GRPCTranslationServer interface {
+	// Set создает или заменяет перевод; для языка по умолчанию - InvalidArgument,
+	// его меняют через Update самого товара
+	Set(context.Context, *SetTranslationRequest) (*emptypb.Empty, error)
+	Delete(context.Context, *DeleteTranslationRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListTranslationsRequest) (*Translations, error)
+	// ListMissing - товары без перевода в locale, по названию
+	ListMissing(context.Context, *ListMissingTranslationsRequest) (*UntranslatedProducts, error)
+	mustEmbedUnimplementedGRPCTranslationServer()
+}
+
+// UnimplementedGRPCTranslationServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGRPCTranslationServer struct{}
+
+func (UnimplementedGRPCTranslationServer) Set(context.Context, *SetTranslationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (UnimplementedGRPCTranslationServer) Delete(context.Context, *DeleteTranslationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedGRPCTranslationServer) List(context.Context, *ListTranslationsRequest) (*Translations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedGRPCTranslationServer) ListMissing(context.Context, *ListMissingTranslationsRequest) (*UntranslatedProducts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMissing not implemented")
+}
+func (UnimplementedGRPCTranslationServer) mustEmbedUnimplementedGRPCTranslationServer() {}
+func (UnimplementedGRPCTranslationServer) testEmbeddedByValue()                         {}
+
+// UnsafeGRPCTranslationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GRPCTranslationServer will
+// result in compilation errors.
+type UnsafeGRPCTranslationServer interface {
+	mustEmbedUnimplementedGRPCTranslationServer()
+}
+
+func RegisterGRPCTranslationServer(s grpc.ServiceRegistrar, srv GRPCTranslationServer) {
+	// If the following call pancis, it indicates UnimplementedGRPCTranslationServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GRPCTranslation_ServiceDesc, srv)
+}
+
+func _GRPCTranslation_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCTranslationServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCTranslation_Set_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCTranslationServer).Set(ctx, req.(*SetTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCTranslation_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCTranslationServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCTranslation_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCTranslationServer).Delete(ctx, req.(*DeleteTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCTranslation_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTranslationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCTranslationServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCTranslation_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCTranslationServer).List(ctx, req.(*ListTranslationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCTranslation_ListMissing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMissingTranslationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCTranslationServer).ListMissing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCTranslation_ListMissing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCTranslationServer).ListMissing(ctx, req.(*ListMissingTranslationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GRPCTranslation_ServiceDesc is the grpc.ServiceDesc for GRPCTranslation service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GRPCTranslation_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "catalog.GRPCTranslation",
+	HandlerType: (*GRPCTranslationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Set",
+			Handler:    _GRPCTranslation_Set_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _GRPCTranslation_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _GRPCTranslation_List_Handler,
+		},
+		{
+			MethodName: "ListMissing",
+			Handler:    _GRPCTranslation_ListMissing_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "catalog/translation.proto",
+}
@@ -0,0 +1,357 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: catalog/variant.proto
+
+package catalog
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Variant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku           string                 `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
+	PriceOverride int64                  `protobuf:"varint,4,opt,name=price_override,json=priceOverride,proto3" json:"price_override,omitempty"` // 0 - цена родительского товара, в его валюте
+	Price         int64                  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`                                      // итоговая цена, только в ответах
+	Options       map[string]string      `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Barcode       string                 `protobuf:"bytes,7,opt,name=barcode,proto3" json:"barcode,omitempty"`   // EAN-8, UPC-A, EAN-13 или GTIN-14
+	Currency      string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"` // валюта price, только в ответах
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Variant) Reset() {
+	*x = Variant{}
+	mi := &file_catalog_variant_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Variant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Variant) ProtoMessage() {}
+
+func (x *Variant) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_variant_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Variant.ProtoReflect.Descriptor instead.
+func (*Variant) Descriptor() ([]byte, []int) {
+	return file_catalog_variant_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Variant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Variant) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *Variant) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Variant) GetPriceOverride() int64 {
+	if x != nil {
+		return x.PriceOverride
+	}
+	return 0
+}
+
+func (x *Variant) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Variant) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Variant) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *Variant) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type VariantID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariantID) Reset() {
+	*x = VariantID{}
+	mi := &file_catalog_variant_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantID) ProtoMessage() {}
+
+func (x *VariantID) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_variant_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariantID.ProtoReflect.Descriptor instead.
+func (*VariantID) Descriptor() ([]byte, []int) {
+	return file_catalog_variant_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VariantID) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type VariantSKU struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariantSKU) Reset() {
+	*x = VariantSKU{}
+	mi := &file_catalog_variant_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantSKU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantSKU) ProtoMessage() {}
+
+func (x *VariantSKU) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_variant_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariantSKU.ProtoReflect.Descriptor instead.
+func (*VariantSKU) Descriptor() ([]byte, []int) {
+	return file_catalog_variant_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VariantSKU) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+type VariantList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variants      []*Variant             `protobuf:"bytes,1,rep,name=variants,proto3" json:"variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariantList) Reset() {
+	*x = VariantList{}
+	mi := &file_catalog_variant_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantList) ProtoMessage() {}
+
+func (x *VariantList) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_variant_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariantList.ProtoReflect.Descriptor instead.
+func (*VariantList) Descriptor() ([]byte, []int) {
+	return file_catalog_variant_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VariantList) GetVariants() []*Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+var File_catalog_variant_proto protoreflect.FileDescriptor
+
+const file_catalog_variant_proto_rawDesc = "" +
+	"\n" +
+	"\x15catalog/variant.proto\x12\acatalog\x1a\x1bgoogle/protobuf/empty.proto\x1a\x16catalog/category.proto\"\xb2\x02\n" +
+	"\aVariant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12%\n" +
+	"\x0eprice_override\x18\x04 \x01(\x03R\rpriceOverride\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x03R\x05price\x127\n" +
+	"\aoptions\x18\x06 \x03(\v2\x1d.catalog.Variant.OptionsEntryR\aoptions\x12\x18\n" +
+	"\abarcode\x18\a \x01(\tR\abarcode\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x1a:\n" +
+	"\fOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x1b\n" +
+	"\tVariantID\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1e\n" +
+	"\n" +
+	"VariantSKU\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\";\n" +
+	"\vVariantList\x12,\n" +
+	"\bvariants\x18\x01 \x03(\v2\x10.catalog.VariantR\bvariants2\xba\x02\n" +
+	"\vGRPCVariant\x12.\n" +
+	"\x06Create\x12\x10.catalog.Variant\x1a\x12.catalog.VariantID\x12+\n" +
+	"\x03Get\x12\x12.catalog.VariantID\x1a\x10.catalog.Variant\x121\n" +
+	"\bGetBySKU\x12\x13.catalog.VariantSKU\x1a\x10.catalog.Variant\x121\n" +
+	"\x04List\x12\x13.catalog.ProductRef\x1a\x14.catalog.VariantList\x122\n" +
+	"\x06Update\x12\x10.catalog.Variant\x1a\x16.google.protobuf.Empty\x124\n" +
+	"\x06Delete\x12\x12.catalog.VariantID\x1a\x16.google.protobuf.EmptyB6Z4github.com/glekoz/online-shop_product/pkg/pb/catalogb\x06proto3"
+
+var (
+	file_catalog_variant_proto_rawDescOnce sync.Once
+	file_catalog_variant_proto_rawDescData []byte
+)
+
+func file_catalog_variant_proto_rawDescGZIP() []byte {
+	file_catalog_variant_proto_rawDescOnce.Do(func() {
+		file_catalog_variant_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_catalog_variant_proto_rawDesc), len(file_catalog_variant_proto_rawDesc)))
+	})
+	return file_catalog_variant_proto_rawDescData
+}
+
+var file_catalog_variant_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_catalog_variant_proto_goTypes = []any{
+	(*Variant)(nil),       // 0: catalog.Variant
+	(*VariantID)(nil),     // 1: catalog.VariantID
+	(*VariantSKU)(nil),    // 2: catalog.VariantSKU
+	(*VariantList)(nil),   // 3: catalog.VariantList
+	nil,                   // 4: catalog.Variant.OptionsEntry
+	(*ProductRef)(nil),    // 5: catalog.ProductRef
+	(*emptypb.Empty)(nil), // 6: google.protobuf.Empty
+}
+var file_catalog_variant_proto_depIdxs = []int32{
+	4, // 0: catalog.Variant.options:type_name -> catalog.Variant.OptionsEntry
+	0, // 1: catalog.VariantList.variants:type_name -> catalog.Variant
+	0, // 2: catalog.GRPCVariant.Create:input_type -> catalog.Variant
+	1, // 3: catalog.GRPCVariant.Get:input_type -> catalog.VariantID
+	2, // 4: catalog.GRPCVariant.GetBySKU:input_type -> catalog.VariantSKU
+	5, // 5: catalog.GRPCVariant.List:input_type -> catalog.ProductRef
+	0, // 6: catalog.GRPCVariant.Update:input_type -> catalog.Variant
+	1, // 7: catalog.GRPCVariant.Delete:input_type -> catalog.VariantID
+	1, // 8: catalog.GRPCVariant.Create:output_type -> catalog.VariantID
+	0, // 9: catalog.GRPCVariant.Get:output_type -> catalog.Variant
+	0, // 10: catalog.GRPCVariant.GetBySKU:output_type -> catalog.Variant
+	3, // 11: catalog.GRPCVariant.List:output_type -> catalog.VariantList
+	6, // 12: catalog.GRPCVariant.Update:output_type -> google.protobuf.Empty
+	6, // 13: catalog.GRPCVariant.Delete:output_type -> google.protobuf.Empty
+	8, // [8:14] is the sub-list for method output_type
+	2, // [2:8] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_catalog_variant_proto_init() }
+func file_catalog_variant_proto_init() {
+	if File_catalog_variant_proto != nil {
+		return
+	}
+	file_catalog_category_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_variant_proto_rawDesc), len(file_catalog_variant_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_catalog_variant_proto_goTypes,
+		DependencyIndexes: file_catalog_variant_proto_depIdxs,
+		MessageInfos:      file_catalog_variant_proto_msgTypes,
+	}.Build()
+	File_catalog_variant_proto = out.File
+	file_catalog_variant_proto_goTypes = nil
+	file_catalog_variant_proto_depIdxs = nil
+}
@@ -0,0 +1,318 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: catalog/variant.proto
+
+package catalog
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GRPCVariant_Create_FullMethodName   = "/catalog.GRPCVariant/Create"
+	GRPCVariant_Get_FullMethodName      = "/catalog.GRPCVariant/Get"
+	GRPCVariant_GetBySKU_FullMethodName = "/catalog.GRPCVariant/GetBySKU"
+	GRPCVariant_List_FullMethodName     = "/catalog.GRPCVariant/List"
+	GRPCVariant_Update_FullMethodName   = "/catalog.GRPCVariant/Update"
+	GRPCVariant_Delete_FullMethodName   = "/catalog.GRPCVariant/Delete"
+)
+
+// GRPCVariantClient is the client API for GRPCVariant service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Вариант - конкретная позиция родительского товара (футболка размера M),
+// со своим SKU, штрихкодом и, при необходимости, своей ценой.
+type GRPCVariantClient interface {
+	Create(ctx context.Context, in *Variant, opts ...grpc.CallOption) (*VariantID, error)
+	Get(ctx context.Context, in *VariantID, opts ...grpc.CallOption) (*Variant, error)
+	GetBySKU(ctx context.Context, in *VariantSKU, opts ...grpc.CallOption) (*Variant, error)
+	List(ctx context.Context, in *ProductRef, opts ...grpc.CallOption) (*VariantList, error)
+	Update(ctx context.Context, in *Variant, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Delete(ctx context.Context, in *VariantID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type gRPCVariantClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGRPCVariantClient(cc grpc.ClientConnInterface) GRPCVariantClient {
+	return &gRPCVariantClient{cc}
+}
+
+func (c *gRPCVariantClient) Create(ctx context.Context, in *Variant, opts ...grpc.CallOption) (*VariantID, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VariantID)
+	err := c.cc.Invoke(ctx, GRPCVariant_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCVariantClient) Get(ctx context.Context, in *VariantID, opts ...grpc.CallOption) (*Variant, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Variant)
+	err := c.cc.Invoke(ctx, GRPCVariant_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCVariantClient) GetBySKU(ctx context.Context, in *VariantSKU, opts ...grpc.CallOption) (*Variant, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Variant)
+	err := c.cc.Invoke(ctx, GRPCVariant_GetBySKU_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCVariantClient) List(ctx context.Context, in *ProductRef, opts ...grpc.CallOption) (*VariantList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VariantList)
+	err := c.cc.Invoke(ctx, GRPCVariant_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCVariantClient) Update(ctx context.Context, in *Variant, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCVariant_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCVariantClient) Delete(ctx context.Context, in *VariantID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GRPCVariant_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GRPCVariantServer is the server API for GRPCVariant service.
+// All implementations must embed UnimplementedGRPCVariantServer
+// for forward compatibility.
+//
+// Вариант - конкретная позиция родительского товара (футболка размера M),
+// со своим SKU, штрихкодом и, при необходимости, своей ценой.
+type GRPCVariantServer interface {
+	Create(context.Context, *Variant) (*VariantID, error)
+	Get(context.Context, *VariantID) (*Variant, error)
+	GetBySKU(context.Context, *VariantSKU) (*Variant, error)
+	List(context.Context, *ProductRef) (*VariantList, error)
+	Update(context.Context, *Variant) (*emptypb.Empty, error)
+	Delete(context.Context, *VariantID) (*emptypb.Empty, error)
+	mustEmbedUnimplementedGRPCVariantServer()
+}
+
+// UnimplementedGRPCVariantServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGRPCVariantServer struct{}
+
+func (UnimplementedGRPCVariantServer) Create(context.Context, *Variant) (*VariantID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedGRPCVariantServer) Get(context.Context, *VariantID) (*Variant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedGRPCVariantServer) GetBySKU(context.Context, *VariantSKU) (*Variant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBySKU not implemented")
+}
+func (UnimplementedGRPCVariantServer) List(context.Context, *ProductRef) (*VariantList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedGRPCVariantServer) Update(context.Context, *Variant) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedGRPCVariantServer) Delete(context.Context, *VariantID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedGRPCVariantServer) mustEmbedUnimplementedGRPCVariantServer() {}
+func (UnimplementedGRPCVariantServer) testEmbeddedByValue()                     {}
+
+// UnsafeGRPCVariantServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GRPCVariantServer will
+// result in compilation errors.
+type UnsafeGRPCVariantServer interface {
+	mustEmbedUnimplementedGRPCVariantServer()
+}
+
+func RegisterGRPCVariantServer(s grpc.ServiceRegistrar, srv GRPCVariantServer) {
+	// If the following call pancis, it indicates UnimplementedGRPCVariantServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GRPCVariant_ServiceDesc, srv)
+}
+
+func _GRPCVariant_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Variant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCVariantServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCVariant_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCVariantServer).Create(ctx, req.(*Variant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCVariant_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VariantID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCVariantServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCVariant_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCVariantServer).Get(ctx, req.(*VariantID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCVariant_GetBySKU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VariantSKU)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCVariantServer).GetBySKU(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCVariant_GetBySKU_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCVariantServer).GetBySKU(ctx, req.(*VariantSKU))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCVariant_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCVariantServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCVariant_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCVariantServer).List(ctx, req.(*ProductRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCVariant_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Variant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCVariantServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCVariant_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCVariantServer).Update(ctx, req.(*Variant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCVariant_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VariantID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCVariantServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GRPCVariant_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCVariantServer).Delete(ctx, req.(*VariantID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GRPCVariant_ServiceDesc is the grpc.ServiceDesc for GRPCVariant service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GRPCVariant_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "catalog.GRPCVariant",
+	HandlerType: (*GRPCVariantServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _GRPCVariant_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _GRPCVariant_Get_Handler,
+		},
+		{
+			MethodName: "GetBySKU",
+			Handler:    _GRPCVariant_GetBySKU_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _GRPCVariant_List_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _GRPCVariant_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _GRPCVariant_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "catalog/variant.proto",
+}
@@ -0,0 +1,169 @@
+// Package promo считает цену товара с учетом акций. Пакет ничего не знает
+// о хранилище: app.App загружает акции и передает их сюда вместе с товаром
+package promo
+
+import (
+	"errors"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/money"
+)
+
+type Kind string
+
+const (
+	Percent Kind = "percent" // Value - скидка в сотых долях процента: 1250 = 12.5%
+	Fixed   Kind = "fixed"   // Amount - скидка в валюте товара
+)
+
+type Target string
+
+const (
+	TargetAll      Target = "all"
+	TargetProduct  Target = "product"
+	TargetCategory Target = "category" // категория и все ее потомки
+)
+
+// MaxPercent - 100% в сотых долях процента
+const MaxPercent = 10000
+
+var (
+	ErrInvalidKind   = errors.New("promotion kind must be percent or fixed")
+	ErrInvalidValue  = errors.New("percent must be between 0.01% and 100%")
+	ErrInvalidAmount = errors.New("fixed discount must be positive")
+	ErrInvalidTarget = errors.New("target must be all, product or category; product and category need target_id")
+	ErrInvalidWindow = errors.New("promotion must end after it starts")
+)
+
+type Promotion struct {
+	ID       string
+	Name     string
+	Kind     Kind
+	Value    int64       // для Percent
+	Amount   money.Money // для Fixed; к товарам в другой валюте акция не применяется
+	Target   Target
+	TargetID string
+	StartsAt time.Time // нулевое время - без ограничения
+	EndsAt   time.Time
+	// Priority - чем больше, тем раньше применяется
+	Priority int
+	// Stackable - акцию можно совмещать с другими совместимыми акциями;
+	// несовместимая акция применяется только одна
+	Stackable bool
+}
+
+func (p Promotion) Validate() error {
+	switch p.Kind {
+	case Percent:
+		if p.Value <= 0 || p.Value > MaxPercent {
+			return ErrInvalidValue
+		}
+	case Fixed:
+		if p.Amount.Amount <= 0 {
+			return ErrInvalidAmount
+		}
+		if err := p.Amount.Validate(); err != nil {
+			return err
+		}
+	default:
+		return ErrInvalidKind
+	}
+	switch p.Target {
+	case TargetAll:
+		if p.TargetID != "" {
+			return ErrInvalidTarget
+		}
+	case TargetProduct, TargetCategory:
+		if p.TargetID == "" {
+			return ErrInvalidTarget
+		}
+	default:
+		return ErrInvalidTarget
+	}
+	if !p.StartsAt.IsZero() && !p.EndsAt.IsZero() && !p.EndsAt.After(p.StartsAt) {
+		return ErrInvalidWindow
+	}
+	return nil
+}
+
+// Active - действует ли акция в момент now: [StartsAt, EndsAt)
+func (p Promotion) Active(now time.Time) bool {
+	return (p.StartsAt.IsZero() || !now.Before(p.StartsAt)) && (p.EndsAt.IsZero() || now.Before(p.EndsAt))
+}
+
+// Item - то, что нужно движку о товаре
+type Item struct {
+	ProductID string
+	// Categories - категории товара вместе со всеми предками
+	Categories []string
+	Price      money.Money
+}
+
+type Result struct {
+	ListPrice money.Money
+	Price     money.Money
+	Applied   []string // id примененных акций в порядке применения
+}
+
+func (p Promotion) matches(it Item) bool {
+	if p.Kind == Fixed && p.Amount.Currency != it.Price.Currency {
+		return false
+	}
+	switch p.Target {
+	case TargetAll:
+		return true
+	case TargetProduct:
+		return p.TargetID == it.ProductID
+	case TargetCategory:
+		return slices.Contains(it.Categories, p.TargetID)
+	}
+	return false
+}
+
+// Apply применяет к товару подходящие и действующие в момент now акции.
+// Акции идут по убыванию приоритета (при равенстве - по id, чтобы результат
+// не зависел от порядка загрузки). Первая акция применяется всегда; следующие -
+// только пока и примененные, и очередная совместимы (Stackable).
+// Процент считается от уже сниженной цены, цена не уходит ниже нуля
+func Apply(promos []Promotion, it Item, now time.Time) Result {
+	res := Result{ListPrice: it.Price, Price: it.Price}
+	var candidates []Promotion
+	for _, p := range promos {
+		if p.Active(now) && p.matches(it) {
+			candidates = append(candidates, p)
+		}
+	}
+	slices.SortFunc(candidates, func(a, b Promotion) int {
+		if a.Priority != b.Priority {
+			return b.Priority - a.Priority
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	for i, p := range candidates {
+		if i > 0 && !(p.Stackable && candidates[0].Stackable) {
+			continue
+		}
+		res.Price.Amount -= discount(p, res.Price.Amount)
+		res.Applied = append(res.Applied, p.ID)
+		if res.Price.Amount <= 0 {
+			res.Price.Amount = 0
+			break
+		}
+	}
+	return res
+}
+
+// discount - размер скидки в минорных единицах; процент округляется
+// до ближайшей минорной единицы, половина - в пользу покупателя
+func discount(p Promotion, amount int64) int64 {
+	if p.Kind == Fixed {
+		return min(p.Amount.Amount, amount)
+	}
+	v := new(big.Int).Mul(big.NewInt(amount), big.NewInt(p.Value))
+	v.Add(v, big.NewInt(MaxPercent/2))
+	v.Quo(v, big.NewInt(MaxPercent))
+	return min(v.Int64(), amount)
+}
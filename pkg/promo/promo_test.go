@@ -0,0 +1,86 @@
+package promo
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/money"
+)
+
+func TestApply(t *testing.T) {
+	now := time.Date(2026, 10, 23, 12, 0, 0, 0, time.UTC)
+	item := Item{ProductID: "donut", Categories: []string{"glazed", "donuts", "bakery"}, Price: money.New(10000, "RUB")}
+	tests := []struct {
+		name    string
+		promos  []Promotion
+		price   int64
+		applied []string
+	}{
+		{"no promotions", nil, 10000, nil},
+		{"percent on category ancestor", []Promotion{
+			{ID: "a", Kind: Percent, Value: 1250, Target: TargetCategory, TargetID: "bakery"},
+		}, 8750, []string{"a"}},
+		{"other product", []Promotion{
+			{ID: "a", Kind: Percent, Value: 5000, Target: TargetProduct, TargetID: "eclair"},
+		}, 10000, nil},
+		{"fixed in other currency is skipped", []Promotion{
+			{ID: "a", Kind: Fixed, Amount: money.New(500, "USD"), Target: TargetAll},
+		}, 10000, nil},
+		{"outside window", []Promotion{
+			{ID: "a", Kind: Percent, Value: 5000, Target: TargetAll, EndsAt: now},
+			{ID: "b", Kind: Percent, Value: 5000, Target: TargetAll, StartsAt: now.Add(time.Second)},
+		}, 10000, nil},
+		{"exclusive wins by priority", []Promotion{
+			{ID: "a", Kind: Percent, Value: 1000, Target: TargetAll, Priority: 1, Stackable: true},
+			{ID: "b", Kind: Fixed, Amount: money.New(3000, "RUB"), Target: TargetProduct, TargetID: "donut", Priority: 5},
+		}, 7000, []string{"b"}},
+		{"stackable in priority order", []Promotion{
+			{ID: "a", Kind: Percent, Value: 1000, Target: TargetAll, Priority: 1, Stackable: true},
+			{ID: "b", Kind: Fixed, Amount: money.New(1000, "RUB"), Target: TargetAll, Priority: 5, Stackable: true},
+			{ID: "c", Kind: Percent, Value: 5000, Target: TargetAll, Priority: 3},
+		}, 8100, []string{"b", "a"}},
+		{"not below zero", []Promotion{
+			{ID: "a", Kind: Fixed, Amount: money.New(20000, "RUB"), Target: TargetAll, Stackable: true},
+			{ID: "b", Kind: Percent, Value: 1000, Target: TargetAll, Stackable: true},
+		}, 0, []string{"a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := Apply(tt.promos, item, now)
+			if res.ListPrice != item.Price || res.Price != money.New(tt.price, "RUB") || !slices.Equal(res.Applied, tt.applied) {
+				t.Fatalf("got %+v, want price %d and %v", res, tt.price, tt.applied)
+			}
+		})
+	}
+}
+
+func TestPercentRounding(t *testing.T) {
+	// 15% от 99.99 = 14.9985 -> 15.00
+	res := Apply([]Promotion{{ID: "a", Kind: Percent, Value: 1500, Target: TargetAll}}, Item{Price: money.New(9999, "RUB")}, time.Now())
+	if res.Price.Amount != 8499 {
+		t.Fatalf("got %d, want 8499", res.Price.Amount)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	start := time.Now()
+	tests := []struct {
+		name string
+		p    Promotion
+		err  error
+	}{
+		{"valid", Promotion{Kind: Percent, Value: 10000, Target: TargetAll}, nil},
+		{"percent over 100", Promotion{Kind: Percent, Value: 10001, Target: TargetAll}, ErrInvalidValue},
+		{"fixed without amount", Promotion{Kind: Fixed, Target: TargetAll}, ErrInvalidAmount},
+		{"category without id", Promotion{Kind: Percent, Value: 1, Target: TargetCategory}, ErrInvalidTarget},
+		{"ends before start", Promotion{Kind: Percent, Value: 1, Target: TargetAll, StartsAt: start, EndsAt: start}, ErrInvalidWindow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.p.Validate(); err != tt.err {
+				t.Fatalf("got %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
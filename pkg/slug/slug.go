@@ -0,0 +1,82 @@
+// Package slug делает из названия товара часть URL: латиница, цифры и дефисы.
+// Кириллица транслитерируется по правилам, близким к загранпаспортным.
+package slug
+
+import (
+	"strings"
+	"unicode"
+)
+
+// MaxLength - длина основы; остальное до ширины колонки оставлено под суффикс "-N"
+const MaxLength = 100
+
+// Fallback - слаг для названия, в котором не нашлось ни одной буквы или цифры
+const Fallback = "product"
+
+var translit = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh",
+	'з': "z", 'и': "i", 'й': "i", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+	'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "iu",
+	'я': "ia",
+	// украинский и белорусский
+	'і': "i", 'ї': "i", 'є': "ie", 'ґ': "g", 'ў': "u",
+	// латиница с диакритикой, которая встречается в названиях
+	'à': "a", 'á': "a", 'â': "a", 'ä': "a", 'ã': "a", 'å': "a", 'æ': "ae", 'ç': "c",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ì': "i", 'í': "i", 'î': "i", 'ï': "i",
+	'ñ': "n", 'ò': "o", 'ó': "o", 'ô': "o", 'ö': "o", 'õ': "o", 'ø': "o", 'œ': "oe",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ý': "y", 'ÿ': "y", 'ß': "ss",
+}
+
+// Make: "Шоколадный пончик №1" -> "shokoladnyi-ponchik-1". Слово, не влезшее
+// в MaxLength, отбрасывается целиком
+func Make(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		s, ok := translit[r]
+		switch {
+		case ok:
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			s = string(r)
+		default:
+			// все остальное, включая непонятные алфавиты, - разделитель слов
+			dash = b.Len() > 0
+			continue
+		}
+		if s == "" {
+			continue
+		}
+		if dash {
+			b.WriteByte('-')
+			dash = false
+		}
+		b.WriteString(s)
+	}
+	res := b.String()
+	if len(res) > MaxLength {
+		res = res[:MaxLength+1]
+		if i := strings.LastIndexByte(res, '-'); i > 0 {
+			res = res[:i]
+		} else {
+			res = res[:MaxLength]
+		}
+	}
+	if res == "" {
+		return Fallback
+	}
+	return res
+}
+
+// Valid - строка могла получиться из Make, возможно с суффиксом
+func Valid(s string) bool {
+	if s == "" || len(s) > MaxLength+10 || s[0] == '-' || s[len(s)-1] == '-' || strings.Contains(s, "--") {
+		return false
+	}
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,51 @@
+package slug
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMake(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Chocolate Donut", "chocolate-donut"},
+		{"Шоколадный пончик №1", "shokoladnyi-ponchik-1"},
+		{"Щи да каша — пища наша", "shchi-da-kasha-pishcha-nasha"},
+		{"Ёжик в тумане", "ezhik-v-tumane"},
+		{"Подъезд, объём!", "podezd-obem"},
+		{"Crème brûlée", "creme-brulee"},
+		{"  --Glazed   Donut--  ", "glazed-donut"},
+		{"寿司", Fallback},
+		{"", Fallback},
+	}
+	for _, tt := range tests {
+		if got := Make(tt.name); got != tt.want {
+			t.Errorf("Make(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMakeLong(t *testing.T) {
+	got := Make(strings.Repeat("donut ", 30))
+	if len(got) > MaxLength || strings.HasSuffix(got, "-") || !strings.HasSuffix(got, "donut") {
+		t.Fatalf("got %q (%d)", got, len(got))
+	}
+	if got := Make(strings.Repeat("a", 150)); len(got) != MaxLength {
+		t.Fatalf("got %d bytes", len(got))
+	}
+}
+
+func TestValid(t *testing.T) {
+	for _, s := range []string{"donut", "chocolate-donut-2", "1"} {
+		if !Valid(s) {
+			t.Errorf("Valid(%q) = false", s)
+		}
+	}
+	for _, s := range []string{"", "-donut", "donut-", "do--nut", "Donut", "пончик", "a/b"} {
+		if Valid(s) {
+			t.Errorf("Valid(%q) = true", s)
+		}
+	}
+}
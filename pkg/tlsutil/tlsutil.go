@@ -0,0 +1,159 @@
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+type Config struct {
+	CertFile string
+	KeyFile  string
+	// ClientCAFile - CA bundle для проверки клиентских сертификатов (mTLS),
+	// пустая строка - клиентские сертификаты не запрашиваются
+	ClientCAFile string
+	// CheckInterval - как часто проверять, не поменялись ли файлы на диске
+	CheckInterval time.Duration
+}
+
+// Reloader держит текущие сертификат и CA bundle и перечитывает их,
+// когда файлы на диске меняются (cert-manager, vault agent и т.п.),
+// поэтому ротация сертификатов не требует рестарта.
+// Проверка ленивая: не чаще CheckInterval во время TLS handshake.
+type Reloader struct {
+	cfg Config
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTimes  map[string]time.Time
+	checkedAt time.Time
+}
+
+func NewReloader(cfg Config) (*Reloader, error) {
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, errors.New("cert and key files are required")
+	}
+	if cfg.CheckInterval <= 0 {
+		cfg.CheckInterval = 10 * time.Second
+	}
+	r := &Reloader{cfg: cfg}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ServerConfig возвращает tls.Config для gRPC сервера; при заданном ClientCAFile
+// клиент обязан предъявить сертификат, подписанный одним из CA (mTLS)
+func (r *Reloader) ServerConfig() *tls.Config {
+	return r.config([]string{"h2"}, true)
+}
+
+// HTTPConfig - то же для HTTP сервера: HTTP/1.1 тоже разрешен, а клиентский
+// сертификат спрашивается, только если verifyClients. На REST ходят браузеры
+// и партнеры, у которых сертификатов нет, даже когда gRPC закрыт mTLS
+func (r *Reloader) HTTPConfig(verifyClients bool) *tls.Config {
+	return r.config([]string{"h2", "http/1.1"}, verifyClients)
+}
+
+func (r *Reloader) config(protos []string, verifyClients bool) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.maybeReload()
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert},
+				NextProtos:   protos,
+			}
+			if verifyClients && r.clientCAs != nil {
+				cfg.ClientCAs = r.clientCAs
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+			return cfg, nil
+		},
+	}
+}
+
+func (r *Reloader) maybeReload() {
+	r.mu.RLock()
+	fresh := time.Since(r.checkedAt) < r.cfg.CheckInterval
+	r.mu.RUnlock()
+	if fresh {
+		return
+	}
+	if err := r.load(); err != nil {
+		// оставляем старый сертификат, пока новый не станет валидным
+		// (файлы могут быть записаны не одновременно)
+		r.mu.Lock()
+		r.checkedAt = time.Now()
+		r.mu.Unlock()
+	}
+}
+
+func (r *Reloader) load() error {
+	files := []string{r.cfg.CertFile, r.cfg.KeyFile}
+	if r.cfg.ClientCAFile != "" {
+		files = append(files, r.cfg.ClientCAFile)
+	}
+	modTimes := make(map[string]time.Time, len(files))
+	changed := false
+	r.mu.RLock()
+	for _, f := range files {
+		st, err := os.Stat(f)
+		if err != nil {
+			r.mu.RUnlock()
+			return err
+		}
+		modTimes[f] = st.ModTime()
+		if !st.ModTime().Equal(r.modTimes[f]) {
+			changed = true
+		}
+	}
+	r.mu.RUnlock()
+	if !changed {
+		r.mu.Lock()
+		r.checkedAt = time.Now()
+		r.mu.Unlock()
+		return nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+	if err != nil {
+		return err
+	}
+	var pool *x509.CertPool
+	if r.cfg.ClientCAFile != "" {
+		pool, err = LoadCertPool(r.cfg.ClientCAFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	r.mu.Lock()
+	r.cert = &cert
+	r.clientCAs = pool
+	r.modTimes = modTimes
+	r.checkedAt = time.Now()
+	r.mu.Unlock()
+	return nil
+}
+
+func LoadCertPool(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("%s: no certificates found", path)
+	}
+	return pool, nil
+}
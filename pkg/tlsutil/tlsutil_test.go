@@ -0,0 +1,142 @@
+package tlsutil
+
+import (
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/tlsutil/tlstest"
+)
+
+// handshake подключается к серверу и возвращает CN сертификата сервера
+func handshake(t *testing.T, addr string, cfg *tls.Config) (string, error) {
+	t.Helper()
+	conn, err := tls.Dial("tcp", addr, cfg)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	return conn.ConnectionState().PeerCertificates[0].Subject.CommonName, nil
+}
+
+func serve(t *testing.T, cfg *tls.Config) string {
+	t.Helper()
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func(c net.Conn) {
+				c.(*tls.Conn).Handshake()
+				c.Close()
+			}(conn)
+		}
+	}()
+	return ln.Addr().String()
+}
+
+func TestReloaderRotation(t *testing.T) {
+	dir := t.TempDir()
+	ca := tlstest.NewCA(t)
+	certFile, keyFile := ca.WriteFiles(t, dir, "server")
+
+	r, err := NewReloader(Config{CertFile: certFile, KeyFile: keyFile, CheckInterval: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := serve(t, r.ServerConfig())
+
+	cn, err := handshake(t, addr, ca.ClientConfig(t, ""))
+	if err != nil || cn != "server" {
+		t.Fatalf("before rotation: cn=%q err=%v", cn, err)
+	}
+
+	// ротация: новый сертификат пишется поверх старого
+	certPEM, keyPEM := ca.Issue(t, "server-rotated")
+	later := time.Now().Add(time.Second)
+	for path, data := range map[string][]byte{certFile: certPEM, keyFile: keyPEM} {
+		if err := os.WriteFile(path, data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		os.Chtimes(path, later, later)
+	}
+	time.Sleep(5 * time.Millisecond)
+
+	cn, err = handshake(t, addr, ca.ClientConfig(t, ""))
+	if err != nil || cn != "server-rotated" {
+		t.Fatalf("after rotation: cn=%q err=%v", cn, err)
+	}
+}
+
+func TestReloaderMTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := tlstest.NewCA(t)
+	certFile, keyFile := ca.WriteFiles(t, dir, "server")
+
+	r, err := NewReloader(Config{CertFile: certFile, KeyFile: keyFile, ClientCAFile: ca.WriteCA(t, dir)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := serve(t, r.ServerConfig())
+
+	if _, err := handshake(t, addr, ca.ClientConfig(t, "order-service")); err != nil {
+		t.Fatalf("client with certificate: %v", err)
+	}
+
+	// сертификат от чужого CA не проходит
+	stranger := tlstest.NewCA(t).ClientConfig(t, "stranger")
+	stranger.RootCAs = ca.ClientConfig(t, "").RootCAs
+	conn, err := tls.Dial("tcp", addr, stranger)
+	if err == nil {
+		// в TLS 1.3 ошибка проверки клиента приходит при первом чтении
+		_, err = conn.Read(make([]byte, 1))
+		conn.Close()
+	}
+	if err == nil {
+		t.Fatal("client with foreign certificate must be rejected")
+	}
+}
+
+func TestHTTPConfig(t *testing.T) {
+	dir := t.TempDir()
+	ca := tlstest.NewCA(t)
+	certFile, keyFile := ca.WriteFiles(t, dir, "server")
+	r, err := NewReloader(Config{CertFile: certFile, KeyFile: keyFile, ClientCAFile: ca.WriteCA(t, dir)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// mTLS на gRPC не мешает HTTP/1.1 клиенту без сертификата
+	cfg := ca.ClientConfig(t, "")
+	cfg.NextProtos = []string{"http/1.1"}
+	conn, err := tls.Dial("tcp", serve(t, r.HTTPConfig(false)), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = conn.Read(make([]byte, 1))
+	proto := conn.ConnectionState().NegotiatedProtocol
+	conn.Close()
+	if !errors.Is(err, io.EOF) || proto != "http/1.1" {
+		t.Fatalf("client without certificate: proto %q, err %v", proto, err)
+	}
+
+	// проверка клиентов включается явно
+	conn, err = tls.Dial("tcp", serve(t, r.HTTPConfig(true)), ca.ClientConfig(t, ""))
+	if err == nil {
+		_, err = conn.Read(make([]byte, 1))
+		conn.Close()
+	}
+	if err == nil {
+		t.Fatal("client without certificate must be rejected")
+	}
+}
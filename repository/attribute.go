@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+	"github.com/glekoz/online-shop_product/repository/db"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) CreateAttributeDefinition(ctx context.Context, id string, d models.AttributeDefinition) error {
+	enumValues := d.EnumValues
+	if enumValues == nil {
+		enumValues = []string{}
+	}
+	err := r.q.CreateAttributeDefinition(ctx, db.CreateAttributeDefinitionParams{
+		ID:         id,
+		CategoryID: d.CategoryID,
+		Code:       d.Code,
+		Name:       d.Name,
+		Type:       string(d.Type),
+		EnumValues: enumValues,
+		TenantID:   auth.Tenant(ctx),
+	})
+	return categoryError(err)
+}
+
+func (r *Repository) DeleteAttributeDefinition(ctx context.Context, id string) error {
+	rows, err := r.q.DeleteAttributeDefinition(ctx, db.DeleteAttributeDefinitionParams{ID: id, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return models.ErrNotFound
+	}
+	return nil
+}
+
+// ListAttributeDefinitions - определения категории и её предков
+func (r *Repository) ListAttributeDefinitions(ctx context.Context, categoryID string) ([]models.AttributeDefinition, error) {
+	if _, err := r.GetCategory(ctx, categoryID); err != nil {
+		return nil, err
+	}
+	ress, err := r.q.ListCategoryAttributeDefinitions(ctx, db.ListCategoryAttributeDefinitionsParams{ID: categoryID, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]models.AttributeDefinition, len(ress))
+	for i, res := range ress {
+		result[i] = definitionFromDB(res)
+	}
+	return result, nil
+}
+
+// ProductAttributeDefinitions - определения всех категорий товара и их предков
+func (r *Repository) ProductAttributeDefinitions(ctx context.Context, productID string) ([]models.AttributeDefinition, error) {
+	ress, err := r.q.ListProductAttributeDefinitions(ctx, db.ListProductAttributeDefinitionsParams{ProductID: productID, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]models.AttributeDefinition, len(ress))
+	for i, res := range ress {
+		result[i] = definitionFromDB(db.ListCategoryAttributeDefinitionsRow(res))
+	}
+	return result, nil
+}
+
+func (r *Repository) SetProductAttributes(ctx context.Context, productID string, attrs models.Attributes) error {
+	if attrs == nil {
+		attrs = models.Attributes{}
+	}
+	data, err := json.Marshal(attrs)
+	if err != nil {
+		return err
+	}
+	rows, err := r.q.SetProductAttributes(ctx, db.SetProductAttributesParams{ID: productID, Attributes: data, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return models.ErrNotFound
+	}
+	return nil
+}
+
+func (r *Repository) GetProductAttributes(ctx context.Context, productID string) (models.Attributes, error) {
+	data, err := r.q.GetProductAttributes(ctx, db.GetProductAttributesParams{ID: productID, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrNotFound
+		}
+		return nil, err
+	}
+	var attrs models.Attributes
+	if err := json.Unmarshal(data, &attrs); err != nil {
+		return nil, err
+	}
+	return attrs, nil
+}
+
+type anyOfFilter struct {
+	K string `json:"k"`
+	V []any  `json:"v"`
+}
+
+type rangeFilter struct {
+	K   string   `json:"k"`
+	Min *float64 `json:"min,omitempty"`
+	Max *float64 `json:"max,omitempty"`
+}
+
+// FilterProducts возвращает страницу товаров и фасеты по всем товарам,
+// подходящим под фильтр (без учета limit/offset)
+func (r *Repository) FilterProducts(ctx context.Context, f models.ProductFilter) (models.FilterResult, error) {
+	if f.CategoryID != "" {
+		if _, err := r.GetCategory(ctx, f.CategoryID); err != nil {
+			return models.FilterResult{}, err
+		}
+	}
+	anyOf := []anyOfFilter{}
+	ranges := []rangeFilter{}
+	for _, a := range f.Attributes {
+		if len(a.AnyOf) > 0 {
+			anyOf = append(anyOf, anyOfFilter{K: a.Code, V: a.AnyOf})
+		}
+		if a.Min != nil || a.Max != nil {
+			ranges = append(ranges, rangeFilter{K: a.Code, Min: a.Min, Max: a.Max})
+		}
+	}
+	anyOfJSON, err := json.Marshal(anyOf)
+	if err != nil {
+		return models.FilterResult{}, err
+	}
+	rangesJSON, err := json.Marshal(ranges)
+	if err != nil {
+		return models.FilterResult{}, err
+	}
+
+	all := auth.CatalogAdmin(ctx)
+	prods, err := r.q.FilterProducts(ctx, db.FilterProductsParams{
+		CategoryID:         f.CategoryID,
+		IncludeDescendants: f.IncludeDescendants,
+		TenantID:           auth.Tenant(ctx),
+		AllStatuses:        all,
+		AnyOf:              anyOfJSON,
+		Ranges:             rangesJSON,
+		Lim:                int32(f.Limit),
+		Off:                int32(f.Offset),
+	})
+	if err != nil {
+		return models.FilterResult{}, err
+	}
+	var result models.FilterResult
+	for _, p := range prods {
+		result.Products = append(result.Products, models.ProductDigest{ID: p.ID, Name: p.Name, Slug: p.Slug, Price: money.New(p.Price, p.Currency), Available: p.Available})
+	}
+
+	values, err := r.q.FilterProductsValueFacets(ctx, db.FilterProductsValueFacetsParams{
+		CategoryID:         f.CategoryID,
+		IncludeDescendants: f.IncludeDescendants,
+		TenantID:           auth.Tenant(ctx),
+		AllStatuses:        all,
+		AnyOf:              anyOfJSON,
+		Ranges:             rangesJSON,
+	})
+	if err != nil {
+		return models.FilterResult{}, err
+	}
+	// строки отсортированы по коду, поэтому значения одного атрибута идут подряд
+	for _, v := range values {
+		var val any
+		if err := json.Unmarshal(v.Value, &val); err != nil {
+			return models.FilterResult{}, err
+		}
+		if n := len(result.Facets); n == 0 || result.Facets[n-1].Code != v.Code {
+			result.Facets = append(result.Facets, models.Facet{Code: v.Code})
+		}
+		last := &result.Facets[len(result.Facets)-1]
+		last.Values = append(last.Values, models.FacetValue{Value: val, Count: int(v.Count)})
+	}
+
+	numbers, err := r.q.FilterProductsRangeFacets(ctx, db.FilterProductsRangeFacetsParams{
+		CategoryID:         f.CategoryID,
+		IncludeDescendants: f.IncludeDescendants,
+		TenantID:           auth.Tenant(ctx),
+		AllStatuses:        all,
+		AnyOf:              anyOfJSON,
+		Ranges:             rangesJSON,
+	})
+	if err != nil {
+		return models.FilterResult{}, err
+	}
+	for _, n := range numbers {
+		result.Facets = append(result.Facets, models.Facet{Code: n.Code, Min: &n.MinValue, Max: &n.MaxValue})
+	}
+	return result, nil
+}
+
+func definitionFromDB(d db.ListCategoryAttributeDefinitionsRow) models.AttributeDefinition {
+	return models.AttributeDefinition{
+		ID:         d.ID,
+		CategoryID: d.CategoryID,
+		Code:       d.Code,
+		Name:       d.Name,
+		Type:       models.AttributeType(d.Type),
+		EnumValues: d.EnumValues,
+	}
+}
@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+	"github.com/glekoz/online-shop_product/repository/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// auditState - поля товара, изменения которых пишутся в журнал.
+// Цена - в минимальных единицах валюты
+type auditState struct {
+	Name        string
+	Description string
+	Price       int64
+	Currency    string
+	SKU         string
+	// статус и расписание; время - RFC 3339, пусто - не запланировано
+	Status      string
+	PublishAt   string
+	UnpublishAt string
+}
+
+func (s *auditState) fields() map[string]any {
+	if s == nil {
+		return nil
+	}
+	f := map[string]any{
+		"name":        s.Name,
+		"description": s.Description,
+		"price":       s.Price,
+		"currency":    s.Currency,
+	}
+	if s.SKU != "" {
+		f["sku"] = s.SKU
+	}
+	for k, v := range map[string]string{"status": s.Status, "publish_at": s.PublishAt, "unpublish_at": s.UnpublishAt} {
+		if v != "" {
+			f[k] = v
+		}
+	}
+	return f
+}
+
+func (s *auditState) withPrice(price money.Money) *auditState {
+	next := *s
+	next.Price, next.Currency = price.Amount, price.Currency
+	return &next
+}
+
+type auditChange struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+// auditDiff - JSON изменившихся полей; false, если не изменилось ничего.
+// old == nil - товар создан, cur == nil - удален
+func auditDiff(old, cur *auditState) ([]byte, bool, error) {
+	o, n := old.fields(), cur.fields()
+	diff := make(map[string]auditChange)
+	for k, v := range o {
+		if nv, ok := n[k]; !ok || nv != v {
+			diff[k] = auditChange{Old: v, New: nv}
+		}
+	}
+	for k, v := range n {
+		if _, ok := o[k]; !ok {
+			diff[k] = auditChange{New: v}
+		}
+	}
+	if len(diff) == 0 {
+		return nil, false, nil
+	}
+	b, err := json.Marshal(diff)
+	return b, true, err
+}
+
+// auditEntry готовит запись журнала; кто и в каком запросе менял товар,
+// берется из контекста. false - писать нечего
+func auditEntry(ctx context.Context, productID string, action models.AuditAction, old, cur *auditState) (db.AddAuditEntryParams, bool, error) {
+	diff, changed, err := auditDiff(old, cur)
+	if err != nil || !changed {
+		return db.AddAuditEntryParams{}, false, err
+	}
+	var requestID string
+	if ld, ok := ctx.Value(log.LogDataKey).(log.LogData); ok {
+		requestID = ld.RequestID
+	}
+	return db.AddAuditEntryParams{
+		ProductID: productID,
+		Action:    string(action),
+		Actor:     auth.Actor(ctx),
+		RequestID: requestID,
+		Diff:      diff,
+		TenantID:  auth.Tenant(ctx),
+	}, true, nil
+}
+
+// addAudit пишет запись журнала в транзакции q
+func addAudit(ctx context.Context, q *db.Queries, productID string, action models.AuditAction, old, cur *auditState) error {
+	entry, ok, err := auditEntry(ctx, productID, action, old, cur)
+	if err != nil || !ok {
+		return err
+	}
+	return q.AddAuditEntry(ctx, entry)
+}
+
+// copyAudit - то же для многих товаров сразу, через COPY
+func copyAudit(ctx context.Context, q *db.Queries, entries []db.CopyAuditEntriesParams) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	_, err := q.CopyAuditEntries(ctx, entries)
+	return err
+}
+
+func (r *Repository) ListAudit(ctx context.Context, f models.AuditFilter) ([]models.AuditEntry, error) {
+	ress, err := r.q.ListAuditEntries(ctx, db.ListAuditEntriesParams{
+		TenantID:  auth.Tenant(ctx),
+		ProductID: pgtype.Text{String: f.ProductID, Valid: f.ProductID != ""},
+		Actor:     pgtype.Text{String: f.Actor, Valid: f.Actor != ""},
+		Lim:       int32(f.Limit),
+		Off:       int32(f.Offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]models.AuditEntry, len(ress))
+	for i, res := range ress {
+		result[i] = models.AuditEntry{
+			ID:        res.ID,
+			ProductID: res.ProductID,
+			Action:    models.AuditAction(res.Action),
+			Actor:     res.Actor,
+			RequestID: res.RequestID,
+			Diff:      res.Diff,
+			CreatedAt: res.CreatedAt.Time,
+		}
+	}
+	return result, nil
+}
+
+func productState(p models.Product) *auditState {
+	return &auditState{Name: p.Name, Description: p.Description, Price: p.Price.Amount, Currency: p.Price.Currency}
+}
+
+func stateFromDB(name, description string, price int64, currency string, sku pgtype.Text) *auditState {
+	return &auditState{Name: name, Description: description, Price: price, Currency: currency, SKU: sku.String}
+}
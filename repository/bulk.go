@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+	"github.com/glekoz/online-shop_product/repository/db"
+)
+
+// BulkUpdatePrices меняет базовые цены одной транзакцией, пачками запросов.
+// Элементы, которые нельзя применить (товара нет, цена вышла не больше нуля),
+// помечаются ошибкой; при atomic тогда не применяется ни один. Ошибка базы
+// откатывает все в любом режиме
+func (r *Repository) BulkUpdatePrices(ctx context.Context, updates []models.PriceUpdate, atomic bool) ([]models.BulkItemResult, error) {
+	results := make([]models.BulkItemResult, len(updates))
+	var changed []string
+	tenant := auth.Tenant(ctx)
+	err := r.inTx(ctx, func(q *db.Queries) error {
+		ids := make([]string, len(updates))
+		for i, u := range updates {
+			ids[i] = u.ProductID
+		}
+		locked, err := q.LockProducts(ctx, db.LockProductsParams{TenantID: tenant, Ids: ids})
+		if err != nil {
+			return err
+		}
+		cur := make(map[string]db.LockProductsRow, len(locked))
+		for _, p := range locked {
+			cur[p.ID] = p
+		}
+
+		var (
+			sets      []db.SetProductBasePricesParams
+			overrides []db.DeleteProductPriceOverridesParams
+			closes    []db.ClosePriceHistoriesParams
+			prices    []db.CopyPriceHistoryParams
+			audits    []db.CopyAuditEntriesParams
+			failed    bool
+			now       = timestamptz(time.Now())
+		)
+		for i, u := range updates {
+			res := &results[i]
+			res.ID = u.ProductID
+			row, ok := cur[u.ProductID]
+			if !ok {
+				res.Status, res.Error, failed = models.BulkFailed, models.ErrNotFound.Error(), true
+				continue
+			}
+			old := money.New(row.Price, row.Currency)
+			price := u.Price
+			if u.ChangeBP != 0 {
+				if price, err = old.ChangeBy(u.ChangeBP); err != nil {
+					res.Status, res.Error, failed = models.BulkFailed, err.Error(), true
+					continue
+				}
+			}
+			res.OldPrice, res.NewPrice = old, price
+			if price.Amount <= 0 {
+				res.Status, res.Error, failed = models.BulkFailed, "price must be greater than 0", true
+				continue
+			}
+			res.Status = models.BulkApplied
+			if price == old {
+				continue
+			}
+			changed = append(changed, u.ProductID)
+			sets = append(sets, db.SetProductBasePricesParams{ID: u.ProductID, Price: price.Amount, Currency: price.Currency, TenantID: tenant})
+			overrides = append(overrides, db.DeleteProductPriceOverridesParams{ProductID: u.ProductID, TenantID: tenant, Currency: price.Currency})
+			closes = append(closes, db.ClosePriceHistoriesParams{EffectiveTo: now, ProductID: u.ProductID, TenantID: tenant})
+			prices = append(prices, db.CopyPriceHistoryParams{
+				ProductID:     u.ProductID,
+				Amount:        price.Amount,
+				Currency:      price.Currency,
+				EffectiveFrom: now,
+				Applied:       true,
+				TenantID:      tenant,
+			})
+			prev := stateFromDB(row.Name, row.Description, row.Price, row.Currency, row.ExternalSku)
+			entry, _, err := auditEntry(ctx, u.ProductID, models.AuditUpdate, prev, prev.withPrice(price))
+			if err != nil {
+				return err
+			}
+			audits = append(audits, db.CopyAuditEntriesParams(entry))
+		}
+		if atomic && failed {
+			skipApplied(results)
+			changed = nil
+			return nil
+		}
+		if len(sets) == 0 {
+			return nil
+		}
+		if err := execBatch(q.SetProductBasePrices(ctx, sets)); err != nil {
+			return err
+		}
+		if err := execBatch(q.DeleteProductPriceOverrides(ctx, overrides)); err != nil {
+			return err
+		}
+		if err := execBatch(q.ClosePriceHistories(ctx, closes)); err != nil {
+			return err
+		}
+		if _, err := q.CopyPriceHistory(ctx, prices); err != nil {
+			return err
+		}
+		return copyAudit(ctx, q, audits)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range changed {
+		r.cache.Delete(cacheKey(ctx, id))
+	}
+	return results, nil
+}
+
+// BulkDelete удаляет товары одной транзакцией и возвращает их картинки,
+// чтобы вызывающий удалил файлы. Ненайденные id - ошибки элементов;
+// при atomic тогда не удаляется ничего
+func (r *Repository) BulkDelete(ctx context.Context, ids []string, atomic bool) ([]models.BulkItemResult, []models.Image, error) {
+	results := make([]models.BulkItemResult, len(ids))
+	var deleted []string
+	var imgs []models.Image
+	tenant := auth.Tenant(ctx)
+	err := r.inTx(ctx, func(q *db.Queries) error {
+		locked, err := q.LockProducts(ctx, db.LockProductsParams{TenantID: tenant, Ids: ids})
+		if err != nil {
+			return err
+		}
+		cur := make(map[string]db.LockProductsRow, len(locked))
+		for _, p := range locked {
+			cur[p.ID] = p
+		}
+		failed := false
+		var audits []db.CopyAuditEntriesParams
+		for i, id := range ids {
+			results[i].ID = id
+			row, ok := cur[id]
+			if !ok {
+				results[i].Status, results[i].Error, failed = models.BulkFailed, models.ErrNotFound.Error(), true
+				continue
+			}
+			results[i].Status = models.BulkApplied
+			deleted = append(deleted, id)
+			old := stateFromDB(row.Name, row.Description, row.Price, row.Currency, row.ExternalSku)
+			entry, _, err := auditEntry(ctx, id, models.AuditDelete, old, nil)
+			if err != nil {
+				return err
+			}
+			audits = append(audits, db.CopyAuditEntriesParams(entry))
+		}
+		if atomic && failed {
+			skipApplied(results)
+			deleted = nil
+			return nil
+		}
+		if len(deleted) == 0 {
+			return nil
+		}
+		ress, err := q.ListImagesOfProducts(ctx, db.ListImagesOfProductsParams{TenantID: tenant, ProductIds: deleted})
+		if err != nil {
+			return err
+		}
+		if imgs, err = imagesFromDB(ress); err != nil {
+			return err
+		}
+		// картинки, цены, остатки и прочее удалит каскад
+		if _, err := q.DeleteProducts(ctx, db.DeleteProductsParams{TenantID: tenant, Ids: deleted}); err != nil {
+			return err
+		}
+		return copyAudit(ctx, q, audits)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, id := range deleted {
+		r.cache.Delete(cacheKey(ctx, id))
+	}
+	return results, imgs, nil
+}
+
+// skipApplied - атомарная операция не прошла: годные элементы не применены
+func skipApplied(results []models.BulkItemResult) {
+	for i := range results {
+		if results[i].Status == models.BulkApplied {
+			results[i].Status = models.BulkSkipped
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+	"github.com/glekoz/online-shop_product/repository/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *Repository) CreateCategory(ctx context.Context, id string, c models.Category) error {
+	err := r.q.CreateCategory(ctx, db.CreateCategoryParams{
+		ID:       id,
+		ParentID: nullText(c.ParentID),
+		Name:     c.Name,
+		Slug:     c.Slug,
+		TenantID: auth.Tenant(ctx),
+	})
+	return categoryError(err)
+}
+
+func (r *Repository) GetCategory(ctx context.Context, id string) (models.Category, error) {
+	res, err := r.q.GetCategory(ctx, db.GetCategoryParams{ID: id, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Category{}, models.ErrNotFound
+		}
+		return models.Category{}, err
+	}
+	return models.Category{ID: res.ID, ParentID: res.ParentID.String, Name: res.Name, Slug: res.Slug}, nil
+}
+
+func (r *Repository) GetCategoryBySlug(ctx context.Context, slug string) (models.Category, error) {
+	res, err := r.q.GetCategoryBySlug(ctx, db.GetCategoryBySlugParams{Slug: slug, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Category{}, models.ErrNotFound
+		}
+		return models.Category{}, err
+	}
+	return models.Category{ID: res.ID, ParentID: res.ParentID.String, Name: res.Name, Slug: res.Slug}, nil
+}
+
+func (r *Repository) ListCategories(ctx context.Context) ([]models.Category, error) {
+	ress, err := r.q.ListCategories(ctx, auth.Tenant(ctx))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]models.Category, len(ress))
+	for i, res := range ress {
+		result[i] = models.Category{ID: res.ID, ParentID: res.ParentID.String, Name: res.Name, Slug: res.Slug}
+	}
+	return result, nil
+}
+
+// UpdateCategory в одной транзакции проверяет, что новый родитель
+// не лежит в поддереве самой категории, иначе получится цикл. Переносы
+// внутри магазина идут по очереди под advisory lock
+func (r *Repository) UpdateCategory(ctx context.Context, id string, c models.Category) error {
+	return r.inTx(ctx, func(q *db.Queries) error {
+		if c.ParentID != "" {
+			if err := q.LockCategoryTree(ctx, auth.Tenant(ctx)); err != nil {
+				return err
+			}
+			subtree, err := q.CategorySubtreeIDs(ctx, db.CategorySubtreeIDsParams{ID: id, TenantID: auth.Tenant(ctx)})
+			if err != nil {
+				return err
+			}
+			if slices.Contains(subtree, c.ParentID) {
+				return models.ErrCategoryCycle
+			}
+		}
+		rows, err := q.UpdateCategory(ctx, db.UpdateCategoryParams{
+			ID:       id,
+			ParentID: nullText(c.ParentID),
+			Name:     c.Name,
+			Slug:     c.Slug,
+			TenantID: auth.Tenant(ctx),
+		})
+		if err != nil {
+			return categoryError(err)
+		}
+		if rows < 1 {
+			return models.ErrNotFound
+		}
+		return nil
+	})
+}
+
+func (r *Repository) DeleteCategory(ctx context.Context, id string) error {
+	rows, err := r.q.DeleteCategory(ctx, db.DeleteCategoryParams{ID: id, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		var errp *pgconn.PgError
+		if errors.As(err, &errp) && errp.Code == models.ForeignKeyErrCode {
+			return models.ErrHasChildren
+		}
+		return err
+	}
+	if rows < 1 {
+		return models.ErrNotFound
+	}
+	return nil
+}
+
+// SetProductCategories заменяет набор категорий товара целиком
+func (r *Repository) SetProductCategories(ctx context.Context, productID string, categoryIDs []string) error {
+	tenant := auth.Tenant(ctx)
+	return r.inTx(ctx, func(q *db.Queries) error {
+		if _, err := q.Get(ctx, db.GetParams{ID: productID, TenantID: tenant}); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return models.ErrNotFound
+			}
+			return err
+		}
+		if err := q.DeleteProductCategories(ctx, db.DeleteProductCategoriesParams{ProductID: productID, TenantID: tenant}); err != nil {
+			return err
+		}
+		for _, cid := range categoryIDs {
+			err := q.AddProductCategory(ctx, db.AddProductCategoryParams{ProductID: productID, CategoryID: cid, TenantID: tenant})
+			if err != nil {
+				return categoryError(err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *Repository) GetProductCategories(ctx context.Context, productID string) ([]models.Category, error) {
+	ress, err := r.q.ListProductCategories(ctx, db.ListProductCategoriesParams{ProductID: productID, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]models.Category, len(ress))
+	for i, res := range ress {
+		result[i] = models.Category{ID: res.ID, ParentID: res.ParentID.String, Name: res.Name, Slug: res.Slug}
+	}
+	return result, nil
+}
+
+func (r *Repository) ListProductsByCategory(ctx context.Context, categoryID string, descendants bool, limit, offset int) ([]models.ProductDigest, error) {
+	if _, err := r.GetCategory(ctx, categoryID); err != nil {
+		return nil, err
+	}
+	var result []models.ProductDigest
+	if descendants {
+		ress, err := r.q.ListProductsInCategoryTree(ctx, db.ListProductsInCategoryTreeParams{
+			CategoryID:  categoryID,
+			TenantID:    auth.Tenant(ctx),
+			AllStatuses: auth.CatalogAdmin(ctx),
+			Lim:         int32(limit),
+			Off:         int32(offset),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, res := range ress {
+			result = append(result, models.ProductDigest{ID: res.ID, Name: res.Name, Slug: res.Slug, Price: money.New(res.Price, res.Currency), Available: res.Available})
+		}
+		return result, nil
+	}
+	ress, err := r.q.ListProductsInCategory(ctx, db.ListProductsInCategoryParams{
+		CategoryID:  categoryID,
+		TenantID:    auth.Tenant(ctx),
+		AllStatuses: auth.CatalogAdmin(ctx),
+		Lim:         int32(limit),
+		Off:         int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, res := range ress {
+		result = append(result, models.ProductDigest{ID: res.ID, Name: res.Name, Slug: res.Slug, Price: money.New(res.Price, res.Currency), Available: res.Available})
+	}
+	return result, nil
+}
+
+// categoryError переводит ошибки ограничений Postgres в ошибки моделей:
+// занятый slug - ErrAlreadyExists, несуществующий родитель или товар - ErrNotFound
+func categoryError(err error) error {
+	var errp *pgconn.PgError
+	if errors.As(err, &errp) {
+		switch errp.Code {
+		case models.UniqueErrCode:
+			return models.ErrAlreadyExists
+		case models.ForeignKeyErrCode:
+			return models.ErrNotFound
+		}
+	}
+	return err
+}
+
+func nullText(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: s != ""}
+}
@@ -7,19 +7,24 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const create = `-- name: Create :exec
 
-INSERT INTO products(id, name, price, description)
-VALUES ($1, $2, $3, $4)
+INSERT INTO products(id, name, price, currency, description, tenant_id, slug)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 `
 
 type CreateParams struct {
 	ID          string
 	Name        string
-	Price       int32
+	Price       int64
+	Currency    string
 	Description string
+	TenantID    string
+	Slug        string
 }
 
 // Я использую pgx, в котором если не найдена строка
@@ -31,7 +36,10 @@ func (q *Queries) Create(ctx context.Context, arg CreateParams) error {
 		arg.ID,
 		arg.Name,
 		arg.Price,
+		arg.Currency,
 		arg.Description,
+		arg.TenantID,
+		arg.Slug,
 	)
 	return err
 }
@@ -39,11 +47,16 @@ func (q *Queries) Create(ctx context.Context, arg CreateParams) error {
 const delete = `-- name: Delete :execrows
 DELETE
 FROM products
-WHERE id = $1
+WHERE id = $1 AND tenant_id = $2
 `
 
-func (q *Queries) Delete(ctx context.Context, id string) (int64, error) {
-	result, err := q.db.Exec(ctx, delete, id)
+type DeleteParams struct {
+	ID       string
+	TenantID string
+}
+
+func (q *Queries) Delete(ctx context.Context, arg DeleteParams) (int64, error) {
+	result, err := q.db.Exec(ctx, delete, arg.ID, arg.TenantID)
 	if err != nil {
 		return 0, err
 	}
@@ -51,37 +64,66 @@ func (q *Queries) Delete(ctx context.Context, id string) (int64, error) {
 }
 
 const get = `-- name: Get :one
-SELECT name, price, description
+SELECT name, price, currency, description, slug, status, publish_at, unpublish_at
 FROM products
-WHERE id = $1
+WHERE id = $1 AND tenant_id = $2
 `
 
+type GetParams struct {
+	ID       string
+	TenantID string
+}
+
 type GetRow struct {
 	Name        string
-	Price       int32
+	Price       int64
+	Currency    string
 	Description string
+	Slug        string
+	Status      string
+	PublishAt   pgtype.Timestamptz
+	UnpublishAt pgtype.Timestamptz
 }
 
-func (q *Queries) Get(ctx context.Context, id string) (GetRow, error) {
-	row := q.db.QueryRow(ctx, get, id)
+func (q *Queries) Get(ctx context.Context, arg GetParams) (GetRow, error) {
+	row := q.db.QueryRow(ctx, get, arg.ID, arg.TenantID)
 	var i GetRow
-	err := row.Scan(&i.Name, &i.Price, &i.Description)
+	err := row.Scan(
+		&i.Name,
+		&i.Price,
+		&i.Currency,
+		&i.Description,
+		&i.Slug,
+		&i.Status,
+		&i.PublishAt,
+		&i.UnpublishAt,
+	)
 	return i, err
 }
 
 const getAll = `-- name: GetAll :many
-SELECT id, name, price
-FROM products
+SELECT p.id, p.name, p.slug, p.price, p.currency, product_in_stock(p.id) AS available
+FROM products p
+WHERE p.tenant_id = $1 AND ($2::boolean OR p.status = 'published')
 `
 
+type GetAllParams struct {
+	TenantID    string
+	AllStatuses bool
+}
+
 type GetAllRow struct {
-	ID    string
-	Name  string
-	Price int32
+	ID        string
+	Name      string
+	Slug      string
+	Price     int64
+	Currency  string
+	Available bool
 }
 
-func (q *Queries) GetAll(ctx context.Context) ([]GetAllRow, error) {
-	rows, err := q.db.Query(ctx, getAll)
+// all_statuses = false - только опубликованные, как видит витрина
+func (q *Queries) GetAll(ctx context.Context, arg GetAllParams) ([]GetAllRow, error) {
+	rows, err := q.db.Query(ctx, getAll, arg.TenantID, arg.AllStatuses)
 	if err != nil {
 		return nil, err
 	}
@@ -89,7 +131,110 @@ func (q *Queries) GetAll(ctx context.Context) ([]GetAllRow, error) {
 	var items []GetAllRow
 	for rows.Next() {
 		var i GetAllRow
-		if err := rows.Scan(&i.ID, &i.Name, &i.Price); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Slug,
+			&i.Price,
+			&i.Currency,
+			&i.Available,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getForUpdate = `-- name: GetForUpdate :one
+SELECT name, price, currency, description, external_sku, slug, status, publish_at, unpublish_at
+FROM products
+WHERE id = $1 AND tenant_id = $2
+FOR UPDATE
+`
+
+type GetForUpdateParams struct {
+	ID       string
+	TenantID string
+}
+
+type GetForUpdateRow struct {
+	Name        string
+	Price       int64
+	Currency    string
+	Description string
+	ExternalSku pgtype.Text
+	Slug        string
+	Status      string
+	PublishAt   pgtype.Timestamptz
+	UnpublishAt pgtype.Timestamptz
+}
+
+// текущие значения под блокировкой: для истории цен и аудита
+func (q *Queries) GetForUpdate(ctx context.Context, arg GetForUpdateParams) (GetForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getForUpdate, arg.ID, arg.TenantID)
+	var i GetForUpdateRow
+	err := row.Scan(
+		&i.Name,
+		&i.Price,
+		&i.Currency,
+		&i.Description,
+		&i.ExternalSku,
+		&i.Slug,
+		&i.Status,
+		&i.PublishAt,
+		&i.UnpublishAt,
+	)
+	return i, err
+}
+
+const getMany = `-- name: GetMany :many
+SELECT id, name, price, currency, description, slug, status, publish_at, unpublish_at
+FROM products
+WHERE tenant_id = $1 AND id = ANY($2::text[])
+`
+
+type GetManyParams struct {
+	TenantID string
+	Ids      []string
+}
+
+type GetManyRow struct {
+	ID          string
+	Name        string
+	Price       int64
+	Currency    string
+	Description string
+	Slug        string
+	Status      string
+	PublishAt   pgtype.Timestamptz
+	UnpublishAt pgtype.Timestamptz
+}
+
+// Все найденные из ids за один запрос, порядок не гарантирован
+func (q *Queries) GetMany(ctx context.Context, arg GetManyParams) ([]GetManyRow, error) {
+	rows, err := q.db.Query(ctx, getMany, arg.TenantID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetManyRow
+	for rows.Next() {
+		var i GetManyRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Price,
+			&i.Currency,
+			&i.Description,
+			&i.Slug,
+			&i.Status,
+			&i.PublishAt,
+			&i.UnpublishAt,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -103,26 +248,33 @@ func (q *Queries) GetAll(ctx context.Context) ([]GetAllRow, error) {
 const orderedOffsetGetAll = `-- name: OrderedOffsetGetAll :many
 SELECT id, name, price, description
 FROM products
+WHERE tenant_id = $4
 ORDER BY $1
 LIMIT $2
 OFFSET $3
 `
 
 type OrderedOffsetGetAllParams struct {
-	Column1 interface{}
-	Limit   int32
-	Offset  int32
+	Column1  interface{}
+	Limit    int32
+	Offset   int32
+	TenantID string
 }
 
 type OrderedOffsetGetAllRow struct {
 	ID          string
 	Name        string
-	Price       int32
+	Price       int64
 	Description string
 }
 
 func (q *Queries) OrderedOffsetGetAll(ctx context.Context, arg OrderedOffsetGetAllParams) ([]OrderedOffsetGetAllRow, error) {
-	rows, err := q.db.Query(ctx, orderedOffsetGetAll, arg.Column1, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, orderedOffsetGetAll,
+		arg.Column1,
+		arg.Limit,
+		arg.Offset,
+		arg.TenantID,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -149,15 +301,17 @@ func (q *Queries) OrderedOffsetGetAll(ctx context.Context, arg OrderedOffsetGetA
 const update = `-- name: Update :execrows
 
 UPDATE products
-SET name = $2, price = $3, description = $4
-WHERE id = $1
+SET name = $2, price = $3, currency = $4, description = $5
+WHERE id = $1 AND tenant_id = $6
 `
 
 type UpdateParams struct {
 	ID          string
 	Name        string
-	Price       int32
+	Price       int64
+	Currency    string
 	Description string
+	TenantID    string
 }
 
 // тут сначала будет Гет, потом из переданных в функцию
@@ -168,7 +322,9 @@ func (q *Queries) Update(ctx context.Context, arg UpdateParams) (int64, error) {
 		arg.ID,
 		arg.Name,
 		arg.Price,
+		arg.Currency,
 		arg.Description,
+		arg.TenantID,
 	)
 	if err != nil {
 		return 0, err
@@ -8,11 +8,188 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AttributeDefinition struct {
+	ID         string
+	CategoryID string
+	Code       string
+	Name       string
+	Type       string
+	EnumValues []string
+	TenantID   string
+}
+
+type AuditLog struct {
+	ID        int64
+	ProductID string
+	Action    string
+	Actor     string
+	RequestID string
+	Diff      []byte
+	CreatedAt pgtype.Timestamptz
+	TenantID  string
+}
+
+type Category struct {
+	ID        string
+	ParentID  pgtype.Text
+	Name      string
+	Slug      string
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+	TenantID  string
+}
+
+type CurrencyRate struct {
+	Base      string
+	Quote     string
+	Rate      pgtype.Numeric
+	Rounding  string
+	Increment int64
+	UpdatedAt pgtype.Timestamp
+	TenantID  string
+}
+
+type PriceHistory struct {
+	ID            int64
+	ProductID     string
+	Amount        int64
+	Currency      string
+	EffectiveFrom pgtype.Timestamptz
+	EffectiveTo   pgtype.Timestamptz
+	Applied       bool
+	CreatedAt     pgtype.Timestamptz
+	TenantID      string
+}
+
 type Product struct {
+	ID                string
+	Name              string
+	Price             int64
+	Description       string
+	CreatedAt         pgtype.Timestamp
+	UpdatedAt         pgtype.Timestamp
+	Attributes        []byte
+	LowStockThreshold int32
+	Currency          string
+	SearchLanguage    interface{}
+	SearchVector      interface{}
+	ExternalSku       pgtype.Text
+	TenantID          string
+	Slug              string
+	Status            string
+	PublishAt         pgtype.Timestamptz
+	UnpublishAt       pgtype.Timestamptz
+}
+
+type ProductCategory struct {
+	ProductID  string
+	CategoryID string
+	TenantID   string
+}
+
+type ProductImage struct {
 	ID          string
+	ProductID   string
+	Position    int32
+	IsPrimary   bool
+	StorageKey  string
+	ContentType string
+	Size        int64
+	Width       int32
+	Height      int32
+	Alt         string
+	Thumbnails  []byte
+	CreatedAt   pgtype.Timestamptz
+	TenantID    string
+}
+
+type ProductPrice struct {
+	ProductID string
+	Currency  string
+	Amount    int64
+	UpdatedAt pgtype.Timestamp
+	TenantID  string
+}
+
+type ProductSlug struct {
+	TenantID  string
+	Slug      string
+	ProductID string
+	CreatedAt pgtype.Timestamptz
+}
+
+type ProductTranslation struct {
+	TenantID    string
+	ProductID   string
+	Locale      string
 	Name        string
-	Price       int32
 	Description string
-	CreatedAt   pgtype.Timestamp
-	UpdatedAt   pgtype.Timestamp
+	UpdatedAt   pgtype.Timestamptz
+}
+
+type ProductVariant struct {
+	ID            string
+	ProductID     string
+	Sku           string
+	PriceOverride pgtype.Int8
+	Options       []byte
+	Barcode       pgtype.Text
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+	TenantID      string
+}
+
+type Promotion struct {
+	ID        string
+	Name      string
+	Kind      string
+	Value     int64
+	Currency  pgtype.Text
+	Target    string
+	TargetID  pgtype.Text
+	StartsAt  pgtype.Timestamptz
+	EndsAt    pgtype.Timestamptz
+	Priority  int32
+	Stackable bool
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+	TenantID  string
+}
+
+type StockLevel struct {
+	ProductID string
+	Location  string
+	Quantity  int32
+	UpdatedAt pgtype.Timestamp
+	Reserved  int32
+	TenantID  string
+}
+
+type StockMovement struct {
+	ID            int64
+	ProductID     string
+	Location      string
+	Delta         int32
+	QuantityAfter int32
+	Reason        string
+	Actor         string
+	CreatedAt     pgtype.Timestamp
+	TenantID      string
+}
+
+type StockReservation struct {
+	ID        string
+	Status    string
+	ExpiresAt pgtype.Timestamp
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+	TenantID  string
+}
+
+type StockReservationItem struct {
+	ReservationID string
+	ProductID     string
+	Location      string
+	Quantity      int32
+	TenantID      string
 }
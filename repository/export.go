@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+	"github.com/glekoz/online-shop_product/repository/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Export проходит по товарам серверным курсором и отдает их fn пачками
+// в порядке id. Ошибка fn прерывает выгрузку и возвращается как есть.
+// Транзакция держится, пока идет выгрузка, поэтому fn не должна надолго
+// блокироваться - медленного клиента лучше отсечь таймаутом
+func (r *Repository) Export(ctx context.Context, f models.ExportFilter, fn func([]models.ExportRow) error) error {
+	tenant := auth.Tenant(ctx)
+	return r.inTx(ctx, func(q *db.Queries) error {
+		// пустой список в запросе значит "все категории", а не "ни одной"
+		categories := []string{}
+		if f.CategoryID != "" {
+			ids, err := q.CategorySubtreeIDs(ctx, db.CategorySubtreeIDsParams{ID: f.CategoryID, TenantID: tenant})
+			if err != nil {
+				return err
+			}
+			if len(ids) == 0 {
+				return models.ErrNotFound
+			}
+			categories = ids
+		}
+		params := db.DeclareExportCursorParams{
+			TenantID:      tenant,
+			CategoryIds:   categories,
+			Currency:      pgtype.Text{String: f.Currency, Valid: f.Currency != ""},
+			InStockOnly:   f.InStockOnly,
+			PublishedOnly: f.PublishedOnly,
+		}
+		if !f.UpdatedSince.IsZero() {
+			// updated_at - TIMESTAMP без пояса, сравниваем в UTC
+			params.UpdatedSince = pgtype.Timestamp{Time: f.UpdatedSince.UTC(), Valid: true}
+		}
+		if err := q.DeclareExportCursor(ctx, params); err != nil {
+			return err
+		}
+		for {
+			rows, err := q.FetchExportCursor(ctx)
+			if err != nil {
+				return err
+			}
+			if len(rows) == 0 {
+				return nil
+			}
+			batch := make([]models.ExportRow, len(rows))
+			for i, row := range rows {
+				batch[i] = models.ExportRow{
+					ProductDigest: models.ProductDigest{
+						ID:        row.ID,
+						Name:      row.Name,
+						Slug:      row.Slug,
+						Price:     money.New(row.Price, row.Currency),
+						Available: row.Available,
+					},
+					SKU:         row.ExternalSku.String,
+					Description: row.Description,
+					UpdatedAt:   row.UpdatedAt.Time,
+				}
+			}
+			if err := fn(batch); err != nil {
+				return err
+			}
+		}
+	})
+}
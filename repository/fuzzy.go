@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+	"github.com/glekoz/online-shop_product/repository/db"
+)
+
+// FuzzySearch ищет товары по названию с опечатками; Rank в результате - сходство
+func (r *Repository) FuzzySearch(ctx context.Context, fq models.FuzzyQuery) (models.SearchResult, error) {
+	var result models.SearchResult
+	err := r.inTx(ctx, func(q *db.Queries) error {
+		if err := q.SetTrigramThreshold(ctx, fq.Threshold); err != nil {
+			return err
+		}
+		ress, err := q.FuzzySearchProducts(ctx, db.FuzzySearchProductsParams{
+			Query:       fq.Query,
+			TenantID:    auth.Tenant(ctx),
+			AllStatuses: auth.CatalogAdmin(ctx),
+			Lim:         int32(fq.Limit),
+			Off:         int32(fq.Offset),
+		})
+		if err != nil {
+			return err
+		}
+		result.Hits = make([]models.SearchHit, len(ress))
+		for i, res := range ress {
+			result.Hits[i] = models.SearchHit{
+				ProductDigest: models.ProductDigest{
+					ID:        res.ID,
+					Name:      res.Name,
+					Slug:      res.Slug,
+					Price:     money.New(res.Price, res.Currency),
+					Available: res.Available,
+				},
+				Rank: res.Similarity,
+			}
+			result.Total = int(res.Total)
+		}
+		return nil
+	})
+	return result, err
+}
+
+func (r *Repository) SuggestNames(ctx context.Context, query string, threshold float32, limit int) ([]string, error) {
+	var names []string
+	err := r.inTx(ctx, func(q *db.Queries) error {
+		if err := q.SetTrigramThreshold(ctx, threshold); err != nil {
+			return err
+		}
+		var err error
+		names, err = q.SuggestProductNames(ctx, db.SuggestProductNamesParams{
+			Query:       query,
+			TenantID:    auth.Tenant(ctx),
+			AllStatuses: auth.CatalogAdmin(ctx),
+			Lim:         int32(limit),
+		})
+		return err
+	})
+	return names, err
+}
+
+func (r *Repository) SimilarProducts(ctx context.Context, name string, threshold float32, limit int) ([]models.SimilarProduct, error) {
+	var result []models.SimilarProduct
+	err := r.inTx(ctx, func(q *db.Queries) error {
+		if err := q.SetTrigramThreshold(ctx, threshold); err != nil {
+			return err
+		}
+		ress, err := q.SimilarProducts(ctx, db.SimilarProductsParams{Name: name, TenantID: auth.Tenant(ctx), Lim: int32(limit)})
+		if err != nil {
+			return err
+		}
+		result = make([]models.SimilarProduct, len(ress))
+		for i, res := range ress {
+			result[i] = models.SimilarProduct{ID: res.ID, Name: res.Name, Similarity: res.Similarity}
+		}
+		return nil
+	})
+	return result, err
+}
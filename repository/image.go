@@ -0,0 +1,227 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/repository/db"
+	"github.com/jackc/pgx/v5"
+)
+
+type thumbnailJSON struct {
+	Name        string `json:"name"`
+	Key         string `json:"key"`
+	ContentType string `json:"content_type"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+}
+
+// AddImage добавляет картинку в конец списка товара; Position и Primary
+// в img игнорируются и возвращаются заполненными
+func (r *Repository) AddImage(ctx context.Context, img models.Image) (models.Image, error) {
+	thumbs := make([]thumbnailJSON, len(img.Thumbnails))
+	for i, t := range img.Thumbnails {
+		thumbs[i] = thumbnailJSON{Name: t.Name, Key: t.Key, ContentType: t.ContentType, Width: t.Width, Height: t.Height}
+	}
+	data, err := json.Marshal(thumbs)
+	if err != nil {
+		return models.Image{}, err
+	}
+	tenant := auth.Tenant(ctx)
+	err = r.inTx(ctx, func(q *db.Queries) error {
+		if _, err := q.LockProductImages(ctx, db.LockProductImagesParams{ID: img.ProductID, TenantID: tenant}); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return models.ErrNotFound
+			}
+			return err
+		}
+		res, err := q.AddImage(ctx, db.AddImageParams{
+			ID:          img.ID,
+			ProductID:   img.ProductID,
+			StorageKey:  img.Key,
+			ContentType: img.ContentType,
+			Size:        img.Size,
+			Width:       int32(img.Width),
+			Height:      int32(img.Height),
+			Alt:         img.Alt,
+			Thumbnails:  data,
+			TenantID:    tenant,
+		})
+		if err != nil {
+			return err
+		}
+		img.Position, img.Primary = int(res.Position), res.IsPrimary
+		return nil
+	})
+	if err != nil {
+		return models.Image{}, err
+	}
+	return img, nil
+}
+
+func (r *Repository) GetImage(ctx context.Context, id string) (models.Image, error) {
+	res, err := r.q.GetImage(ctx, db.GetImageParams{ID: id, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Image{}, models.ErrNotFound
+		}
+		return models.Image{}, err
+	}
+	return imageFromDB(res)
+}
+
+func (r *Repository) ListImages(ctx context.Context, productID string) ([]models.Image, error) {
+	ress, err := r.q.ListImages(ctx, db.ListImagesParams{ProductID: productID, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		return nil, err
+	}
+	return imagesFromDB(ress)
+}
+
+// PrimaryImages - главные картинки товаров по id товара
+func (r *Repository) PrimaryImages(ctx context.Context, productIDs []string) (map[string]models.Image, error) {
+	ress, err := r.q.ListPrimaryImages(ctx, db.ListPrimaryImagesParams{ProductIds: productIDs, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		return nil, err
+	}
+	imgs, err := imagesFromDB(ress)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]models.Image, len(imgs))
+	for _, img := range imgs {
+		result[img.ProductID] = img
+	}
+	return result, nil
+}
+
+// DeleteImage удаляет запись и возвращает ее, чтобы вызывающий удалил файлы.
+// Если удалили главную картинку, главной становится первая из оставшихся
+func (r *Repository) DeleteImage(ctx context.Context, id string) (models.Image, error) {
+	var img models.Image
+	tenant := auth.Tenant(ctx)
+	err := r.inTx(ctx, func(q *db.Queries) error {
+		res, err := q.GetImage(ctx, db.GetImageParams{ID: id, TenantID: tenant})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return models.ErrNotFound
+			}
+			return err
+		}
+		if _, err := q.LockProductImages(ctx, db.LockProductImagesParams{ID: res.ProductID, TenantID: tenant}); err != nil {
+			return err
+		}
+		rows, err := q.DeleteImage(ctx, db.DeleteImageParams{ID: id, TenantID: tenant})
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return models.ErrNotFound
+		}
+		if err := q.PromoteFirstImage(ctx, res.ProductID); err != nil {
+			return err
+		}
+		img, err = imageFromDB(res)
+		return err
+	})
+	return img, err
+}
+
+func (r *Repository) SetPrimaryImage(ctx context.Context, id string) error {
+	tenant := auth.Tenant(ctx)
+	return r.inTx(ctx, func(q *db.Queries) error {
+		res, err := q.GetImage(ctx, db.GetImageParams{ID: id, TenantID: tenant})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return models.ErrNotFound
+			}
+			return err
+		}
+		if _, err := q.LockProductImages(ctx, db.LockProductImagesParams{ID: res.ProductID, TenantID: tenant}); err != nil {
+			return err
+		}
+		if err := q.ClearPrimaryImage(ctx, res.ProductID); err != nil {
+			return err
+		}
+		rows, err := q.SetPrimaryImage(ctx, db.SetPrimaryImageParams{ID: id, ProductID: res.ProductID, TenantID: tenant})
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			// картинку удалили между GetImage и блокировкой товара
+			return models.ErrNotFound
+		}
+		return nil
+	})
+}
+
+// ReorderImages задает порядок картинок товара; imageIDs должен содержать
+// все картинки товара ровно по одному разу
+func (r *Repository) ReorderImages(ctx context.Context, productID string, imageIDs []string) error {
+	tenant := auth.Tenant(ctx)
+	return r.inTx(ctx, func(q *db.Queries) error {
+		if _, err := q.LockProductImages(ctx, db.LockProductImagesParams{ID: productID, TenantID: tenant}); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return models.ErrNotFound
+			}
+			return err
+		}
+		cur, err := q.ListImages(ctx, db.ListImagesParams{ProductID: productID, TenantID: tenant})
+		if err != nil {
+			return err
+		}
+		ids := slices.Clone(imageIDs)
+		slices.Sort(ids)
+		have := make([]string, len(cur))
+		for i, c := range cur {
+			have[i] = c.ID
+		}
+		slices.Sort(have)
+		if !slices.Equal(ids, have) {
+			return models.ErrInvalidImageOrder
+		}
+		_, err = q.ReorderImages(ctx, db.ReorderImagesParams{ImageIds: imageIDs, ProductID: productID, TenantID: tenant})
+		return err
+	})
+}
+
+func imageFromDB(res db.ProductImage) (models.Image, error) {
+	var thumbs []thumbnailJSON
+	if err := json.Unmarshal(res.Thumbnails, &thumbs); err != nil {
+		return models.Image{}, err
+	}
+	img := models.Image{
+		ID:          res.ID,
+		ProductID:   res.ProductID,
+		Position:    int(res.Position),
+		Primary:     res.IsPrimary,
+		Key:         res.StorageKey,
+		ContentType: res.ContentType,
+		Size:        res.Size,
+		Width:       int(res.Width),
+		Height:      int(res.Height),
+		Alt:         res.Alt,
+		Thumbnails:  make([]models.Thumbnail, len(thumbs)),
+		CreatedAt:   res.CreatedAt.Time,
+	}
+	for i, t := range thumbs {
+		img.Thumbnails[i] = models.Thumbnail{Name: t.Name, Key: t.Key, ContentType: t.ContentType, Width: t.Width, Height: t.Height}
+	}
+	return img, nil
+}
+
+func imagesFromDB(ress []db.ProductImage) ([]models.Image, error) {
+	result := make([]models.Image, len(ress))
+	for i, res := range ress {
+		img, err := imageFromDB(res)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = img
+	}
+	return result, nil
+}
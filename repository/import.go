@@ -0,0 +1,247 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+	"github.com/glekoz/online-shop_product/pkg/slug"
+	"github.com/glekoz/online-shop_product/repository/db"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// errDryRun откатывает транзакцию пробного импорта
+var errDryRun = errors.New("dry run")
+
+// ImportProducts создает и обновляет товары одной транзакцией: новые - через COPY,
+// изменения - пачкой запросов. Строки, которые нельзя применить (артикул и название
+// указывают на разные товары, название занято другим товаром), попадают в отчет
+// с ошибкой и не мешают остальным. С dryRun все выполняется и откатывается,
+// поэтому отчет тот же, что дал бы настоящий импорт
+func (r *Repository) ImportProducts(ctx context.Context, rows []models.ImportRow, dryRun bool) ([]models.ImportRowResult, error) {
+	results := make([]models.ImportRowResult, len(rows))
+	var updated []string
+	tenant := auth.Tenant(ctx)
+	err := r.inTx(ctx, func(q *db.Queries) error {
+		skus := make([]string, 0, len(rows))
+		names := make([]string, len(rows))
+		bases := make([]string, len(rows))
+		for i, row := range rows {
+			if row.SKU != "" {
+				skus = append(skus, row.SKU)
+			}
+			names[i] = row.Name
+			bases[i] = slug.Make(row.Name)
+		}
+		cands, err := q.LockImportCandidates(ctx, db.LockImportCandidatesParams{TenantID: tenant, Skus: skus, Names: names})
+		if err != nil {
+			return err
+		}
+		slugs, err := newSlugAllocator(ctx, q, bases)
+		if err != nil {
+			return err
+		}
+		bySKU := make(map[string]db.LockImportCandidatesRow, len(cands))
+		byName := make(map[string]db.LockImportCandidatesRow, len(cands))
+		for _, c := range cands {
+			if c.ExternalSku.Valid {
+				bySKU[c.ExternalSku.String] = c
+			}
+			byName[c.Name] = c
+		}
+
+		var (
+			creates  []db.CopyProductsParams
+			newSlugs []db.CopyProductSlugsParams
+			updates  []db.UpdateImportedProductParams
+			closes   []db.ClosePriceHistoriesParams
+			prices   []db.CopyPriceHistoryParams
+			audits   []db.CopyAuditEntriesParams
+			// matched - какой строкой файла уже занят товар
+			matched = make(map[string]int)
+			now     = timestamptz(time.Now())
+		)
+		for i, row := range rows {
+			res := &results[i]
+			res.Line, res.SKU, res.Name = row.Line, row.SKU, row.Name
+			cur, found, err := matchImport(row, bySKU, byName)
+			if err == nil && found {
+				if line, ok := matched[cur.ID]; ok {
+					err = fmt.Errorf("matches the same product as line %d", line)
+				}
+			}
+			if err != nil {
+				res.Status, res.Error = models.ImportFailed, err.Error()
+				continue
+			}
+			price := db.CopyPriceHistoryParams{
+				Amount:        row.Price.Amount,
+				Currency:      row.Price.Currency,
+				EffectiveFrom: now,
+				Applied:       true,
+				TenantID:      tenant,
+			}
+			if !found {
+				res.ID, res.Status = row.ID, models.ImportCreated
+				matched[row.ID] = row.Line
+				s, _ := slugs.allocate(row.ID, bases[i])
+				newSlugs = append(newSlugs, db.CopyProductSlugsParams{TenantID: tenant, Slug: s, ProductID: row.ID})
+				creates = append(creates, db.CopyProductsParams{
+					ID:          row.ID,
+					Name:        row.Name,
+					Price:       row.Price.Amount,
+					Currency:    row.Price.Currency,
+					Description: row.Description,
+					ExternalSku: pgtype.Text{String: row.SKU, Valid: row.SKU != ""},
+					TenantID:    tenant,
+					Slug:        s,
+				})
+				price.ProductID = row.ID
+				prices = append(prices, price)
+				next := &auditState{Name: row.Name, Description: row.Description, Price: row.Price.Amount, Currency: row.Price.Currency, SKU: row.SKU}
+				entry, _, err := auditEntry(ctx, row.ID, models.AuditCreate, nil, next)
+				if err != nil {
+					return err
+				}
+				audits = append(audits, db.CopyAuditEntriesParams(entry))
+				continue
+			}
+
+			res.ID = cur.ID
+			matched[cur.ID] = row.Line
+			// без артикула в файле артикул товара не стираем
+			sku := cur.ExternalSku
+			if row.SKU != "" {
+				sku = pgtype.Text{String: row.SKU, Valid: true}
+			}
+			samePrice := money.New(cur.Price, cur.Currency) == row.Price
+			if samePrice && cur.Name == row.Name && cur.Description == row.Description && cur.ExternalSku == sku {
+				res.Status = models.ImportUnchanged
+				continue
+			}
+			res.Status = models.ImportUpdated
+			updated = append(updated, cur.ID)
+			// как в Update: слаг меняется, только если от названия получается другая основа
+			s := cur.Slug
+			if slug.Make(cur.Name) != bases[i] {
+				var fresh bool
+				if s, fresh = slugs.allocate(cur.ID, bases[i]); fresh {
+					newSlugs = append(newSlugs, db.CopyProductSlugsParams{TenantID: tenant, Slug: s, ProductID: cur.ID})
+				}
+			}
+			updates = append(updates, db.UpdateImportedProductParams{
+				ID:          cur.ID,
+				Name:        row.Name,
+				Price:       row.Price.Amount,
+				Currency:    row.Price.Currency,
+				Description: row.Description,
+				ExternalSku: sku,
+				Slug:        s,
+				TenantID:    tenant,
+			})
+			if !samePrice {
+				closes = append(closes, db.ClosePriceHistoriesParams{EffectiveTo: now, ProductID: cur.ID, TenantID: tenant})
+				price.ProductID = cur.ID
+				prices = append(prices, price)
+			}
+			prev := stateFromDB(cur.Name, cur.Description, cur.Price, cur.Currency, cur.ExternalSku)
+			next := &auditState{Name: row.Name, Description: row.Description, Price: row.Price.Amount, Currency: row.Price.Currency, SKU: sku.String}
+			entry, _, err := auditEntry(ctx, cur.ID, models.AuditUpdate, prev, next)
+			if err != nil {
+				return err
+			}
+			audits = append(audits, db.CopyAuditEntriesParams(entry))
+		}
+
+		if len(creates) > 0 {
+			if _, err := q.CopyProducts(ctx, creates); err != nil {
+				return err
+			}
+		}
+		if len(updates) > 0 {
+			if err := execBatch(q.UpdateImportedProduct(ctx, updates)); err != nil {
+				return err
+			}
+		}
+		// после товаров: слаги ссылаются на них внешним ключом
+		if len(newSlugs) > 0 {
+			if _, err := q.CopyProductSlugs(ctx, newSlugs); err != nil {
+				return err
+			}
+		}
+		if len(closes) > 0 {
+			if err := execBatch(q.ClosePriceHistories(ctx, closes)); err != nil {
+				return err
+			}
+		}
+		if len(prices) > 0 {
+			if _, err := q.CopyPriceHistory(ctx, prices); err != nil {
+				return err
+			}
+		}
+		if err := copyAudit(ctx, q, audits); err != nil {
+			return err
+		}
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if errors.Is(err, errDryRun) {
+		return results, nil
+	}
+	if err != nil {
+		var errp *pgconn.PgError
+		if errors.As(err, &errp) && errp.Code == models.UniqueErrCode && !slugConflict(errp) {
+			// товар с тем же названием или артикулом создали параллельно
+			return nil, fmt.Errorf("%w: %s", models.ErrAlreadyExists, errp.Detail)
+		}
+		return nil, err
+	}
+	for _, id := range updated {
+		r.cache.Delete(cacheKey(ctx, id))
+	}
+	return results, nil
+}
+
+// matchImport находит товар для строки: по артикулу, а если его нет в базе -
+// по названию. Название не должно принадлежать другому товару, а найденный
+// по названию товар не должен числиться под другим артикулом
+func matchImport(row models.ImportRow, bySKU, byName map[string]db.LockImportCandidatesRow) (db.LockImportCandidatesRow, bool, error) {
+	named, nameTaken := byName[row.Name]
+	if row.SKU != "" {
+		if cur, ok := bySKU[row.SKU]; ok {
+			if nameTaken && named.ID != cur.ID {
+				return cur, true, fmt.Errorf("name %q is used by another product %s", row.Name, named.ID)
+			}
+			return cur, true, nil
+		}
+	}
+	if !nameTaken {
+		return db.LockImportCandidatesRow{}, false, nil
+	}
+	if row.SKU != "" && named.ExternalSku.Valid {
+		return named, true, fmt.Errorf("name %q is used by product %s with sku %q", row.Name, named.ID, named.ExternalSku.String)
+	}
+	return named, true, nil
+}
+
+type batchResults interface {
+	Exec(f func(int, error))
+}
+
+// execBatch выполняет пачку и возвращает первую ошибку
+func execBatch(b batchResults) error {
+	var first error
+	b.Exec(func(_ int, err error) {
+		if err != nil && first == nil {
+			first = err
+		}
+	})
+	return first
+}
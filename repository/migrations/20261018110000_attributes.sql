@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE products ADD COLUMN attributes JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX products_attributes_idx ON products USING GIN (attributes);
+
+-- определения атрибутов наследуются подкатегориями
+CREATE TABLE attribute_definitions (
+    id VARCHAR(50) PRIMARY KEY,
+    category_id VARCHAR(50) NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+    code VARCHAR(50) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    type VARCHAR(10) NOT NULL CHECK (type IN ('string', 'number', 'boolean', 'enum')),
+    enum_values TEXT[] NOT NULL DEFAULT '{}',
+    UNIQUE (category_id, code)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE attribute_definitions;
+DROP INDEX products_attributes_idx;
+ALTER TABLE products DROP COLUMN attributes;
+-- +goose StatementEnd
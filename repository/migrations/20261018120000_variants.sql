@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- вариант товара: размер, цвет и т.п.; цена NULL - берется цена родительского товара
+CREATE TABLE product_variants (
+    id VARCHAR(50) PRIMARY KEY,
+    product_id VARCHAR(50) NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    sku VARCHAR(64) NOT NULL UNIQUE,
+    price_override INT CHECK (price_override > 0),
+    options JSONB NOT NULL DEFAULT '{}',
+    barcode VARCHAR(14) UNIQUE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (product_id, options)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE product_variants;
+-- +goose StatementEnd
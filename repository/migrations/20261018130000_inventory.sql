@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE products ADD COLUMN low_stock_threshold INT NOT NULL DEFAULT 0 CHECK (low_stock_threshold >= 0);
+
+CREATE TABLE stock_levels (
+    product_id VARCHAR(50) NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    location VARCHAR(50) NOT NULL,
+    quantity INT NOT NULL DEFAULT 0 CHECK (quantity >= 0),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (product_id, location)
+);
+
+-- журнал движений не ссылается на products, чтобы история переживала удаление товара
+CREATE TABLE stock_movements (
+    id BIGSERIAL PRIMARY KEY,
+    product_id VARCHAR(50) NOT NULL,
+    location VARCHAR(50) NOT NULL,
+    delta INT NOT NULL,
+    quantity_after INT NOT NULL,
+    reason VARCHAR(200) NOT NULL DEFAULT '',
+    actor VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX stock_movements_product_id_idx ON stock_movements(product_id, id DESC);
+
+-- единственное место, где определяется "в наличии" для выдачи каталога
+CREATE FUNCTION product_in_stock(pid VARCHAR) RETURNS BOOLEAN
+LANGUAGE sql STABLE AS $$
+    SELECT EXISTS (SELECT 1 FROM stock_levels s WHERE s.product_id = pid AND s.quantity > 0)
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION product_in_stock;
+DROP TABLE stock_movements;
+DROP TABLE stock_levels;
+ALTER TABLE products DROP COLUMN low_stock_threshold;
+-- +goose StatementEnd
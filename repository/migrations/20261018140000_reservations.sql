@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+-- на складе физически quantity, из них reserved удерживается под неоплаченные заказы
+ALTER TABLE stock_levels ADD COLUMN reserved INT NOT NULL DEFAULT 0;
+ALTER TABLE stock_levels ADD CONSTRAINT stock_levels_reserved_check CHECK (reserved >= 0 AND reserved <= quantity);
+
+-- id задает сервис заказов, поэтому повторный Reserve с тем же id безопасен
+CREATE TABLE stock_reservations (
+    id VARCHAR(100) PRIMARY KEY,
+    status VARCHAR(10) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'committed', 'released', 'expired')),
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX stock_reservations_expires_at_idx ON stock_reservations(expires_at) WHERE status = 'active';
+
+CREATE TABLE stock_reservation_items (
+    reservation_id VARCHAR(100) NOT NULL REFERENCES stock_reservations(id) ON DELETE CASCADE,
+    product_id VARCHAR(50) NOT NULL,
+    location VARCHAR(50) NOT NULL,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    PRIMARY KEY (reservation_id, product_id, location),
+    FOREIGN KEY (product_id, location) REFERENCES stock_levels(product_id, location) ON DELETE CASCADE
+);
+
+CREATE OR REPLACE FUNCTION product_in_stock(pid VARCHAR) RETURNS BOOLEAN
+LANGUAGE sql STABLE AS $$
+    SELECT EXISTS (SELECT 1 FROM stock_levels s WHERE s.product_id = pid AND s.quantity > s.reserved)
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION product_in_stock(pid VARCHAR) RETURNS BOOLEAN
+LANGUAGE sql STABLE AS $$
+    SELECT EXISTS (SELECT 1 FROM stock_levels s WHERE s.product_id = pid AND s.quantity > 0)
+$$;
+DROP TABLE stock_reservation_items;
+DROP TABLE stock_reservations;
+ALTER TABLE stock_levels DROP COLUMN reserved;
+-- +goose StatementEnd
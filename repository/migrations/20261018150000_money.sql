@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- цена хранится в минорных единицах (копейках) своей валюты; INT не хватало для дорогих товаров в валютах без копеек
+ALTER TABLE products ALTER COLUMN price TYPE BIGINT;
+ALTER TABLE products ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'RUB';
+ALTER TABLE product_variants ALTER COLUMN price_override TYPE BIGINT;
+
+-- явные цены в других валютах; если цены нет - она считается по курсу из currency_rates
+CREATE TABLE product_prices (
+    product_id VARCHAR(50) NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    currency CHAR(3) NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (product_id, currency)
+);
+
+-- 1 base = rate quote; increment - шаг округления результата в минорных единицах quote
+CREATE TABLE currency_rates (
+    base CHAR(3) NOT NULL,
+    quote CHAR(3) NOT NULL,
+    rate NUMERIC(24, 12) NOT NULL CHECK (rate > 0),
+    rounding VARCHAR(10) NOT NULL DEFAULT 'half_up' CHECK (rounding IN ('half_up', 'half_even', 'down', 'up')),
+    increment BIGINT NOT NULL DEFAULT 1 CHECK (increment > 0),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (base, quote),
+    CHECK (base <> quote)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE currency_rates;
+DROP TABLE product_prices;
+ALTER TABLE product_variants ALTER COLUMN price_override TYPE INT;
+ALTER TABLE products DROP COLUMN currency;
+ALTER TABLE products ALTER COLUMN price TYPE INT;
+-- +goose StatementEnd
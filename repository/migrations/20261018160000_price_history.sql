@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- история базовой цены: applied = FALSE - запланированное изменение, которое еще не наступило.
+-- effective_to у действующей цены NULL и проставляется, когда ее сменяет следующая.
+-- TIMESTAMPTZ, потому что время приходит от клиентов и сравнивается с NOW()
+CREATE TABLE price_history (
+    id BIGSERIAL PRIMARY KEY,
+    product_id VARCHAR(50) NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    currency CHAR(3) NOT NULL,
+    effective_from TIMESTAMPTZ NOT NULL,
+    effective_to TIMESTAMPTZ CHECK (effective_to >= effective_from),
+    applied BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX price_history_product_idx ON price_history(product_id, effective_from);
+CREATE INDEX price_history_due_idx ON price_history(effective_from) WHERE NOT applied;
+
+INSERT INTO price_history(product_id, amount, currency, effective_from, applied)
+SELECT id, price, currency, COALESCE(created_at, NOW()), TRUE
+FROM products;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE price_history;
+-- +goose StatementEnd
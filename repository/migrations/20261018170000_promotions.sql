@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- value: для percent - сотые доли процента (1250 = 12.5%), для fixed - сумма в минорных единицах currency.
+-- target_id без внешнего ключа: это либо товар, либо категория
+CREATE TABLE promotions (
+    id VARCHAR(50) PRIMARY KEY,
+    name VARCHAR(200) NOT NULL,
+    kind VARCHAR(10) NOT NULL CHECK (kind IN ('percent', 'fixed')),
+    value BIGINT NOT NULL CHECK (value > 0),
+    currency CHAR(3),
+    target VARCHAR(10) NOT NULL CHECK (target IN ('all', 'product', 'category')),
+    target_id VARCHAR(50),
+    starts_at TIMESTAMPTZ,
+    ends_at TIMESTAMPTZ,
+    priority INT NOT NULL DEFAULT 0,
+    stackable BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (kind = 'percent' AND value <= 10000 AND currency IS NULL OR kind = 'fixed' AND currency IS NOT NULL),
+    CHECK ((target = 'all') = (target_id IS NULL)),
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX promotions_ends_at_idx ON promotions(ends_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE promotions;
+-- +goose StatementEnd
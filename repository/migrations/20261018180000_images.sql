@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- сами файлы лежат в blob-хранилище, здесь только ключи и метаданные.
+-- thumbnails: [{"name": "small", "key": "...", "content_type": "image/jpeg", "width": 160, "height": 120}, ...]
+CREATE TABLE product_images (
+    id VARCHAR(50) PRIMARY KEY,
+    product_id VARCHAR(50) NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    is_primary BOOLEAN NOT NULL DEFAULT FALSE,
+    storage_key VARCHAR(300) NOT NULL,
+    content_type VARCHAR(50) NOT NULL,
+    size BIGINT NOT NULL CHECK (size > 0),
+    width INT NOT NULL CHECK (width > 0),
+    height INT NOT NULL CHECK (height > 0),
+    alt VARCHAR(300) NOT NULL DEFAULT '',
+    thumbnails JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX product_images_product_idx ON product_images(product_id, position);
+-- у товара не больше одной главной картинки
+CREATE UNIQUE INDEX product_images_primary_idx ON product_images(product_id) WHERE is_primary;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE product_images;
+-- +goose StatementEnd
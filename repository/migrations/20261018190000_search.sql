@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- search_language - словарь Postgres (russian, english, simple, ...), по которому
+-- индексируется товар. Тип regconfig, а не текст: приведение текста к regconfig
+-- зависит от search_path и не разрешено в генерируемой колонке
+ALTER TABLE products ADD COLUMN search_language regconfig NOT NULL DEFAULT 'russian';
+
+-- вес A у названия выше веса B у описания, это учитывает ts_rank
+ALTER TABLE products ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector(search_language, coalesce(name, '')), 'A') ||
+    setweight(to_tsvector(search_language, coalesce(description, '')), 'B')
+) STORED;
+
+CREATE INDEX products_search_idx ON products USING GIN (search_vector);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE products DROP COLUMN search_vector;
+ALTER TABLE products DROP COLUMN search_language;
+-- +goose StatementEnd
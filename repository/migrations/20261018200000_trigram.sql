@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- индекс нужен операторам % и <%: similarity() и word_similarity() сами по себе
+-- индекс не используют и считаются по всей таблице
+CREATE INDEX products_name_trgm_idx ON products USING GIN (name gin_trgm_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX products_name_trgm_idx;
+-- расширение не удаляем: им могут пользоваться не только наши таблицы
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- external_sku - артикул поставщика: по нему повторный импорт находит тот же товар,
+-- даже если поставщик переименовал его. NULL у товаров, заведенных вручную
+ALTER TABLE products ADD COLUMN external_sku VARCHAR(64) UNIQUE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE products DROP COLUMN external_sku;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Журнал изменений товаров. Без внешнего ключа на products:
+-- записи об удаленных товарах должны остаться
+CREATE TABLE audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    product_id VARCHAR(50) NOT NULL,
+    action VARCHAR(16) NOT NULL CHECK (action IN ('create', 'update', 'delete')),
+    actor VARCHAR(255) NOT NULL DEFAULT '',
+    request_id VARCHAR(64) NOT NULL DEFAULT '',
+    diff JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX audit_log_product_idx ON audit_log (product_id, id DESC);
+CREATE INDEX audit_log_actor_idx ON audit_log (actor, id DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE audit_log;
+-- +goose StatementEnd
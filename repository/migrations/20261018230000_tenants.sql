@@ -0,0 +1,205 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Каталог делится между магазинами (tenant). Существующие строки уходят в магазин 'default'
+ALTER TABLE products ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE categories ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE product_categories ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE attribute_definitions ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE product_variants ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE stock_levels ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE stock_movements ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE stock_reservations ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE stock_reservation_items ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE product_prices ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE currency_rates ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE price_history ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE promotions ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE product_images ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE audit_log ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default';
+
+-- без значения по умолчанию строка, вставленная мимо магазина, упадет, а не уйдет в 'default'
+ALTER TABLE products ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE categories ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE product_categories ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE attribute_definitions ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE product_variants ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE stock_levels ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE stock_movements ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE stock_reservations ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE stock_reservation_items ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE product_prices ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE currency_rates ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE price_history ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE promotions ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE product_images ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE audit_log ALTER COLUMN tenant_id DROP DEFAULT;
+
+-- уникальность - в пределах магазина: у двух магазинов может быть товар с одним названием
+ALTER TABLE products DROP CONSTRAINT products_name_key;
+ALTER TABLE products ADD CONSTRAINT products_tenant_name_key UNIQUE (tenant_id, name);
+ALTER TABLE products DROP CONSTRAINT products_external_sku_key;
+ALTER TABLE products ADD CONSTRAINT products_tenant_external_sku_key UNIQUE (tenant_id, external_sku);
+ALTER TABLE categories DROP CONSTRAINT categories_slug_key;
+ALTER TABLE categories ADD CONSTRAINT categories_tenant_slug_key UNIQUE (tenant_id, slug);
+ALTER TABLE product_variants DROP CONSTRAINT product_variants_sku_key;
+ALTER TABLE product_variants ADD CONSTRAINT product_variants_tenant_sku_key UNIQUE (tenant_id, sku);
+ALTER TABLE product_variants DROP CONSTRAINT product_variants_barcode_key;
+ALTER TABLE product_variants ADD CONSTRAINT product_variants_tenant_barcode_key UNIQUE (tenant_id, barcode);
+ALTER TABLE currency_rates DROP CONSTRAINT currency_rates_pkey;
+ALTER TABLE currency_rates ADD PRIMARY KEY (tenant_id, base, quote);
+
+-- id резерва задает сервис заказов, у разных магазинов они могут совпасть
+ALTER TABLE stock_reservation_items DROP CONSTRAINT stock_reservation_items_reservation_id_fkey;
+ALTER TABLE stock_reservation_items DROP CONSTRAINT stock_reservation_items_pkey;
+ALTER TABLE stock_reservations DROP CONSTRAINT stock_reservations_pkey;
+ALTER TABLE stock_reservations ADD PRIMARY KEY (tenant_id, id);
+ALTER TABLE stock_reservation_items ADD PRIMARY KEY (tenant_id, reservation_id, product_id, location);
+ALTER TABLE stock_reservation_items ADD CONSTRAINT stock_reservation_items_reservation_id_fkey
+    FOREIGN KEY (tenant_id, reservation_id) REFERENCES stock_reservations(tenant_id, id) ON DELETE CASCADE;
+
+-- связи только внутри магазина: товар не попадет в чужую категорию, даже если id известен
+ALTER TABLE products ADD CONSTRAINT products_tenant_id_key UNIQUE (tenant_id, id);
+ALTER TABLE categories ADD CONSTRAINT categories_tenant_id_key UNIQUE (tenant_id, id);
+
+ALTER TABLE categories DROP CONSTRAINT categories_parent_id_fkey;
+ALTER TABLE categories ADD CONSTRAINT categories_parent_id_fkey
+    FOREIGN KEY (tenant_id, parent_id) REFERENCES categories(tenant_id, id) ON DELETE RESTRICT;
+ALTER TABLE product_categories DROP CONSTRAINT product_categories_product_id_fkey;
+ALTER TABLE product_categories ADD CONSTRAINT product_categories_product_id_fkey
+    FOREIGN KEY (tenant_id, product_id) REFERENCES products(tenant_id, id) ON DELETE CASCADE;
+ALTER TABLE product_categories DROP CONSTRAINT product_categories_category_id_fkey;
+ALTER TABLE product_categories ADD CONSTRAINT product_categories_category_id_fkey
+    FOREIGN KEY (tenant_id, category_id) REFERENCES categories(tenant_id, id) ON DELETE CASCADE;
+ALTER TABLE attribute_definitions DROP CONSTRAINT attribute_definitions_category_id_fkey;
+ALTER TABLE attribute_definitions ADD CONSTRAINT attribute_definitions_category_id_fkey
+    FOREIGN KEY (tenant_id, category_id) REFERENCES categories(tenant_id, id) ON DELETE CASCADE;
+ALTER TABLE product_variants DROP CONSTRAINT product_variants_product_id_fkey;
+ALTER TABLE product_variants ADD CONSTRAINT product_variants_product_id_fkey
+    FOREIGN KEY (tenant_id, product_id) REFERENCES products(tenant_id, id) ON DELETE CASCADE;
+ALTER TABLE stock_levels DROP CONSTRAINT stock_levels_product_id_fkey;
+ALTER TABLE stock_levels ADD CONSTRAINT stock_levels_product_id_fkey
+    FOREIGN KEY (tenant_id, product_id) REFERENCES products(tenant_id, id) ON DELETE CASCADE;
+ALTER TABLE product_prices DROP CONSTRAINT product_prices_product_id_fkey;
+ALTER TABLE product_prices ADD CONSTRAINT product_prices_product_id_fkey
+    FOREIGN KEY (tenant_id, product_id) REFERENCES products(tenant_id, id) ON DELETE CASCADE;
+ALTER TABLE price_history DROP CONSTRAINT price_history_product_id_fkey;
+ALTER TABLE price_history ADD CONSTRAINT price_history_product_id_fkey
+    FOREIGN KEY (tenant_id, product_id) REFERENCES products(tenant_id, id) ON DELETE CASCADE;
+ALTER TABLE product_images DROP CONSTRAINT product_images_product_id_fkey;
+ALTER TABLE product_images ADD CONSTRAINT product_images_product_id_fkey
+    FOREIGN KEY (tenant_id, product_id) REFERENCES products(tenant_id, id) ON DELETE CASCADE;
+
+DROP INDEX audit_log_actor_idx;
+CREATE INDEX audit_log_actor_idx ON audit_log (tenant_id, actor, id DESC);
+CREATE INDEX promotions_tenant_idx ON promotions (tenant_id, priority DESC, id);
+
+-- Row-level security - вторая линия обороны на случай запроса без фильтра по магазину.
+-- Политика работает, только если сервис запущен с ней и выставляет app.tenant_id
+-- на соединении; без настройки (миграции, psql) и со значением '*' (фоновые задачи
+-- по всем магазинам) видно все
+DO $$
+DECLARE
+    t TEXT;
+BEGIN
+    FOREACH t IN ARRAY ARRAY['products', 'categories', 'product_categories', 'attribute_definitions',
+        'product_variants', 'stock_levels', 'stock_movements', 'stock_reservations', 'stock_reservation_items',
+        'product_prices', 'currency_rates', 'price_history', 'promotions', 'product_images', 'audit_log']
+    LOOP
+        EXECUTE format('ALTER TABLE %I ENABLE ROW LEVEL SECURITY', t);
+        EXECUTE format('ALTER TABLE %I FORCE ROW LEVEL SECURITY', t);
+        EXECUTE format($p$CREATE POLICY tenant_isolation ON %I
+            USING (COALESCE(current_setting('app.tenant_id', true), '') IN ('', '*')
+                OR tenant_id = current_setting('app.tenant_id', true))$p$, t);
+    END LOOP;
+END
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DO $$
+DECLARE
+    t TEXT;
+BEGIN
+    FOREACH t IN ARRAY ARRAY['products', 'categories', 'product_categories', 'attribute_definitions',
+        'product_variants', 'stock_levels', 'stock_movements', 'stock_reservations', 'stock_reservation_items',
+        'product_prices', 'currency_rates', 'price_history', 'promotions', 'product_images', 'audit_log']
+    LOOP
+        EXECUTE format('DROP POLICY tenant_isolation ON %I', t);
+        EXECUTE format('ALTER TABLE %I NO FORCE ROW LEVEL SECURITY', t);
+        EXECUTE format('ALTER TABLE %I DISABLE ROW LEVEL SECURITY', t);
+    END LOOP;
+END
+$$;
+
+DROP INDEX promotions_tenant_idx;
+DROP INDEX audit_log_actor_idx;
+CREATE INDEX audit_log_actor_idx ON audit_log (actor, id DESC);
+
+ALTER TABLE product_images DROP CONSTRAINT product_images_product_id_fkey;
+ALTER TABLE product_images ADD CONSTRAINT product_images_product_id_fkey
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE;
+ALTER TABLE price_history DROP CONSTRAINT price_history_product_id_fkey;
+ALTER TABLE price_history ADD CONSTRAINT price_history_product_id_fkey
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE;
+ALTER TABLE product_prices DROP CONSTRAINT product_prices_product_id_fkey;
+ALTER TABLE product_prices ADD CONSTRAINT product_prices_product_id_fkey
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE;
+ALTER TABLE stock_levels DROP CONSTRAINT stock_levels_product_id_fkey;
+ALTER TABLE stock_levels ADD CONSTRAINT stock_levels_product_id_fkey
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE;
+ALTER TABLE product_variants DROP CONSTRAINT product_variants_product_id_fkey;
+ALTER TABLE product_variants ADD CONSTRAINT product_variants_product_id_fkey
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE;
+ALTER TABLE attribute_definitions DROP CONSTRAINT attribute_definitions_category_id_fkey;
+ALTER TABLE attribute_definitions ADD CONSTRAINT attribute_definitions_category_id_fkey
+    FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE CASCADE;
+ALTER TABLE product_categories DROP CONSTRAINT product_categories_category_id_fkey;
+ALTER TABLE product_categories ADD CONSTRAINT product_categories_category_id_fkey
+    FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE CASCADE;
+ALTER TABLE product_categories DROP CONSTRAINT product_categories_product_id_fkey;
+ALTER TABLE product_categories ADD CONSTRAINT product_categories_product_id_fkey
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE;
+ALTER TABLE categories DROP CONSTRAINT categories_parent_id_fkey;
+ALTER TABLE categories ADD CONSTRAINT categories_parent_id_fkey
+    FOREIGN KEY (parent_id) REFERENCES categories(id) ON DELETE RESTRICT;
+ALTER TABLE categories DROP CONSTRAINT categories_tenant_id_key;
+ALTER TABLE products DROP CONSTRAINT products_tenant_id_key;
+
+ALTER TABLE stock_reservation_items DROP CONSTRAINT stock_reservation_items_reservation_id_fkey;
+ALTER TABLE stock_reservation_items DROP CONSTRAINT stock_reservation_items_pkey;
+ALTER TABLE stock_reservations DROP CONSTRAINT stock_reservations_pkey;
+ALTER TABLE stock_reservations ADD PRIMARY KEY (id);
+ALTER TABLE stock_reservation_items ADD PRIMARY KEY (reservation_id, product_id, location);
+ALTER TABLE stock_reservation_items ADD CONSTRAINT stock_reservation_items_reservation_id_fkey
+    FOREIGN KEY (reservation_id) REFERENCES stock_reservations(id) ON DELETE CASCADE;
+
+ALTER TABLE currency_rates DROP CONSTRAINT currency_rates_pkey;
+ALTER TABLE currency_rates ADD PRIMARY KEY (base, quote);
+ALTER TABLE product_variants DROP CONSTRAINT product_variants_tenant_barcode_key;
+ALTER TABLE product_variants ADD CONSTRAINT product_variants_barcode_key UNIQUE (barcode);
+ALTER TABLE product_variants DROP CONSTRAINT product_variants_tenant_sku_key;
+ALTER TABLE product_variants ADD CONSTRAINT product_variants_sku_key UNIQUE (sku);
+ALTER TABLE categories DROP CONSTRAINT categories_tenant_slug_key;
+ALTER TABLE categories ADD CONSTRAINT categories_slug_key UNIQUE (slug);
+ALTER TABLE products DROP CONSTRAINT products_tenant_external_sku_key;
+ALTER TABLE products ADD CONSTRAINT products_external_sku_key UNIQUE (external_sku);
+ALTER TABLE products DROP CONSTRAINT products_tenant_name_key;
+ALTER TABLE products ADD CONSTRAINT products_name_key UNIQUE (name);
+
+ALTER TABLE audit_log DROP COLUMN tenant_id;
+ALTER TABLE product_images DROP COLUMN tenant_id;
+ALTER TABLE promotions DROP COLUMN tenant_id;
+ALTER TABLE price_history DROP COLUMN tenant_id;
+ALTER TABLE currency_rates DROP COLUMN tenant_id;
+ALTER TABLE product_prices DROP COLUMN tenant_id;
+ALTER TABLE stock_reservation_items DROP COLUMN tenant_id;
+ALTER TABLE stock_reservations DROP COLUMN tenant_id;
+ALTER TABLE stock_movements DROP COLUMN tenant_id;
+ALTER TABLE stock_levels DROP COLUMN tenant_id;
+ALTER TABLE product_variants DROP COLUMN tenant_id;
+ALTER TABLE attribute_definitions DROP COLUMN tenant_id;
+ALTER TABLE product_categories DROP COLUMN tenant_id;
+ALTER TABLE categories DROP COLUMN tenant_id;
+ALTER TABLE products DROP COLUMN tenant_id;
+-- +goose StatementEnd
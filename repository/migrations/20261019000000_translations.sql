@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Переводы названия и описания товара. Язык по умолчанию хранится в самом
+-- товаре, здесь только остальные локали
+CREATE TABLE product_translations (
+    tenant_id VARCHAR(50) NOT NULL,
+    product_id VARCHAR(50) NOT NULL,
+    locale VARCHAR(16) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, product_id, locale),
+    FOREIGN KEY (tenant_id, product_id) REFERENCES products (tenant_id, id) ON DELETE CASCADE
+);
+
+-- для списка товаров без перевода
+CREATE INDEX product_translations_locale_idx ON product_translations (tenant_id, locale, product_id);
+
+ALTER TABLE product_translations ENABLE ROW LEVEL SECURITY;
+ALTER TABLE product_translations FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON product_translations
+    USING (COALESCE(current_setting('app.tenant_id', true), '') IN ('', '*')
+        OR tenant_id = current_setting('app.tenant_id', true));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE product_translations;
+-- +goose StatementEnd
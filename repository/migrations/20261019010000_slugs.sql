@@ -0,0 +1,74 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Слаги товаров для URL вида /p/chocolate-donut. В products - текущий,
+-- в product_slugs - все, что когда-либо были у товара: старые ведут на
+-- текущий редиректом и не достаются другим товарам
+ALTER TABLE products ADD COLUMN slug VARCHAR(120);
+
+-- Уникальность проверяет индекс ограничения, пока слаги раздаются по одному
+ALTER TABLE products ADD CONSTRAINT products_tenant_slug_key UNIQUE (tenant_id, slug);
+
+-- Та же транслитерация, что в pkg/slug, только без обрезки по границе слова.
+-- Суффиксы -2, -3... раздаются в порядке создания, как в slugAllocator:
+-- первый свободный среди уже занятых, так что "Donut 2" не столкнется
+-- с суффиксом второго "Donut"
+DO $$
+DECLARE
+    p RECORD;
+    candidate TEXT;
+    n INT;
+BEGIN
+    FOR p IN
+        SELECT id, tenant_id,
+            COALESCE(NULLIF(trim(BOTH '-' FROM left(trim(BOTH '-' FROM regexp_replace(
+                translate(
+                    replace(replace(replace(replace(replace(replace(replace(replace(replace(replace(replace(replace(
+                        lower(name),
+                        'ж', 'zh'), 'х', 'kh'), 'ц', 'ts'), 'ч', 'ch'), 'щ', 'shch'), 'ш', 'sh'),
+                        'ю', 'iu'), 'я', 'ia'), 'є', 'ie'), 'æ', 'ae'), 'œ', 'oe'), 'ß', 'ss'),
+                    'абвгдеёзийклмнопрстуфыэіїґўàáâäãåçèéêëìíîïñòóôöõøùúûüýÿъь',
+                    'abvgdeeziiklmnoprstufyeiiguaaaaaaceeeeiiiinoooooouuuuyy'),
+                '[^a-z0-9]+', '-', 'g')), 100)), ''), 'product') AS base
+        FROM products
+        ORDER BY created_at, id
+    LOOP
+        candidate := p.base;
+        n := 1;
+        WHILE EXISTS (SELECT 1 FROM products WHERE tenant_id = p.tenant_id AND slug = candidate) LOOP
+            n := n + 1;
+            candidate := p.base || '-' || n;
+        END LOOP;
+        UPDATE products SET slug = candidate WHERE id = p.id;
+    END LOOP;
+END
+$$;
+
+ALTER TABLE products ALTER COLUMN slug SET NOT NULL;
+
+CREATE TABLE product_slugs (
+    tenant_id VARCHAR(50) NOT NULL,
+    slug VARCHAR(120) NOT NULL,
+    product_id VARCHAR(50) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, slug),
+    FOREIGN KEY (tenant_id, product_id) REFERENCES products (tenant_id, id) ON DELETE CASCADE
+);
+
+CREATE INDEX product_slugs_product_idx ON product_slugs (tenant_id, product_id);
+
+INSERT INTO product_slugs (tenant_id, slug, product_id)
+SELECT tenant_id, slug, id FROM products;
+
+ALTER TABLE product_slugs ENABLE ROW LEVEL SECURITY;
+ALTER TABLE product_slugs FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON product_slugs
+    USING (COALESCE(current_setting('app.tenant_id', true), '') IN ('', '*')
+        OR tenant_id = current_setting('app.tenant_id', true));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE product_slugs;
+ALTER TABLE products DROP CONSTRAINT products_tenant_slug_key;
+ALTER TABLE products DROP COLUMN slug;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Жизненный цикл товара: draft -> in_review -> published -> discontinued,
+-- витрина видит только published. Существующие товары уже продаются,
+-- поэтому им published, а новые по умолчанию - черновики.
+-- publish_at и unpublish_at исполняет планировщик, как отложенные цены
+ALTER TABLE products
+    ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'published'
+        CHECK (status IN ('draft', 'in_review', 'published', 'discontinued')),
+    ADD COLUMN publish_at TIMESTAMPTZ,
+    ADD COLUMN unpublish_at TIMESTAMPTZ;
+
+ALTER TABLE products ALTER COLUMN status SET DEFAULT 'draft';
+
+CREATE INDEX products_status_idx ON products (tenant_id, status);
+CREATE INDEX products_publish_at_idx ON products (publish_at) WHERE status = 'in_review' AND publish_at IS NOT NULL;
+CREATE INDEX products_unpublish_at_idx ON products (unpublish_at) WHERE status = 'published' AND unpublish_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE products
+    DROP COLUMN status,
+    DROP COLUMN publish_at,
+    DROP COLUMN unpublish_at;
+-- +goose StatementEnd
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Миграции гоняются на живом Postgres: TEST_DATABASE_URL указывает на базу,
+// где можно создавать схемы. Без нее тест пропускается. Вторым значением
+// возвращается DSN, соединения по которому смотрят в ту же схему
+func testConn(t *testing.T) (*pgx.Conn, string) {
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema := fmt.Sprintf("migtest_%d", time.Now().UnixNano())
+	if _, err := conn.Exec(ctx, "CREATE SCHEMA "+schema+"; SET search_path TO "+schema+", public"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		conn.Exec(ctx, "DROP SCHEMA "+schema+" CASCADE")
+		conn.Close(ctx)
+	})
+	sep := " "
+	if strings.Contains(dsn, "://") {
+		sep = "?"
+		if strings.Contains(dsn, "?") {
+			sep = "&"
+		}
+	}
+	return conn, dsn + sep + "search_path=" + schema + ",public"
+}
+
+// testRepository - Repository поверх схемы со всеми миграциями
+func testRepository(t *testing.T) *Repository {
+	conn, dsn := testConn(t)
+	migrate(t, conn, migrationFiles(t), func(string) bool { return true })
+	r, err := New(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(r.Close)
+	return r
+}
+
+func migrationFiles(t *testing.T) []string {
+	files, err := filepath.Glob("migrations/*.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices.Sort(files)
+	return files
+}
+
+// migrate накатывает Up-части миграций, для которых filter возвращает true
+func migrate(t *testing.T, conn *pgx.Conn, files []string, filter func(name string) bool) {
+	for _, f := range files {
+		if !filter(filepath.Base(f)) {
+			continue
+		}
+		raw, err := os.ReadFile(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		up, _, _ := strings.Cut(string(raw), "-- +goose Down")
+		if err := conn.PgConn().Exec(context.Background(), up).Close(); err != nil {
+			t.Fatalf("%s: %v", filepath.Base(f), err)
+		}
+	}
+}
+
+func TestSlugMigrationCollisions(t *testing.T) {
+	conn, _ := testConn(t)
+	ctx := context.Background()
+	files := migrationFiles(t)
+	const slugs = "20261019010000_slugs.sql"
+	migrate(t, conn, files, func(name string) bool { return name < slugs })
+
+	// "Donut 2" дает основу donut-2, которую уже занял второй "Donut"
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	products := []struct{ id, tenant, name string }{
+		{"1", "a", "Donut"},
+		{"2", "a", "Donut!"},
+		{"3", "a", "Donut 2"},
+		{"4", "a", "Шоколадный пончик"},
+		{"5", "b", "Donut"},
+	}
+	for i, p := range products {
+		_, err := conn.Exec(ctx,
+			"INSERT INTO products (id, tenant_id, name, price, description, created_at) VALUES ($1, $2, $3, 100, '', $4)",
+			p.id, p.tenant, p.name, created.Add(time.Duration(i)*time.Minute))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	migrate(t, conn, files, func(name string) bool { return name >= slugs })
+
+	want := map[string]string{"1": "donut", "2": "donut-2", "3": "donut-2-2", "4": "shokoladnyi-ponchik", "5": "donut"}
+	rows, err := conn.Query(ctx, "SELECT id, slug FROM products")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) ([2]string, error) {
+		var r [2]string
+		err := row.Scan(&r[0], &r[1])
+		return r, err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d products, want %d", len(got), len(want))
+	}
+	for _, r := range got {
+		if want[r[0]] != r[1] {
+			t.Errorf("product %s: slug %q, want %q", r[0], r[1], want[r[0]])
+		}
+	}
+
+	var history int
+	if err := conn.QueryRow(ctx, "SELECT count(*) FROM product_slugs").Scan(&history); err != nil {
+		t.Fatal(err)
+	}
+	if history != len(want) {
+		t.Fatalf("product_slugs: got %d rows, want %d", history, len(want))
+	}
+}
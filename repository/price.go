@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+	"github.com/glekoz/online-shop_product/repository/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// SetBasePrice меняет цену и валюту товара и пишет изменение в историю. Явная цена
+// в новой базовой валюте становится лишней, поэтому удаляется в той же транзакции
+func (r *Repository) SetBasePrice(ctx context.Context, productID string, price money.Money) error {
+	err := r.inTx(ctx, func(q *db.Queries) error {
+		cur, err := q.GetForUpdate(ctx, db.GetForUpdateParams{ID: productID, TenantID: auth.Tenant(ctx)})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return models.ErrNotFound
+			}
+			return err
+		}
+		if money.New(cur.Price, cur.Currency) == price {
+			return nil
+		}
+		if err := setBasePrice(ctx, q, productID, price, time.Now()); err != nil {
+			return err
+		}
+		old := stateFromDB(cur.Name, cur.Description, cur.Price, cur.Currency, cur.ExternalSku)
+		return addAudit(ctx, q, productID, models.AuditUpdate, old, old.withPrice(price))
+	})
+	if err != nil {
+		return err
+	}
+	r.cache.Delete(cacheKey(ctx, productID))
+	return nil
+}
+
+func (r *Repository) SetProductPrice(ctx context.Context, productID string, price money.Money) error {
+	err := r.q.SetProductPrice(ctx, db.SetProductPriceParams{
+		ProductID: productID,
+		Currency:  price.Currency,
+		Amount:    price.Amount,
+		TenantID:  auth.Tenant(ctx),
+	})
+	return categoryError(err)
+}
+
+func (r *Repository) GetProductPrice(ctx context.Context, productID, currency string) (money.Money, error) {
+	amount, err := r.q.GetProductPrice(ctx, db.GetProductPriceParams{ProductID: productID, Currency: currency, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return money.Money{}, models.ErrNotFound
+		}
+		return money.Money{}, err
+	}
+	return money.New(amount, currency), nil
+}
+
+func (r *Repository) ListProductPrices(ctx context.Context, productID string) ([]money.Money, error) {
+	ress, err := r.q.ListProductPrices(ctx, db.ListProductPricesParams{ProductID: productID, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]money.Money, len(ress))
+	for i, res := range ress {
+		result[i] = money.New(res.Amount, res.Currency)
+	}
+	return result, nil
+}
+
+func (r *Repository) DeleteProductPrice(ctx context.Context, productID, currency string) error {
+	rows, err := r.q.DeleteProductPrice(ctx, db.DeleteProductPriceParams{ProductID: productID, Currency: currency, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return models.ErrNotFound
+	}
+	return nil
+}
+
+func (r *Repository) SetCurrencyRate(ctx context.Context, rate money.Rate) error {
+	increment := rate.Increment
+	if increment < 1 {
+		increment = 1
+	}
+	return r.q.UpsertCurrencyRate(ctx, db.UpsertCurrencyRateParams{
+		Base:      rate.Base,
+		Quote:     rate.Quote,
+		Rate:      rate.Rate,
+		Rounding:  string(rate.Rounding),
+		Increment: increment,
+		TenantID:  auth.Tenant(ctx),
+	})
+}
+
+func (r *Repository) GetCurrencyRate(ctx context.Context, base, quote string) (models.CurrencyRate, error) {
+	res, err := r.q.GetCurrencyRate(ctx, db.GetCurrencyRateParams{Base: base, Quote: quote, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.CurrencyRate{}, models.ErrNotFound
+		}
+		return models.CurrencyRate{}, err
+	}
+	return rateFromDB(db.ListCurrencyRatesRow(res)), nil
+}
+
+func (r *Repository) ListCurrencyRates(ctx context.Context) ([]models.CurrencyRate, error) {
+	ress, err := r.q.ListCurrencyRates(ctx, auth.Tenant(ctx))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]models.CurrencyRate, len(ress))
+	for i, res := range ress {
+		result[i] = rateFromDB(res)
+	}
+	return result, nil
+}
+
+func (r *Repository) DeleteCurrencyRate(ctx context.Context, base, quote string) error {
+	rows, err := r.q.DeleteCurrencyRate(ctx, db.DeleteCurrencyRateParams{Base: base, Quote: quote, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return models.ErrNotFound
+	}
+	return nil
+}
+
+func rateFromDB(res db.ListCurrencyRatesRow) models.CurrencyRate {
+	return models.CurrencyRate{
+		Rate: money.Rate{
+			Base:      res.Base,
+			Quote:     res.Quote,
+			Rate:      res.Rate,
+			Rounding:  money.Rounding(res.Rounding),
+			Increment: res.Increment,
+		},
+		UpdatedAt: res.UpdatedAt.Time,
+	}
+}
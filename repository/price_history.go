@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+	"github.com/glekoz/online-shop_product/repository/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *Repository) SchedulePrice(ctx context.Context, productID string, price money.Money, at time.Time) (int64, error) {
+	id, err := r.q.AddPriceHistory(ctx, db.AddPriceHistoryParams{
+		ProductID:     productID,
+		Amount:        price.Amount,
+		Currency:      price.Currency,
+		EffectiveFrom: timestamptz(at),
+		TenantID:      auth.Tenant(ctx),
+	})
+	return id, categoryError(err)
+}
+
+// CancelScheduledPrice удаляет только еще не примененное изменение
+func (r *Repository) CancelScheduledPrice(ctx context.Context, id int64) error {
+	rows, err := r.q.DeleteScheduledPrice(ctx, db.DeleteScheduledPriceParams{ID: id, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return models.ErrNotFound
+	}
+	return nil
+}
+
+func (r *Repository) ListPriceHistory(ctx context.Context, productID string, limit, offset int) ([]models.PriceChange, error) {
+	ress, err := r.q.ListPriceHistory(ctx, db.ListPriceHistoryParams{
+		ProductID: productID,
+		TenantID:  auth.Tenant(ctx),
+		Limit:     int32(limit),
+		Offset:    int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]models.PriceChange, len(ress))
+	for i, res := range ress {
+		result[i] = models.PriceChange{
+			ID:            res.ID,
+			ProductID:     res.ProductID,
+			Price:         money.New(res.Amount, res.Currency),
+			EffectiveFrom: res.EffectiveFrom.Time,
+			EffectiveTo:   res.EffectiveTo.Time,
+			Applied:       res.Applied,
+		}
+	}
+	return result, nil
+}
+
+// PriceAt возвращает базовую цену, действовавшую в момент at
+func (r *Repository) PriceAt(ctx context.Context, productID string, at time.Time) (money.Money, error) {
+	res, err := r.q.GetPriceAt(ctx, db.GetPriceAtParams{ProductID: productID, TenantID: auth.Tenant(ctx), At: timestamptz(at)})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return money.Money{}, models.ErrNotFound
+		}
+		return money.Money{}, err
+	}
+	return money.New(res.Amount, res.Currency), nil
+}
+
+// ApplyDuePrices применяет до limit наступивших изменений цены и возвращает,
+// сколько применил. Кэш чистится после коммита, чтобы никто не успел
+// положить туда старую цену из незакоммиченного состояния.
+// Планировщик один на все магазины: магазин берется из каждого изменения
+func (r *Repository) ApplyDuePrices(ctx context.Context, limit int) (int, error) {
+	var keys []string
+	err := r.inTx(log.WithTenantID(ctx, allTenants), func(q *db.Queries) error {
+		due, err := q.ListDuePrices(ctx, int32(limit))
+		if err != nil {
+			return err
+		}
+		for _, d := range due {
+			ctx := log.WithTenantID(ctx, d.TenantID)
+			price := money.New(d.Amount, d.Currency)
+			closed, err := q.ClosePriceHistory(ctx, db.ClosePriceHistoryParams{EffectiveTo: d.EffectiveFrom, ProductID: d.ProductID, TenantID: d.TenantID})
+			if err != nil {
+				return err
+			}
+			// цену могли поменять вручную между наступлением срока и тиком
+			// планировщика: тогда новая действует с момента, когда закрыта ручная
+			from := d.EffectiveFrom
+			if len(closed) > 0 {
+				from = closed[0]
+			}
+			if err := q.MarkPriceApplied(ctx, db.MarkPriceAppliedParams{EffectiveFrom: from, ID: d.ID, TenantID: d.TenantID}); err != nil {
+				return err
+			}
+			cur, err := q.GetForUpdate(ctx, db.GetForUpdateParams{ID: d.ProductID, TenantID: d.TenantID})
+			if err != nil {
+				return err
+			}
+			if err := updateBasePrice(ctx, q, d.ProductID, price); err != nil {
+				return err
+			}
+			// в журнале без автора: цену меняет планировщик
+			old := stateFromDB(cur.Name, cur.Description, cur.Price, cur.Currency, cur.ExternalSku)
+			if err := addAudit(ctx, q, d.ProductID, models.AuditUpdate, old, old.withPrice(price)); err != nil {
+				return err
+			}
+			keys = append(keys, cacheKey(ctx, d.ProductID))
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	for _, key := range keys {
+		r.cache.Delete(key)
+	}
+	return len(keys), nil
+}
+
+// setBasePrice меняет цену товара прямо сейчас и записывает это в историю
+func setBasePrice(ctx context.Context, q *db.Queries, productID string, price money.Money, at time.Time) error {
+	if err := updateBasePrice(ctx, q, productID, price); err != nil {
+		return err
+	}
+	return recordPrice(ctx, q, productID, price, at)
+}
+
+func updateBasePrice(ctx context.Context, q *db.Queries, productID string, price money.Money) error {
+	rows, err := q.SetProductBasePrice(ctx, db.SetProductBasePriceParams{
+		ID:       productID,
+		Price:    price.Amount,
+		Currency: price.Currency,
+		TenantID: auth.Tenant(ctx),
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return models.ErrNotFound
+	}
+	_, err = q.DeleteProductPrice(ctx, db.DeleteProductPriceParams{ProductID: productID, Currency: price.Currency, TenantID: auth.Tenant(ctx)})
+	return err
+}
+
+// recordPrice закрывает действующую цену и открывает новую с момента at
+func recordPrice(ctx context.Context, q *db.Queries, productID string, price money.Money, at time.Time) error {
+	if _, err := q.ClosePriceHistory(ctx, db.ClosePriceHistoryParams{EffectiveTo: timestamptz(at), ProductID: productID, TenantID: auth.Tenant(ctx)}); err != nil {
+		return err
+	}
+	_, err := q.AddPriceHistory(ctx, db.AddPriceHistoryParams{
+		ProductID:     productID,
+		Amount:        price.Amount,
+		Currency:      price.Currency,
+		EffectiveFrom: timestamptz(at),
+		Applied:       true,
+		TenantID:      auth.Tenant(ctx),
+	})
+	return err
+}
+
+func timestamptz(t time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: t, Valid: true}
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+)
+
+// Срок отложенной цены наступил, но до тика планировщика цену поменяли
+// вручную. Раньше закрытие ручной цены задним числом нарушало CHECK в
+// price_history, и планировщик навсегда застревал на этом изменении
+func TestApplyDuePricesAfterManualChange(t *testing.T) {
+	r := testRepository(t)
+	ctx := context.Background()
+	if err := r.Create(ctx, "p1", models.Product{Name: "Donut", Price: money.New(10000, "RUB"), Description: "Tasty"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.SchedulePrice(ctx, "p1", money.New(9000, "RUB"), time.Now().Add(-time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.SetBasePrice(ctx, "p1", money.New(9500, "RUB")); err != nil {
+		t.Fatal(err)
+	}
+
+	if n, err := r.ApplyDuePrices(ctx, 10); err != nil || n != 1 {
+		t.Fatalf("ApplyDuePrices: %d, %v", n, err)
+	}
+	if n, err := r.ApplyDuePrices(ctx, 10); err != nil || n != 0 {
+		t.Fatalf("second ApplyDuePrices: %d, %v", n, err)
+	}
+	p, err := r.Get(ctx, "p1")
+	if err != nil || p.Price != money.New(9000, "RUB") {
+		t.Fatalf("price after tick: %v, %v", p.Price, err)
+	}
+	if at, err := r.PriceAt(ctx, "p1", time.Now()); err != nil || at != money.New(9000, "RUB") {
+		t.Fatalf("PriceAt: %v, %v", at, err)
+	}
+
+	history, err := r.ListPriceHistory(ctx, "p1", 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	open := 0
+	for _, h := range history {
+		if h.EffectiveTo.IsZero() {
+			open++
+		} else if h.EffectiveTo.Before(h.EffectiveFrom) {
+			t.Fatalf("price %d closed before it started: %+v", h.ID, h)
+		}
+	}
+	if len(history) != 3 || open != 1 {
+		t.Fatalf("history: %+v", history)
+	}
+}
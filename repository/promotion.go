@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+	"github.com/glekoz/online-shop_product/pkg/promo"
+	"github.com/glekoz/online-shop_product/repository/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *Repository) CreatePromotion(ctx context.Context, id string, p promo.Promotion) error {
+	arg := db.CreatePromotionParams(promotionParams(ctx, id, p))
+	return categoryError(r.q.CreatePromotion(ctx, arg))
+}
+
+func (r *Repository) UpdatePromotion(ctx context.Context, id string, p promo.Promotion) error {
+	rows, err := r.q.UpdatePromotion(ctx, promotionParams(ctx, id, p))
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return models.ErrNotFound
+	}
+	return nil
+}
+
+func (r *Repository) DeletePromotion(ctx context.Context, id string) error {
+	rows, err := r.q.DeletePromotion(ctx, db.DeletePromotionParams{ID: id, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return models.ErrNotFound
+	}
+	return nil
+}
+
+func (r *Repository) GetPromotion(ctx context.Context, id string) (promo.Promotion, error) {
+	res, err := r.q.GetPromotion(ctx, db.GetPromotionParams{ID: id, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return promo.Promotion{}, models.ErrNotFound
+		}
+		return promo.Promotion{}, err
+	}
+	return promotionFromDB(res), nil
+}
+
+func (r *Repository) ListPromotions(ctx context.Context) ([]promo.Promotion, error) {
+	ress, err := r.q.ListPromotions(ctx, auth.Tenant(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return promotionsFromDB(ress), nil
+}
+
+// ListCurrentPromotions - действующие и будущие акции, без закончившихся
+func (r *Repository) ListCurrentPromotions(ctx context.Context) ([]promo.Promotion, error) {
+	ress, err := r.q.ListCurrentPromotions(ctx, auth.Tenant(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return promotionsFromDB(ress), nil
+}
+
+// ProductCategoryPaths возвращает для каждого товара его категории вместе с предками
+func (r *Repository) ProductCategoryPaths(ctx context.Context, productIDs []string) (map[string][]string, error) {
+	ress, err := r.q.ListProductCategoryPaths(ctx, db.ListProductCategoryPathsParams{ProductIds: productIDs, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string][]string)
+	for _, res := range ress {
+		result[res.ProductID] = append(result[res.ProductID], res.CategoryID)
+	}
+	return result, nil
+}
+
+func promotionParams(ctx context.Context, id string, p promo.Promotion) db.UpdatePromotionParams {
+	arg := db.UpdatePromotionParams{
+		ID:        id,
+		Name:      p.Name,
+		Kind:      string(p.Kind),
+		Value:     p.Value,
+		Target:    string(p.Target),
+		TargetID:  nullText(p.TargetID),
+		StartsAt:  nullTime(p.StartsAt),
+		EndsAt:    nullTime(p.EndsAt),
+		Priority:  int32(p.Priority),
+		Stackable: p.Stackable,
+		TenantID:  auth.Tenant(ctx),
+	}
+	if p.Kind == promo.Fixed {
+		arg.Value = p.Amount.Amount
+		arg.Currency = nullText(p.Amount.Currency)
+	}
+	return arg
+}
+
+func promotionsFromDB(ress []db.Promotion) []promo.Promotion {
+	result := make([]promo.Promotion, len(ress))
+	for i, res := range ress {
+		result[i] = promotionFromDB(res)
+	}
+	return result
+}
+
+func promotionFromDB(res db.Promotion) promo.Promotion {
+	p := promo.Promotion{
+		ID:        res.ID,
+		Name:      res.Name,
+		Kind:      promo.Kind(res.Kind),
+		Target:    promo.Target(res.Target),
+		TargetID:  res.TargetID.String,
+		StartsAt:  res.StartsAt.Time,
+		EndsAt:    res.EndsAt.Time,
+		Priority:  int(res.Priority),
+		Stackable: res.Stackable,
+	}
+	if p.Kind == promo.Fixed {
+		p.Amount = money.New(res.Value, res.Currency.String)
+	} else {
+		p.Value = res.Value
+	}
+	return p
+}
+
+func nullTime(t time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: t, Valid: !t.IsZero()}
+}
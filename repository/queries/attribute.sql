@@ -0,0 +1,173 @@
+-- name: CreateAttributeDefinition :exec
+INSERT INTO attribute_definitions(id, category_id, code, name, type, enum_values, tenant_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: DeleteAttributeDefinition :execrows
+DELETE
+FROM attribute_definitions
+WHERE id = $1 AND tenant_id = $2;
+
+-- определения категории и всех её предков
+-- name: ListCategoryAttributeDefinitions :many
+WITH RECURSIVE up AS (
+    SELECT c.id, c.parent_id
+    FROM categories c
+    WHERE c.id = $1 AND c.tenant_id = $2
+    UNION
+    SELECT c.id, c.parent_id
+    FROM categories c
+    JOIN up ON c.id = up.parent_id
+)
+SELECT d.id, d.category_id, d.code, d.name, d.type, d.enum_values
+FROM attribute_definitions d
+WHERE d.category_id IN (SELECT id FROM up)
+ORDER BY d.code;
+
+-- определения всех категорий товара и их предков
+-- name: ListProductAttributeDefinitions :many
+WITH RECURSIVE up AS (
+    SELECT c.id, c.parent_id
+    FROM categories c
+    JOIN product_categories pc ON pc.category_id = c.id
+    WHERE pc.product_id = $1 AND pc.tenant_id = $2
+    UNION
+    SELECT c.id, c.parent_id
+    FROM categories c
+    JOIN up ON c.id = up.parent_id
+)
+SELECT d.id, d.category_id, d.code, d.name, d.type, d.enum_values
+FROM attribute_definitions d
+WHERE d.category_id IN (SELECT id FROM up)
+ORDER BY d.code;
+
+-- name: SetProductAttributes :execrows
+UPDATE products
+SET attributes = $2, updated_at = NOW()
+WHERE id = $1 AND tenant_id = $3;
+
+-- name: GetProductAttributes :one
+SELECT attributes
+FROM products
+WHERE id = $1 AND tenant_id = $2;
+
+-- Фильтры передаются как jsonb, чтобы запрос оставался статическим:
+-- any_of - [{"k": "color", "v": ["red", "blue"]}], значение товара должно быть одним из v;
+-- ranges - [{"k": "weight", "min": 1, "max": 5}], min и max необязательны.
+-- Пустая category_id - весь каталог.
+
+-- name: FilterProducts :many
+WITH RECURSIVE tree AS (
+    SELECT c.id
+    FROM categories c
+    WHERE c.id = @category_id::text
+    UNION
+    SELECT c.id
+    FROM categories c
+    JOIN tree t ON c.parent_id = t.id
+    WHERE @include_descendants::boolean
+)
+SELECT p.id, p.name, p.slug, p.price, p.currency, product_in_stock(p.id) AS available
+FROM products p
+WHERE p.tenant_id = @tenant_id AND (@all_statuses::boolean OR p.status = 'published')
+    AND (@category_id::text = '' OR EXISTS (
+        SELECT 1
+        FROM product_categories pc
+        WHERE pc.product_id = p.id AND pc.category_id IN (SELECT id FROM tree)
+    ))
+    AND NOT EXISTS (
+        SELECT 1
+        FROM jsonb_array_elements(@any_of::jsonb) f
+        WHERE NOT (f.value->'v') @> jsonb_build_array(p.attributes->(f.value->>'k'))
+    )
+    AND NOT EXISTS (
+        SELECT 1
+        FROM jsonb_array_elements(@ranges::jsonb) f
+        WHERE CASE
+            WHEN jsonb_typeof(p.attributes->(f.value->>'k')) IS DISTINCT FROM 'number' THEN TRUE
+            ELSE (f.value ? 'min' AND (p.attributes->>(f.value->>'k'))::numeric < (f.value->>'min')::numeric)
+                OR (f.value ? 'max' AND (p.attributes->>(f.value->>'k'))::numeric > (f.value->>'max')::numeric)
+        END
+    )
+ORDER BY p.name
+LIMIT @lim
+OFFSET @off;
+
+-- name: FilterProductsValueFacets :many
+WITH RECURSIVE tree AS (
+    SELECT c.id
+    FROM categories c
+    WHERE c.id = @category_id::text
+    UNION
+    SELECT c.id
+    FROM categories c
+    JOIN tree t ON c.parent_id = t.id
+    WHERE @include_descendants::boolean
+), filtered AS (
+    SELECT p.attributes
+    FROM products p
+    WHERE p.tenant_id = @tenant_id AND (@all_statuses::boolean OR p.status = 'published')
+        AND (@category_id::text = '' OR EXISTS (
+            SELECT 1
+            FROM product_categories pc
+            WHERE pc.product_id = p.id AND pc.category_id IN (SELECT id FROM tree)
+        ))
+        AND NOT EXISTS (
+            SELECT 1
+            FROM jsonb_array_elements(@any_of::jsonb) f
+            WHERE NOT (f.value->'v') @> jsonb_build_array(p.attributes->(f.value->>'k'))
+        )
+        AND NOT EXISTS (
+            SELECT 1
+            FROM jsonb_array_elements(@ranges::jsonb) f
+            WHERE CASE
+                WHEN jsonb_typeof(p.attributes->(f.value->>'k')) IS DISTINCT FROM 'number' THEN TRUE
+                ELSE (f.value ? 'min' AND (p.attributes->>(f.value->>'k'))::numeric < (f.value->>'min')::numeric)
+                    OR (f.value ? 'max' AND (p.attributes->>(f.value->>'k'))::numeric > (f.value->>'max')::numeric)
+            END
+        )
+)
+SELECT kv.key::text AS code, kv.value::jsonb AS value, COUNT(*) AS count
+FROM filtered, jsonb_each(filtered.attributes) kv
+WHERE jsonb_typeof(kv.value) IN ('string', 'boolean')
+GROUP BY kv.key, kv.value
+ORDER BY kv.key, COUNT(*) DESC;
+
+-- name: FilterProductsRangeFacets :many
+WITH RECURSIVE tree AS (
+    SELECT c.id
+    FROM categories c
+    WHERE c.id = @category_id::text
+    UNION
+    SELECT c.id
+    FROM categories c
+    JOIN tree t ON c.parent_id = t.id
+    WHERE @include_descendants::boolean
+), filtered AS (
+    SELECT p.attributes
+    FROM products p
+    WHERE p.tenant_id = @tenant_id AND (@all_statuses::boolean OR p.status = 'published')
+        AND (@category_id::text = '' OR EXISTS (
+            SELECT 1
+            FROM product_categories pc
+            WHERE pc.product_id = p.id AND pc.category_id IN (SELECT id FROM tree)
+        ))
+        AND NOT EXISTS (
+            SELECT 1
+            FROM jsonb_array_elements(@any_of::jsonb) f
+            WHERE NOT (f.value->'v') @> jsonb_build_array(p.attributes->(f.value->>'k'))
+        )
+        AND NOT EXISTS (
+            SELECT 1
+            FROM jsonb_array_elements(@ranges::jsonb) f
+            WHERE CASE
+                WHEN jsonb_typeof(p.attributes->(f.value->>'k')) IS DISTINCT FROM 'number' THEN TRUE
+                ELSE (f.value ? 'min' AND (p.attributes->>(f.value->>'k'))::numeric < (f.value->>'min')::numeric)
+                    OR (f.value ? 'max' AND (p.attributes->>(f.value->>'k'))::numeric > (f.value->>'max')::numeric)
+            END
+        )
+)
+SELECT kv.key::text AS code, MIN(kv.value::text::float8)::float8 AS min_value, MAX(kv.value::text::float8)::float8 AS max_value
+FROM filtered, jsonb_each(filtered.attributes) kv
+WHERE jsonb_typeof(kv.value) = 'number'
+GROUP BY kv.key
+ORDER BY kv.key;
@@ -0,0 +1,19 @@
+-- name: AddAuditEntry :exec
+INSERT INTO audit_log (product_id, action, actor, request_id, diff, tenant_id)
+VALUES (@product_id, @action, @actor, @request_id, @diff, @tenant_id);
+
+-- для массовых операций и импорта
+-- name: CopyAuditEntries :copyfrom
+INSERT INTO audit_log (product_id, action, actor, request_id, diff, tenant_id)
+VALUES (@product_id, @action, @actor, @request_id, @diff, @tenant_id);
+
+-- Пустой фильтр не ограничивает; новые записи первыми
+-- name: ListAuditEntries :many
+SELECT id, product_id, action, actor, request_id, diff, created_at
+FROM audit_log
+WHERE tenant_id = @tenant_id
+  AND (sqlc.narg(product_id)::text IS NULL OR product_id = sqlc.narg(product_id))
+  AND (sqlc.narg(actor)::text IS NULL OR actor = sqlc.narg(actor))
+ORDER BY id DESC
+LIMIT @lim
+OFFSET @off;
@@ -0,0 +1,29 @@
+-- Товары массовой операции. FOR UPDATE в порядке id - чтобы две пачки
+-- с пересекающимися товарами не взяли блокировки навстречу друг другу
+-- name: LockProducts :many
+SELECT id, name, price, currency, description, external_sku
+FROM products
+WHERE tenant_id = @tenant_id AND id = ANY(@ids::text[])
+ORDER BY id
+FOR UPDATE;
+
+-- name: SetProductBasePrices :batchexec
+UPDATE products
+SET price = @price, currency = @currency, updated_at = NOW()
+WHERE id = @id AND tenant_id = @tenant_id;
+
+-- отдельная цена в валюте базовой больше не нужна, как в SetBasePrice
+-- name: DeleteProductPriceOverrides :batchexec
+DELETE
+FROM product_prices
+WHERE product_id = @product_id AND tenant_id = @tenant_id AND currency = @currency;
+
+-- name: ListImagesOfProducts :many
+SELECT *
+FROM product_images
+WHERE tenant_id = @tenant_id AND product_id = ANY(@product_ids::text[]);
+
+-- name: DeleteProducts :execrows
+DELETE
+FROM products
+WHERE tenant_id = @tenant_id AND id = ANY(@ids::text[]);
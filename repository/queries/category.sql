@@ -0,0 +1,98 @@
+-- name: CreateCategory :exec
+INSERT INTO categories(id, parent_id, name, slug, tenant_id)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: GetCategory :one
+SELECT id, parent_id, name, slug
+FROM categories
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: GetCategoryBySlug :one
+SELECT id, parent_id, name, slug
+FROM categories
+WHERE slug = $1 AND tenant_id = $2;
+
+-- name: ListCategories :many
+SELECT id, parent_id, name, slug
+FROM categories
+WHERE tenant_id = $1
+ORDER BY name;
+
+-- name: UpdateCategory :execrows
+UPDATE categories
+SET parent_id = $2, name = $3, slug = $4, updated_at = NOW()
+WHERE id = $1 AND tenant_id = $5;
+
+-- перенос категорий в магазине по одному: иначе два встречных переноса
+-- (A под B и B под A) оба пройдут проверку на цикл
+-- name: LockCategoryTree :exec
+SELECT pg_advisory_xact_lock(hashtext('categories:' || @tenant_id::text));
+
+-- name: DeleteCategory :execrows
+DELETE
+FROM categories
+WHERE id = $1 AND tenant_id = $2;
+
+-- в результат входит и сама категория. UNION, а не UNION ALL: если в данных
+-- все же окажется цикл, обход закончится, а не зависнет
+-- name: CategorySubtreeIDs :many
+WITH RECURSIVE tree AS (
+    SELECT c.id
+    FROM categories c
+    WHERE c.id = $1 AND c.tenant_id = $2
+    UNION
+    SELECT c.id
+    FROM categories c
+    JOIN tree t ON c.parent_id = t.id
+)
+SELECT id
+FROM tree;
+
+-- name: DeleteProductCategories :exec
+DELETE
+FROM product_categories
+WHERE product_id = $1 AND tenant_id = $2;
+
+-- name: AddProductCategory :exec
+INSERT INTO product_categories(product_id, category_id, tenant_id)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- name: ListProductCategories :many
+SELECT c.id, c.parent_id, c.name, c.slug
+FROM categories c
+JOIN product_categories pc ON pc.category_id = c.id
+WHERE pc.product_id = $1 AND pc.tenant_id = $2
+ORDER BY c.name;
+
+-- name: ListProductsInCategory :many
+SELECT p.id, p.name, p.slug, p.price, p.currency, product_in_stock(p.id) AS available
+FROM products p
+JOIN product_categories pc ON pc.product_id = p.id
+WHERE pc.category_id = @category_id AND pc.tenant_id = @tenant_id
+    AND (@all_statuses::boolean OR p.status = 'published')
+ORDER BY p.name
+LIMIT @lim
+OFFSET @off;
+
+-- товары категории и всех её потомков
+-- name: ListProductsInCategoryTree :many
+WITH RECURSIVE tree AS (
+    SELECT c.id
+    FROM categories c
+    WHERE c.id = @category_id AND c.tenant_id = @tenant_id
+    UNION
+    SELECT c.id
+    FROM categories c
+    JOIN tree t ON c.parent_id = t.id
+)
+SELECT p.id, p.name, p.slug, p.price, p.currency, product_in_stock(p.id) AS available
+FROM products p
+WHERE EXISTS (
+    SELECT 1
+    FROM product_categories pc
+    WHERE pc.product_id = p.id AND pc.category_id IN (SELECT id FROM tree)
+) AND (@all_statuses::boolean OR p.status = 'published')
+ORDER BY p.name
+LIMIT @lim
+OFFSET @off;
@@ -0,0 +1,23 @@
+-- Выгрузка идет курсором в транзакции: все пачки видят один снимок базы,
+-- а в памяти одновременно лежит только одна пачка.
+-- Пустой category_ids - все категории.
+-- Фиды для площадок выгружаются с published_only: черновикам там не место
+-- name: DeclareExportCursor :exec
+DECLARE export_cursor NO SCROLL CURSOR FOR
+SELECT p.id, p.external_sku, p.name, p.slug, p.description, p.price, p.currency,
+       product_in_stock(p.id) AS available, p.updated_at
+FROM products p
+WHERE p.tenant_id = @tenant_id
+  AND (cardinality(@category_ids::text[]) = 0 OR EXISTS (
+        SELECT 1
+        FROM product_categories pc
+        WHERE pc.product_id = p.id AND pc.category_id = ANY(@category_ids::text[])
+    ))
+  AND (sqlc.narg(currency)::text IS NULL OR p.currency = sqlc.narg(currency))
+  AND (NOT @in_stock_only::boolean OR product_in_stock(p.id))
+  AND (sqlc.narg(updated_since)::timestamp IS NULL OR p.updated_at >= sqlc.narg(updated_since))
+  AND (NOT @published_only::boolean OR p.status = 'published')
+ORDER BY p.id;
+
+-- name: FetchExportCursor :many
+FETCH FORWARD 500 FROM export_cursor;
@@ -4,22 +4,37 @@
 -- ни одной, то ошибки нет - будет пустой срез.
 
 -- name: Create :exec
-INSERT INTO products(id, name, price, description)
-VALUES ($1, $2, $3, $4);
+INSERT INTO products(id, name, price, currency, description, tenant_id, slug)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
 
 -- name: Get :one
-SELECT name, price, description
+SELECT name, price, currency, description, slug, status, publish_at, unpublish_at
 FROM products
-WHERE id = $1;
+WHERE id = $1 AND tenant_id = $2;
 
+-- текущие значения под блокировкой: для истории цен и аудита
+-- name: GetForUpdate :one
+SELECT name, price, currency, description, external_sku, slug, status, publish_at, unpublish_at
+FROM products
+WHERE id = $1 AND tenant_id = $2
+FOR UPDATE;
+
+-- all_statuses = false - только опубликованные, как видит витрина
 -- name: GetAll :many
-SELECT id, name, price
-FROM products;
+SELECT p.id, p.name, p.slug, p.price, p.currency, product_in_stock(p.id) AS available
+FROM products p
+WHERE p.tenant_id = @tenant_id AND (@all_statuses::boolean OR p.status = 'published');
+
+-- Все найденные из ids за один запрос, порядок не гарантирован
+-- name: GetMany :many
+SELECT id, name, price, currency, description, slug, status, publish_at, unpublish_at
+FROM products
+WHERE tenant_id = @tenant_id AND id = ANY(@ids::text[]);
 
 -- name: Delete :execrows
 DELETE
 FROM products
-WHERE id = $1;
+WHERE id = $1 AND tenant_id = $2;
 
 -- тут сначала будет Гет, потом из переданных в функцию 
 -- аргументов выбираются ненулевые и заменяются в структуре из Гет
@@ -27,12 +42,13 @@ WHERE id = $1;
 
 -- name: Update :execrows
 UPDATE products
-SET name = $2, price = $3, description = $4
-WHERE id = $1;
+SET name = $2, price = $3, currency = $4, description = $5
+WHERE id = $1 AND tenant_id = $6;
 
 -- name: OrderedOffsetGetAll :many
 SELECT id, name, price, description
 FROM products
+WHERE tenant_id = $4
 ORDER BY $1
 LIMIT $2
 OFFSET $3;
\ No newline at end of file
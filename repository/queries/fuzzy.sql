@@ -0,0 +1,36 @@
+-- Порог похожести для операторов % и <% берется из настроек сессии.
+-- is_local = true: порог действует до конца транзакции и не утекает
+-- в пул соединений, поэтому вызывать внутри той же транзакции, что и поиск
+-- name: SetTrigramThreshold :exec
+SELECT set_config('pg_trgm.similarity_threshold', @threshold::real::text, true),
+    set_config('pg_trgm.word_similarity_threshold', @threshold::real::text, true);
+
+-- word_similarity сравнивает запрос с самым похожим куском названия,
+-- поэтому "donutt" находит "Glazed Donut", хотя с названием целиком сходство низкое
+-- name: FuzzySearchProducts :many
+SELECT id, name, slug, price, currency, product_in_stock(id) AS available,
+    word_similarity(@query::text, name) AS similarity,
+    COUNT(*) OVER () AS total
+FROM products
+WHERE @query::text <% name AND tenant_id = @tenant_id
+    AND (@all_statuses::boolean OR status = 'published')
+ORDER BY similarity DESC, id
+LIMIT @lim
+OFFSET @off;
+
+-- name: SuggestProductNames :many
+SELECT name
+FROM products
+WHERE @query::text <% name AND tenant_id = @tenant_id
+    AND (@all_statuses::boolean OR status = 'published')
+ORDER BY word_similarity(@query::text, name) DESC, name
+LIMIT @lim;
+
+-- Для поиска дублей названия сравниваются целиком: "Donut" и "Glazed Donut" -
+-- разные товары, а "Glazed Donut" и "Glazed Donuts" - вероятно, один
+-- name: SimilarProducts :many
+SELECT id, name, similarity(name, @name::text) AS similarity
+FROM products
+WHERE name % @name::text AND tenant_id = @tenant_id
+ORDER BY similarity DESC, id
+LIMIT @lim;
@@ -0,0 +1,73 @@
+-- блокирует товар: параллельные загрузки иначе получат одну позицию
+-- и обе попробуют стать главной картинкой
+-- name: LockProductImages :one
+SELECT id
+FROM products
+WHERE id = $1 AND tenant_id = $2
+FOR UPDATE;
+
+-- первая картинка товара становится главной
+-- name: AddImage :one
+INSERT INTO product_images(id, product_id, position, is_primary, storage_key, content_type, size, width, height, alt, thumbnails, tenant_id)
+SELECT @id::text, @product_id::text, COALESCE(MAX(i.position) + 1, 0), COUNT(*) = 0,
+    @storage_key::text, @content_type::text, @size::bigint, @width::int, @height::int, @alt::text, @thumbnails::jsonb, @tenant_id::text
+FROM product_images i
+WHERE i.product_id = @product_id::text
+RETURNING position, is_primary;
+
+-- name: GetImage :one
+SELECT *
+FROM product_images
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: ListImages :many
+SELECT *
+FROM product_images
+WHERE product_id = $1 AND tenant_id = $2
+ORDER BY position;
+
+-- главные картинки для списков товаров
+-- name: ListPrimaryImages :many
+SELECT *
+FROM product_images
+WHERE product_id = ANY(@product_ids::text[]) AND tenant_id = @tenant_id AND is_primary;
+
+-- name: DeleteImage :execrows
+DELETE
+FROM product_images
+WHERE id = $1 AND tenant_id = $2;
+
+-- если главной картинки не осталось, главной становится первая
+-- name: PromoteFirstImage :exec
+UPDATE product_images
+SET is_primary = TRUE
+WHERE id = (
+        SELECT id
+        FROM product_images
+        WHERE product_id = $1
+        ORDER BY position
+        LIMIT 1
+    )
+    AND NOT EXISTS (
+        SELECT 1
+        FROM product_images
+        WHERE product_id = $1 AND is_primary
+    );
+
+-- снимать флаг и ставить новый нужно разными запросами: уникальный индекс
+-- проверяется на каждой строке, а не в конце UPDATE
+-- name: ClearPrimaryImage :exec
+UPDATE product_images
+SET is_primary = FALSE
+WHERE product_id = $1 AND is_primary;
+
+-- name: SetPrimaryImage :execrows
+UPDATE product_images
+SET is_primary = TRUE
+WHERE id = $1 AND product_id = $2 AND tenant_id = $3;
+
+-- name: ReorderImages :execrows
+UPDATE product_images i
+SET position = o.ord - 1
+FROM unnest(@image_ids::text[]) WITH ORDINALITY AS o(id, ord)
+WHERE i.id = o.id AND i.product_id = @product_id AND i.tenant_id = @tenant_id;
@@ -0,0 +1,28 @@
+-- Товары, с которыми могут совпасть строки импорта. FOR UPDATE - чтобы
+-- параллельная запись не изменила их между сверкой и обновлением
+-- name: LockImportCandidates :many
+SELECT id, name, price, currency, description, external_sku, slug
+FROM products
+WHERE tenant_id = @tenant_id AND (external_sku = ANY(@skus::text[]) OR name = ANY(@names::text[]))
+ORDER BY id
+FOR UPDATE;
+
+-- name: CopyProducts :copyfrom
+INSERT INTO products (id, name, price, currency, description, external_sku, tenant_id, slug)
+VALUES (@id, @name, @price, @currency, @description, @external_sku, @tenant_id, @slug);
+
+-- name: CopyPriceHistory :copyfrom
+INSERT INTO price_history (product_id, amount, currency, effective_from, applied, tenant_id)
+VALUES (@product_id, @amount, @currency, @effective_from, @applied, @tenant_id);
+
+-- name: UpdateImportedProduct :batchexec
+UPDATE products
+SET name = @name, price = @price, currency = @currency, description = @description,
+    external_sku = @external_sku, slug = @slug, updated_at = NOW()
+WHERE id = @id AND tenant_id = @tenant_id;
+
+-- то же, что ClosePriceHistory, но пачкой
+-- name: ClosePriceHistories :batchexec
+UPDATE price_history
+SET effective_to = @effective_to
+WHERE product_id = @product_id AND tenant_id = @tenant_id AND applied AND effective_to IS NULL;
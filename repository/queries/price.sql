@@ -0,0 +1,49 @@
+-- name: SetProductBasePrice :execrows
+UPDATE products
+SET price = $2, currency = $3, updated_at = NOW()
+WHERE id = $1 AND tenant_id = $4;
+
+-- name: SetProductPrice :exec
+INSERT INTO product_prices(product_id, currency, amount, tenant_id)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (product_id, currency) DO UPDATE
+SET amount = EXCLUDED.amount, updated_at = NOW();
+
+-- name: GetProductPrice :one
+SELECT amount
+FROM product_prices
+WHERE product_id = $1 AND currency = $2 AND tenant_id = $3;
+
+-- name: ListProductPrices :many
+SELECT currency, amount
+FROM product_prices
+WHERE product_id = $1 AND tenant_id = $2
+ORDER BY currency;
+
+-- name: DeleteProductPrice :execrows
+DELETE
+FROM product_prices
+WHERE product_id = $1 AND currency = $2 AND tenant_id = $3;
+
+-- курс передается и читается строкой, чтобы не терять точность на float
+-- name: UpsertCurrencyRate :exec
+INSERT INTO currency_rates(base, quote, rate, rounding, increment, tenant_id)
+VALUES (@base, @quote, @rate::text::numeric, @rounding, @increment, @tenant_id)
+ON CONFLICT (tenant_id, base, quote) DO UPDATE
+SET rate = EXCLUDED.rate, rounding = EXCLUDED.rounding, increment = EXCLUDED.increment, updated_at = NOW();
+
+-- name: GetCurrencyRate :one
+SELECT base, quote, trim_scale(rate)::text AS rate, rounding, increment, updated_at
+FROM currency_rates
+WHERE base = $1 AND quote = $2 AND tenant_id = $3;
+
+-- name: ListCurrencyRates :many
+SELECT base, quote, trim_scale(rate)::text AS rate, rounding, increment, updated_at
+FROM currency_rates
+WHERE tenant_id = $1
+ORDER BY base, quote;
+
+-- name: DeleteCurrencyRate :execrows
+DELETE
+FROM currency_rates
+WHERE base = $1 AND quote = $2 AND tenant_id = $3;
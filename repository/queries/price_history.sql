@@ -0,0 +1,49 @@
+-- name: AddPriceHistory :one
+INSERT INTO price_history(product_id, amount, currency, effective_from, applied, tenant_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id;
+
+-- закрывает действующую цену; вызывается перед тем, как применить новую.
+-- Раньше своего начала цена не закрывается: если ее поменяли вручную уже после
+-- времени отложенного изменения, оно начнет действовать с момента закрытия
+-- name: ClosePriceHistory :many
+UPDATE price_history
+SET effective_to = GREATEST(effective_from, @effective_to)
+WHERE product_id = @product_id AND tenant_id = @tenant_id AND applied AND effective_to IS NULL
+RETURNING effective_to;
+
+-- name: GetPriceAt :one
+SELECT amount, currency
+FROM price_history
+WHERE product_id = @product_id AND tenant_id = @tenant_id AND applied
+    AND effective_from <= @at AND (effective_to IS NULL OR effective_to > @at)
+ORDER BY effective_from DESC
+LIMIT 1;
+
+-- name: ListPriceHistory :many
+SELECT id, product_id, amount, currency, effective_from, effective_to, applied
+FROM price_history
+WHERE product_id = $1 AND tenant_id = $2
+ORDER BY effective_from DESC, id DESC
+LIMIT $3
+OFFSET $4;
+
+-- SKIP LOCKED - несколько реплик сервиса могут применять цены одновременно.
+-- Единственный запрос по всем магазинам: планировщик общий
+-- name: ListDuePrices :many
+SELECT id, tenant_id, product_id, amount, currency, effective_from
+FROM price_history
+WHERE NOT applied AND effective_from <= NOW()
+ORDER BY effective_from, id
+LIMIT $1
+FOR UPDATE SKIP LOCKED;
+
+-- name: MarkPriceApplied :exec
+UPDATE price_history
+SET applied = TRUE, effective_from = @effective_from
+WHERE id = @id AND tenant_id = @tenant_id;
+
+-- name: DeleteScheduledPrice :execrows
+DELETE
+FROM price_history
+WHERE id = $1 AND tenant_id = $2 AND NOT applied;
@@ -0,0 +1,47 @@
+-- name: CreatePromotion :exec
+INSERT INTO promotions(id, name, kind, value, currency, target, target_id, starts_at, ends_at, priority, stackable, tenant_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
+
+-- name: UpdatePromotion :execrows
+UPDATE promotions
+SET name = $2, kind = $3, value = $4, currency = $5, target = $6, target_id = $7,
+    starts_at = $8, ends_at = $9, priority = $10, stackable = $11, updated_at = NOW()
+WHERE id = $1 AND tenant_id = $12;
+
+-- name: DeletePromotion :execrows
+DELETE
+FROM promotions
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: GetPromotion :one
+SELECT *
+FROM promotions
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: ListPromotions :many
+SELECT *
+FROM promotions
+WHERE tenant_id = $1
+ORDER BY priority DESC, id;
+
+-- закончившиеся акции движку не нужны, будущие нужны: кэш живет дольше, чем до их начала
+-- name: ListCurrentPromotions :many
+SELECT *
+FROM promotions
+WHERE tenant_id = $1 AND (ends_at IS NULL OR ends_at > NOW())
+ORDER BY priority DESC, id;
+
+-- категории товаров вместе со всеми предками - для акций на категорию
+-- name: ListProductCategoryPaths :many
+WITH RECURSIVE paths AS (
+    SELECT pc.product_id, c.id, c.parent_id
+    FROM product_categories pc
+    JOIN categories c ON c.id = pc.category_id
+    WHERE pc.product_id = ANY(@product_ids::text[]) AND pc.tenant_id = @tenant_id
+    UNION
+    SELECT p.product_id, c.id, c.parent_id
+    FROM categories c
+    JOIN paths p ON c.id = p.parent_id
+)
+SELECT DISTINCT product_id, id AS category_id
+FROM paths;
@@ -0,0 +1,59 @@
+-- name: CreateReservation :execrows
+INSERT INTO stock_reservations(id, expires_at, tenant_id)
+VALUES (@id, NOW() + make_interval(secs => @ttl_seconds::float8), @tenant_id)
+ON CONFLICT (tenant_id, id) DO NOTHING;
+
+-- name: GetReservation :one
+SELECT id, status, expires_at, expires_at < NOW() AS expired
+FROM stock_reservations
+WHERE id = $1 AND tenant_id = $2;
+
+-- блокирует резерв до конца транзакции, чтобы Commit, Release и чистильщик не гонялись
+-- name: GetReservationForUpdate :one
+SELECT id, status, expires_at, expires_at < NOW() AS expired
+FROM stock_reservations
+WHERE id = $1 AND tenant_id = $2
+FOR UPDATE;
+
+-- name: SetReservationStatus :exec
+UPDATE stock_reservations
+SET status = $2, updated_at = NOW()
+WHERE id = $1 AND tenant_id = $3;
+
+-- name: AddReservationItem :exec
+INSERT INTO stock_reservation_items(reservation_id, product_id, location, quantity, tenant_id)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ListReservationItems :many
+SELECT product_id, location, quantity
+FROM stock_reservation_items
+WHERE reservation_id = $1 AND tenant_id = $2
+ORDER BY product_id, location;
+
+-- условное обновление вместо SELECT FOR UPDATE: строка блокируется самим UPDATE,
+-- а проверка свободного остатка выполняется уже над заблокированной строкой
+-- name: ReserveStock :execrows
+UPDATE stock_levels
+SET reserved = reserved + @amount, updated_at = NOW()
+WHERE product_id = @product_id AND location = @location AND tenant_id = @tenant_id AND quantity - reserved >= @amount;
+
+-- name: UnreserveStock :exec
+UPDATE stock_levels
+SET reserved = reserved - @amount, updated_at = NOW()
+WHERE product_id = @product_id AND location = @location AND tenant_id = @tenant_id;
+
+-- name: CommitReservedStock :one
+UPDATE stock_levels
+SET quantity = quantity - @amount, reserved = reserved - @amount, updated_at = NOW()
+WHERE product_id = @product_id AND location = @location AND tenant_id = @tenant_id
+RETURNING quantity;
+
+-- SKIP LOCKED - несколько экземпляров сервиса чистят разные резервы.
+-- Чистильщик общий для всех магазинов
+-- name: ListExpiredReservations :many
+SELECT id, tenant_id
+FROM stock_reservations
+WHERE status = 'active' AND expires_at < NOW()
+ORDER BY expires_at
+LIMIT $1
+FOR UPDATE SKIP LOCKED;
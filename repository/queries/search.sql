@@ -0,0 +1,36 @@
+-- ts_headline дорогой, поэтому подсветка считается только для строк страницы.
+-- total - число всех найденных товаров: оконная функция считается до LIMIT.
+-- Диапазон цен - по цене из прайса в валюте currency, без учета акций
+-- name: SearchProducts :many
+WITH q AS (
+    SELECT CASE
+        WHEN @prefix::boolean THEN to_tsquery(@language::text::regconfig, @query::text)
+        ELSE websearch_to_tsquery(@language::text::regconfig, @query::text)
+    END AS query
+), hits AS (
+    SELECT p.id, p.name, p.slug, p.description, p.price, p.currency,
+        ts_rank(p.search_vector, q.query) AS rank,
+        COUNT(*) OVER () AS total
+    FROM products p, q
+    WHERE p.search_vector @@ q.query
+        AND p.tenant_id = @tenant_id
+        AND (@currency::text = '' OR p.currency = @currency::text)
+        AND (sqlc.narg(min_price)::bigint IS NULL OR p.price >= sqlc.narg(min_price)::bigint)
+        AND (sqlc.narg(max_price)::bigint IS NULL OR p.price <= sqlc.narg(max_price)::bigint)
+        AND (@all_statuses::boolean OR p.status = 'published')
+    ORDER BY rank DESC, p.id
+    LIMIT @lim
+    OFFSET @off
+)
+SELECT h.id, h.name, h.slug, h.price, h.currency, product_in_stock(h.id) AS available, h.rank, h.total,
+    ts_headline(@language::text::regconfig, h.name, q.query,
+        'HighlightAll=true, StartSel=<mark>, StopSel=</mark>')::text AS name_highlight,
+    ts_headline(@language::text::regconfig, h.description, q.query,
+        'MaxFragments=2, MinWords=5, MaxWords=20, FragmentDelimiter=" … ", StartSel=<mark>, StopSel=</mark>')::text AS snippet
+FROM hits h, q
+ORDER BY h.rank DESC, h.id;
+
+-- name: SetProductSearchLanguage :execrows
+UPDATE products
+SET search_language = @language::text::regconfig, updated_at = NOW()
+WHERE id = @id AND tenant_id = @tenant_id;
@@ -0,0 +1,32 @@
+-- Занятые слаги с той же основой: сама основа и она же с суффиксом -N
+-- name: ListSlugsLike :many
+SELECT slug, product_id
+FROM product_slugs
+WHERE tenant_id = @tenant_id
+  AND (slug = ANY(@bases::text[]) OR substring(slug FROM '^(.*)-[0-9]+$') = ANY(@bases::text[]));
+
+-- Раздача слагов с одной основой идет по очереди: иначе два параллельных
+-- Create выберут один и тот же свободный слаг. Блокировки берутся по
+-- порядку, чтобы импорты с пересекающимися основами не ловили deadlock
+-- name: LockSlugBases :exec
+SELECT pg_advisory_xact_lock(hashtext('slug:' || @tenant_id::text || ':' || b))
+FROM (SELECT DISTINCT b FROM unnest(@bases::text[]) AS b ORDER BY b) AS bases;
+
+-- name: AddProductSlug :exec
+INSERT INTO product_slugs (tenant_id, slug, product_id)
+VALUES (@tenant_id, @slug, @product_id);
+
+-- name: CopyProductSlugs :copyfrom
+INSERT INTO product_slugs (tenant_id, slug, product_id)
+VALUES (@tenant_id, @slug, @product_id);
+
+-- name: SetProductSlug :exec
+UPDATE products
+SET slug = @slug
+WHERE id = @id AND tenant_id = @tenant_id;
+
+-- и текущий, и старый слаг; старый приложение отдает редиректом
+-- name: GetProductIDBySlug :one
+SELECT product_id
+FROM product_slugs
+WHERE tenant_id = @tenant_id AND slug = @slug;
@@ -0,0 +1,24 @@
+-- Запланированное сбрасывается, если в новом статусе оно уже не исполнится:
+-- публикация ждет только в in_review, снятие - в in_review и published
+-- name: SetProductStatus :exec
+UPDATE products
+SET status = @status::text,
+    publish_at = CASE WHEN @status::text = 'in_review' THEN publish_at END,
+    unpublish_at = CASE WHEN @status::text IN ('in_review', 'published') THEN unpublish_at END,
+    updated_at = NOW()
+WHERE id = @id AND tenant_id = @tenant_id;
+
+-- name: SetProductSchedule :exec
+UPDATE products
+SET publish_at = @publish_at, unpublish_at = @unpublish_at, updated_at = NOW()
+WHERE id = @id AND tenant_id = @tenant_id;
+
+-- SKIP LOCKED и один запрос по всем магазинам - как у ListDuePrices
+-- name: ListDueStatusChanges :many
+SELECT id, tenant_id, status, unpublish_at
+FROM products
+WHERE (status = 'in_review' AND publish_at <= NOW())
+    OR (status = 'published' AND unpublish_at <= NOW())
+ORDER BY id
+LIMIT $1
+FOR UPDATE SKIP LOCKED;
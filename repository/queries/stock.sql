@@ -0,0 +1,53 @@
+-- name: IncrementStock :one
+INSERT INTO stock_levels(product_id, location, quantity, tenant_id)
+VALUES (@product_id, @location, @amount, @tenant_id)
+ON CONFLICT (product_id, location) DO UPDATE
+SET quantity = stock_levels.quantity + EXCLUDED.quantity, updated_at = NOW()
+RETURNING quantity;
+
+-- условие в WHERE не дает уйти в минус при конкурентных списаниях
+-- и списать то, что зарезервировано под заказы
+-- name: DecrementStock :one
+UPDATE stock_levels
+SET quantity = quantity - @amount, updated_at = NOW()
+WHERE product_id = @product_id AND location = @location AND tenant_id = @tenant_id AND quantity - reserved >= @amount
+RETURNING quantity;
+
+-- name: AddStockMovement :exec
+INSERT INTO stock_movements(product_id, location, delta, quantity_after, reason, actor, tenant_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: ListStockLevels :many
+SELECT location, quantity, reserved
+FROM stock_levels
+WHERE product_id = $1 AND tenant_id = $2
+ORDER BY location;
+
+-- name: GetLowStockThreshold :one
+SELECT low_stock_threshold
+FROM products
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: SetLowStockThreshold :execrows
+UPDATE products
+SET low_stock_threshold = $2, updated_at = NOW()
+WHERE id = $1 AND tenant_id = $3;
+
+-- name: ListLowStock :many
+SELECT p.id, p.low_stock_threshold, COALESCE(SUM(s.quantity), 0)::int AS total
+FROM products p
+LEFT JOIN stock_levels s ON s.product_id = p.id
+WHERE p.tenant_id = @tenant_id AND p.low_stock_threshold > 0
+GROUP BY p.id
+HAVING COALESCE(SUM(s.quantity), 0) <= p.low_stock_threshold
+ORDER BY total, p.id
+LIMIT @lim
+OFFSET @off;
+
+-- name: ListStockMovements :many
+SELECT id, product_id, location, delta, quantity_after, reason, actor, created_at, tenant_id
+FROM stock_movements
+WHERE product_id = @product_id AND tenant_id = @tenant_id
+ORDER BY id DESC
+LIMIT @lim
+OFFSET @off;
@@ -0,0 +1,36 @@
+-- name: UpsertProductTranslation :exec
+INSERT INTO product_translations (tenant_id, product_id, locale, name, description)
+VALUES (@tenant_id, @product_id, @locale, @name, @description)
+ON CONFLICT (tenant_id, product_id, locale) DO UPDATE
+SET name = EXCLUDED.name, description = EXCLUDED.description, updated_at = NOW();
+
+-- name: DeleteProductTranslation :execrows
+DELETE FROM product_translations
+WHERE tenant_id = @tenant_id AND product_id = @product_id AND locale = @locale;
+
+-- name: ListProductTranslations :many
+SELECT locale, name, description, updated_at
+FROM product_translations
+WHERE tenant_id = @tenant_id AND product_id = @product_id
+ORDER BY locale;
+
+-- Переводы нескольких товаров сразу в нескольких локалях: какую из них
+-- отдать, решает приложение по цепочке локалей клиента
+-- name: FindTranslations :many
+SELECT product_id, locale, name, description
+FROM product_translations
+WHERE tenant_id = @tenant_id
+  AND product_id = ANY(@product_ids::text[])
+  AND locale = ANY(@locales::text[]);
+
+-- name: ListMissingTranslations :many
+SELECT p.id, p.name
+FROM products p
+WHERE p.tenant_id = @tenant_id
+  AND NOT EXISTS (
+    SELECT 1 FROM product_translations t
+    WHERE t.tenant_id = p.tenant_id AND t.product_id = p.id AND t.locale = @locale
+  )
+ORDER BY p.name, p.id
+LIMIT @lim
+OFFSET @off;
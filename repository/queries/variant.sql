@@ -0,0 +1,33 @@
+-- name: CreateVariant :exec
+INSERT INTO product_variants(id, product_id, sku, price_override, options, barcode, tenant_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- price - итоговая цена с учетом цены родительского товара
+-- name: GetVariant :one
+SELECT v.id, v.product_id, v.sku, v.price_override, COALESCE(v.price_override, p.price)::bigint AS price, p.currency, v.options, v.barcode
+FROM product_variants v
+JOIN products p ON p.id = v.product_id
+WHERE v.id = $1 AND v.tenant_id = $2;
+
+-- name: GetVariantBySKU :one
+SELECT v.id, v.product_id, v.sku, v.price_override, COALESCE(v.price_override, p.price)::bigint AS price, p.currency, v.options, v.barcode
+FROM product_variants v
+JOIN products p ON p.id = v.product_id
+WHERE v.sku = $1 AND v.tenant_id = $2;
+
+-- name: ListProductVariants :many
+SELECT v.id, v.product_id, v.sku, v.price_override, COALESCE(v.price_override, p.price)::bigint AS price, p.currency, v.options, v.barcode
+FROM product_variants v
+JOIN products p ON p.id = v.product_id
+WHERE v.product_id = $1 AND v.tenant_id = $2
+ORDER BY v.sku;
+
+-- name: UpdateVariant :execrows
+UPDATE product_variants
+SET sku = $2, price_override = $3, options = $4, barcode = $5, updated_at = NOW()
+WHERE id = $1 AND tenant_id = $6;
+
+-- name: DeleteVariant :execrows
+DELETE
+FROM product_variants
+WHERE id = $1 AND tenant_id = $2;
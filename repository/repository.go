@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"github.com/glekoz/cache"
+	"github.com/glekoz/online-shop_product/pkg/auth"
 	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
 	"github.com/glekoz/online-shop_product/repository/db"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -20,14 +22,53 @@ type Repository struct {
 	q     *db.Queries
 	pool  *pgxpool.Pool
 	cache *cache.Cache[string, models.Product]
+	// slugs - слаг -> id товара, ключи как у cache
+	slugs *cache.Cache[string, string]
 }
 
-func New(dsn string) (*Repository, error) {
+// allTenants - магазин фоновых задач, которые обходят все магазины сразу:
+// с ним политики RLS пропускают все строки
+const allTenants = "*"
+
+type options struct {
+	rowLevelSecurity bool
+}
+
+type Option func(options *options)
+
+// WithRowLevelSecurity выставляет магазин запроса в app.tenant_id на каждом
+// взятом из пула соединении, и политики RLS в Postgres отсекают чужие строки,
+// даже если в запросе забыт фильтр по tenant_id. Стоит лишний запрос на каждое соединение
+func WithRowLevelSecurity() Option {
+	return func(options *options) {
+		options.rowLevelSecurity = true
+	}
+}
+
+func New(dsn string, opts ...Option) (*Repository, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
 	c, err := cache.New[string, models.Product]()
 	if err != nil {
 		return nil, err
 	}
-	p, err := pgxpool.New(context.Background(), dsn)
+	slugs, err := cache.New[string, string]()
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, err
+	}
+	if o.rowLevelSecurity {
+		cfg.PrepareConn = func(ctx context.Context, conn *pgx.Conn) (bool, error) {
+			_, err := conn.Exec(ctx, "SELECT set_config('app.tenant_id', $1, false)", auth.Tenant(ctx))
+			return err == nil, err
+		}
+	}
+	p, err := pgxpool.NewWithConfig(context.Background(), cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -36,38 +77,60 @@ func New(dsn string) (*Repository, error) {
 		q:     q,
 		pool:  p,
 		cache: c,
+		slugs: slugs,
 	}, nil
 }
 
 func (r *Repository) Create(ctx context.Context, id string, prod models.Product) error {
-	err := r.q.Create(ctx, db.CreateParams{
-		ID:          id,
-		Name:        prod.Name,
-		Price:       int32(prod.Price),
-		Description: prod.Description},
-	)
+	err := r.inTx(ctx, func(q *db.Queries) error {
+		var err error
+		if prod.Slug, _, err = pickSlug(ctx, q, id, prod.Name); err != nil {
+			return err
+		}
+		err = q.Create(ctx, db.CreateParams{
+			ID:          id,
+			Name:        prod.Name,
+			Price:       prod.Price.Amount,
+			Currency:    prod.Price.Currency,
+			Description: prod.Description,
+			TenantID:    auth.Tenant(ctx),
+			Slug:        prod.Slug},
+		)
+		if err != nil {
+			return err
+		}
+		if err := q.AddProductSlug(ctx, db.AddProductSlugParams{TenantID: auth.Tenant(ctx), Slug: prod.Slug, ProductID: id}); err != nil {
+			return err
+		}
+		if err := recordPrice(ctx, q, id, prod.Price, time.Now()); err != nil {
+			return err
+		}
+		return addAudit(ctx, q, id, models.AuditCreate, nil, productState(prod))
+	})
 	if err != nil {
 		var errp *pgconn.PgError
 		if errors.As(err, &errp) {
-			if errp.Code == models.UniqueErrCode {
+			if errp.Code == models.UniqueErrCode && !slugConflict(errp) {
 				return models.ErrAlreadyExists
 			}
 		}
 		return err
 	}
-	r.cache.Add(id, models.Product{
+	r.cache.Add(cacheKey(ctx, id), models.Product{
 		Name:        prod.Name,
 		Price:       prod.Price,
 		Description: prod.Description,
+		Slug:        prod.Slug,
+		Status:      models.StatusDraft,
 	}, 30*time.Second)
 	return nil
 }
 
 func (r *Repository) Get(ctx context.Context, id string) (models.Product, error) {
-	if res, ok := r.cache.Get(id); ok {
+	if res, ok := r.cache.Get(cacheKey(ctx, id)); ok {
 		return res, nil
 	}
-	res, err := r.q.Get(ctx, id)
+	res, err := r.q.Get(ctx, db.GetParams{ID: id, TenantID: auth.Tenant(ctx)})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return models.Product{}, models.ErrNotFound
@@ -76,13 +139,48 @@ func (r *Repository) Get(ctx context.Context, id string) (models.Product, error)
 	}
 	return models.Product{
 		Name:        res.Name,
-		Price:       int(res.Price),
+		Price:       money.New(res.Price, res.Currency),
 		Description: res.Description,
+		Slug:        res.Slug,
+		Status:      models.ProductStatus(res.Status),
+		Schedule:    scheduleFromDB(res.PublishAt, res.UnpublishAt),
 	}, nil
 }
 
+// GetMany возвращает найденные товары по id: из кэша, а промахи - одним запросом.
+// Ненайденных id в ответе нет
+func (r *Repository) GetMany(ctx context.Context, ids []string) (map[string]models.Product, error) {
+	res := make(map[string]models.Product, len(ids))
+	var misses []string
+	for _, id := range ids {
+		if p, ok := r.cache.Get(cacheKey(ctx, id)); ok {
+			res[id] = p
+			continue
+		}
+		misses = append(misses, id)
+	}
+	if len(misses) == 0 {
+		return res, nil
+	}
+	rows, err := r.q.GetMany(ctx, db.GetManyParams{TenantID: auth.Tenant(ctx), Ids: misses})
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		res[row.ID] = models.Product{
+			Name:        row.Name,
+			Price:       money.New(row.Price, row.Currency),
+			Description: row.Description,
+			Slug:        row.Slug,
+			Status:      models.ProductStatus(row.Status),
+			Schedule:    scheduleFromDB(row.PublishAt, row.UnpublishAt),
+		}
+	}
+	return res, nil
+}
+
 func (r *Repository) GetAll(ctx context.Context) ([]models.ProductDigest, error) {
-	ress, err := r.q.GetAll(ctx)
+	ress, err := r.q.GetAll(ctx, db.GetAllParams{TenantID: auth.Tenant(ctx), AllStatuses: auth.CatalogAdmin(ctx)})
 	if err != nil {
 		return nil, err
 	}
@@ -92,39 +190,125 @@ func (r *Repository) GetAll(ctx context.Context) ([]models.ProductDigest, error)
 	var result = make([]models.ProductDigest, len(ress))
 	for i, res := range ress {
 		result[i] = models.ProductDigest{
-			ID:    res.ID,
-			Name:  res.Name,
-			Price: int(res.Price),
+			ID:        res.ID,
+			Name:      res.Name,
+			Slug:      res.Slug,
+			Price:     money.New(res.Price, res.Currency),
+			Available: res.Available,
 		}
 	}
 	return result, nil
 }
 
 func (r *Repository) Delete(ctx context.Context, id string) error {
-	rows, err := r.q.Delete(ctx, id)
+	err := r.inTx(ctx, func(q *db.Queries) error {
+		cur, err := q.GetForUpdate(ctx, db.GetForUpdateParams{ID: id, TenantID: auth.Tenant(ctx)})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return models.ErrNotFound
+			}
+			return err
+		}
+		if _, err := q.Delete(ctx, db.DeleteParams{ID: id, TenantID: auth.Tenant(ctx)}); err != nil {
+			return err
+		}
+		old := stateFromDB(cur.Name, cur.Description, cur.Price, cur.Currency, cur.ExternalSku)
+		return addAudit(ctx, q, id, models.AuditDelete, old, nil)
+	})
 	if err != nil {
 		return err
 	}
-	if rows < 1 {
-		return models.ErrNotFound
-	}
-	r.cache.Delete(id)
+	r.cache.Delete(cacheKey(ctx, id))
 	return nil
 }
 
 func (r *Repository) Update(ctx context.Context, id string, prod models.Product) error {
-	rows, err := r.q.Update(ctx, db.UpdateParams{
-		ID:          id,
-		Name:        prod.Name,
-		Price:       int32(prod.Price),
-		Description: prod.Description,
+	err := r.inTx(ctx, func(q *db.Queries) error {
+		cur, err := q.GetForUpdate(ctx, db.GetForUpdateParams{ID: id, TenantID: auth.Tenant(ctx)})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return models.ErrNotFound
+			}
+			return err
+		}
+		// Update - путь старого контракта, он знает только рубли; валюту
+		// меняет SetBasePrice, иначе 1999 центов молча станут 1999 копейками
+		if cur.Currency != prod.Price.Currency {
+			return models.ErrLegacyCurrency
+		}
+		if _, err := q.Update(ctx, db.UpdateParams{
+			ID:          id,
+			Name:        prod.Name,
+			Price:       prod.Price.Amount,
+			Currency:    prod.Price.Currency,
+			Description: prod.Description,
+			TenantID:    auth.Tenant(ctx),
+		}); err != nil {
+			return err
+		}
+		if money.New(cur.Price, cur.Currency) != prod.Price {
+			if err := recordPrice(ctx, q, id, prod.Price, time.Now()); err != nil {
+				return err
+			}
+		}
+		if prod.Slug, err = renameSlug(ctx, q, id, cur.Name, prod.Name, cur.Slug); err != nil {
+			return err
+		}
+		prod.Status, prod.Schedule = models.ProductStatus(cur.Status), scheduleFromDB(cur.PublishAt, cur.UnpublishAt)
+		// артикул Update не меняет
+		old := stateFromDB(cur.Name, cur.Description, cur.Price, cur.Currency, cur.ExternalSku)
+		next := productState(prod)
+		next.SKU = old.SKU
+		return addAudit(ctx, q, id, models.AuditUpdate, old, next)
 	})
 	if err != nil {
 		return err
 	}
-	if rows < 1 {
-		return models.ErrNotFound
+	r.cache.Add(cacheKey(ctx, id), prod, 30*time.Second)
+	return nil
+}
+
+// Ping проверяет, что Postgres отвечает (используется в health-check)
+func (r *Repository) Ping(ctx context.Context) error {
+	return r.pool.Ping(ctx)
+}
+
+// PingCache кладет в кэш пробный ключ и читает его обратно
+func (r *Repository) PingCache(_ context.Context) error {
+	const key = "healthcheck:probe"
+	if err := r.cache.Add(key, models.Product{}, time.Second); err != nil {
+		return err
+	}
+	defer r.cache.Delete(key)
+	if _, ok := r.cache.Get(key); !ok {
+		return errors.New("cache probe key is missing")
 	}
-	r.cache.Add(id, prod, 30*time.Second)
 	return nil
 }
+
+// MaxConns - размер пула соединений, от него считается лимит одновременных запросов
+func (r *Repository) MaxConns() int {
+	return int(r.pool.Config().MaxConns)
+}
+
+func (r *Repository) Close() {
+	r.pool.Close()
+}
+
+// cacheKey - кэш общий для всех магазинов, поэтому в ключе и магазин, и id
+func cacheKey(ctx context.Context, id string) string {
+	return auth.Tenant(ctx) + "/" + id
+}
+
+// inTx выполняет fn в транзакции, при ошибке транзакция откатывается
+func (r *Repository) inTx(ctx context.Context, fn func(q *db.Queries) error) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	if err := fn(r.q.WithTx(tx)); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
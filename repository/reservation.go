@@ -0,0 +1,235 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/repository/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// Reserve создает резерв и удерживает остатки всех позиций в одной транзакции:
+// если хотя бы одной позиции не хватает, не резервируется ничего.
+// Позиции должны быть отсортированы (product_id, location), чтобы конкурентные
+// резервы блокировали строки stock_levels в одном порядке и не ловили deadlock.
+// Повторный вызов с тем же ID и теми же позициями возвращает существующий резерв.
+func (r *Repository) Reserve(ctx context.Context, res models.Reservation, ttl time.Duration) (models.Reservation, error) {
+	var result models.Reservation
+	err := r.inTx(ctx, func(q *db.Queries) error {
+		created, err := q.CreateReservation(ctx, db.CreateReservationParams{ID: res.ID, TtlSeconds: ttl.Seconds(), TenantID: auth.Tenant(ctx)})
+		if err != nil {
+			return err
+		}
+		if created == 0 {
+			existing, _, err := getReservation(ctx, q, res.ID, false)
+			if err != nil {
+				return err
+			}
+			if !slices.Equal(existing.Items, res.Items) {
+				return models.ErrReservationConflict
+			}
+			result = existing
+			return nil
+		}
+		for _, it := range res.Items {
+			rows, err := q.ReserveStock(ctx, db.ReserveStockParams{
+				Amount:    int32(it.Quantity),
+				ProductID: it.ProductID,
+				Location:  it.Location,
+				TenantID:  auth.Tenant(ctx),
+			})
+			if err != nil {
+				return err
+			}
+			if rows == 0 {
+				return fmt.Errorf("%w: %s at %s", models.ErrInsufficientStock, it.ProductID, it.Location)
+			}
+			err = q.AddReservationItem(ctx, db.AddReservationItemParams{
+				ReservationID: res.ID,
+				ProductID:     it.ProductID,
+				Location:      it.Location,
+				Quantity:      int32(it.Quantity),
+				TenantID:      auth.Tenant(ctx),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		result, _, err = getReservation(ctx, q, res.ID, false)
+		return err
+	})
+	return result, err
+}
+
+// CommitReservation списывает зарезервированный товар со склада.
+// Повторный Commit возвращает резерв без изменений; Commit просроченного
+// резерва освобождает товар и возвращает ErrReservationExpired.
+func (r *Repository) CommitReservation(ctx context.Context, id, actor string) (models.Reservation, error) {
+	var (
+		result  models.Reservation
+		expired bool
+	)
+	err := r.inTx(ctx, func(q *db.Queries) error {
+		res, isExpired, err := getReservation(ctx, q, id, true)
+		if err != nil {
+			return err
+		}
+		switch {
+		case res.Status == models.ReservationCommitted:
+			result = res
+			return nil
+		case res.Status != models.ReservationActive:
+			return models.ErrReservationClosed
+		case isExpired:
+			// истек, но чистильщик еще не дошел
+			expired = true
+			result, err = releaseReservation(ctx, q, res, models.ReservationExpired)
+			return err
+		}
+		for _, it := range res.Items {
+			qty, err := q.CommitReservedStock(ctx, db.CommitReservedStockParams{
+				Amount:    int32(it.Quantity),
+				ProductID: it.ProductID,
+				Location:  it.Location,
+				TenantID:  auth.Tenant(ctx),
+			})
+			if err != nil {
+				return err
+			}
+			err = q.AddStockMovement(ctx, db.AddStockMovementParams{
+				ProductID:     it.ProductID,
+				Location:      it.Location,
+				Delta:         int32(-it.Quantity),
+				QuantityAfter: qty,
+				Reason:        "reservation " + id + " committed",
+				Actor:         actor,
+				TenantID:      auth.Tenant(ctx),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if err := q.SetReservationStatus(ctx, db.SetReservationStatusParams{ID: id, Status: string(models.ReservationCommitted), TenantID: auth.Tenant(ctx)}); err != nil {
+			return err
+		}
+		res.Status = models.ReservationCommitted
+		result = res
+		return nil
+	})
+	if err == nil && expired {
+		return result, models.ErrReservationExpired
+	}
+	return result, err
+}
+
+// ReleaseReservation возвращает товар в свободный остаток; повторный
+// Release и Release истекшего резерва ничего не делают
+func (r *Repository) ReleaseReservation(ctx context.Context, id string) (models.Reservation, error) {
+	var result models.Reservation
+	err := r.inTx(ctx, func(q *db.Queries) error {
+		res, _, err := getReservation(ctx, q, id, true)
+		if err != nil {
+			return err
+		}
+		switch res.Status {
+		case models.ReservationReleased, models.ReservationExpired:
+			result = res
+			return nil
+		case models.ReservationCommitted:
+			return models.ErrReservationClosed
+		}
+		result, err = releaseReservation(ctx, q, res, models.ReservationReleased)
+		return err
+	})
+	return result, err
+}
+
+func (r *Repository) GetReservation(ctx context.Context, id string) (models.Reservation, error) {
+	res, _, err := getReservation(ctx, r.q, id, false)
+	return res, err
+}
+
+// ExpireReservations освобождает до limit просроченных резервов всех магазинов
+// и возвращает, сколько освободил
+func (r *Repository) ExpireReservations(ctx context.Context, limit int) (int, error) {
+	var n int
+	err := r.inTx(log.WithTenantID(ctx, allTenants), func(q *db.Queries) error {
+		expired, err := q.ListExpiredReservations(ctx, int32(limit))
+		if err != nil {
+			return err
+		}
+		for _, e := range expired {
+			ctx := log.WithTenantID(ctx, e.TenantID)
+			res, _, err := getReservation(ctx, q, e.ID, false)
+			if err != nil {
+				return err
+			}
+			if _, err := releaseReservation(ctx, q, res, models.ReservationExpired); err != nil {
+				return err
+			}
+		}
+		n = len(expired)
+		return nil
+	})
+	return n, err
+}
+
+func releaseReservation(ctx context.Context, q *db.Queries, res models.Reservation, status models.ReservationStatus) (models.Reservation, error) {
+	for _, it := range res.Items {
+		err := q.UnreserveStock(ctx, db.UnreserveStockParams{
+			Amount:    int32(it.Quantity),
+			ProductID: it.ProductID,
+			Location:  it.Location,
+			TenantID:  auth.Tenant(ctx),
+		})
+		if err != nil {
+			return models.Reservation{}, err
+		}
+	}
+	if err := q.SetReservationStatus(ctx, db.SetReservationStatusParams{ID: res.ID, Status: string(status), TenantID: auth.Tenant(ctx)}); err != nil {
+		return models.Reservation{}, err
+	}
+	res.Status = status
+	return res, nil
+}
+
+// getReservation дополнительно возвращает, истек ли срок резерва; сравнение
+// делается в базе, чтобы не зависеть от часов и часового пояса сервиса
+func getReservation(ctx context.Context, q *db.Queries, id string, forUpdate bool) (models.Reservation, bool, error) {
+	var (
+		row db.GetReservationRow
+		err error
+	)
+	if forUpdate {
+		var locked db.GetReservationForUpdateRow
+		locked, err = q.GetReservationForUpdate(ctx, db.GetReservationForUpdateParams{ID: id, TenantID: auth.Tenant(ctx)})
+		row = db.GetReservationRow(locked)
+	} else {
+		row, err = q.GetReservation(ctx, db.GetReservationParams{ID: id, TenantID: auth.Tenant(ctx)})
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Reservation{}, false, models.ErrNotFound
+		}
+		return models.Reservation{}, false, err
+	}
+	items, err := q.ListReservationItems(ctx, db.ListReservationItemsParams{ReservationID: id, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		return models.Reservation{}, false, err
+	}
+	res := models.Reservation{
+		ID:        row.ID,
+		Status:    models.ReservationStatus(row.Status),
+		ExpiresAt: row.ExpiresAt.Time,
+	}
+	for _, it := range items {
+		res.Items = append(res.Items, models.ReservationItem{ProductID: it.ProductID, Location: it.Location, Quantity: int(it.Quantity)})
+	}
+	return res, row.Expired, nil
+}
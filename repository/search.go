@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+	"github.com/glekoz/online-shop_product/repository/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *Repository) Search(ctx context.Context, sq models.SearchQuery) (models.SearchResult, error) {
+	ress, err := r.q.SearchProducts(ctx, db.SearchProductsParams{
+		Prefix:      sq.Prefix,
+		Language:    sq.Language,
+		Query:       sq.Query,
+		TenantID:    auth.Tenant(ctx),
+		Currency:    sq.Currency,
+		MinPrice:    nullBound(sq.MinPrice),
+		MaxPrice:    nullBound(sq.MaxPrice),
+		AllStatuses: auth.CatalogAdmin(ctx),
+		Lim:         int32(sq.Limit),
+		Off:         int32(sq.Offset),
+	})
+	if err != nil {
+		return models.SearchResult{}, err
+	}
+	result := models.SearchResult{Hits: make([]models.SearchHit, len(ress))}
+	for i, res := range ress {
+		result.Hits[i] = models.SearchHit{
+			ProductDigest: models.ProductDigest{
+				ID:        res.ID,
+				Name:      res.Name,
+				Slug:      res.Slug,
+				Price:     money.New(res.Price, res.Currency),
+				Available: res.Available,
+			},
+			Rank:          res.Rank,
+			NameHighlight: res.NameHighlight,
+			Snippet:       res.Snippet,
+		}
+		result.Total = int(res.Total)
+	}
+	return result, nil
+}
+
+func (r *Repository) SetProductSearchLanguage(ctx context.Context, productID, language string) error {
+	rows, err := r.q.SetProductSearchLanguage(ctx, db.SetProductSearchLanguageParams{Language: language, ID: productID, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return models.ErrNotFound
+	}
+	return nil
+}
+
+// nullBound - в отличие от nullInt ноль здесь значимая граница
+func nullBound(n *int64) pgtype.Int8 {
+	if n == nil {
+		return pgtype.Int8{}
+	}
+	return pgtype.Int8{Int64: *n, Valid: true}
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/slug"
+	"github.com/glekoz/online-shop_product/repository/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// GetBySlug ищет товар по текущему или старому слагу; какой из них
+// текущий, видно по Slug товара. Слаг -> id кэшируется отдельно от товаров
+func (r *Repository) GetBySlug(ctx context.Context, s string) (string, models.Product, error) {
+	key := cacheKey(ctx, s)
+	id, cached := r.slugs.Get(key)
+	if !cached {
+		var err error
+		id, err = r.q.GetProductIDBySlug(ctx, db.GetProductIDBySlugParams{TenantID: auth.Tenant(ctx), Slug: s})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return "", models.Product{}, models.ErrNotFound
+			}
+			return "", models.Product{}, err
+		}
+		r.slugs.Add(key, id, 30*time.Second)
+	}
+	p, err := r.Get(ctx, id)
+	if errors.Is(err, models.ErrNotFound) && cached {
+		// товар удалили, пока слаг лежал в кэше; слаг мог уже достаться другому
+		r.slugs.Delete(key)
+		return r.GetBySlug(ctx, s)
+	}
+	if err != nil {
+		return "", models.Product{}, err
+	}
+	return id, p, nil
+}
+
+// slugAllocator раздает уникальные слаги, зная занятые в базе. Слаги
+// чужих товаров, в том числе старые, не выдаются: по ним уже ходят редиректы
+type slugAllocator struct {
+	taken map[string]string // слаг -> товар
+}
+
+func newSlugAllocator(ctx context.Context, q *db.Queries, bases []string) (*slugAllocator, error) {
+	// занятые читаем под блокировкой основ, она держится до конца транзакции
+	if err := q.LockSlugBases(ctx, db.LockSlugBasesParams{TenantID: auth.Tenant(ctx), Bases: slugLocks(bases)}); err != nil {
+		return nil, err
+	}
+	rows, err := q.ListSlugsLike(ctx, db.ListSlugsLikeParams{TenantID: auth.Tenant(ctx), Bases: bases})
+	if err != nil {
+		return nil, err
+	}
+	a := &slugAllocator{taken: make(map[string]string, len(rows))}
+	for _, row := range rows {
+		a.taken[row.Slug] = row.ProductID
+	}
+	return a, nil
+}
+
+// slugLocks - основы и они же без суффикса -N. Второй "Donut" займет donut-2,
+// а это основа "Donut 2": обе раздачи должны ждать друг друга на donut
+func slugLocks(bases []string) []string {
+	locks := slices.Clone(bases)
+	for _, b := range bases {
+		if i := strings.LastIndexByte(b, '-'); i > 0 && i < len(b)-1 && strings.Trim(b[i+1:], "0123456789") == "" {
+			locks = append(locks, b[:i])
+		}
+	}
+	return locks
+}
+
+// allocate - первый свободный из base, base-2, base-3... Свой старый слаг
+// товар получает обратно, fresh=false: записывать его в product_slugs не нужно
+func (a *slugAllocator) allocate(productID, base string) (s string, fresh bool) {
+	for n := 1; ; n++ {
+		s = base
+		if n > 1 {
+			s = base + "-" + strconv.Itoa(n)
+		}
+		owner, ok := a.taken[s]
+		if !ok {
+			a.taken[s] = productID
+			return s, true
+		}
+		if owner == productID {
+			return s, false
+		}
+	}
+}
+
+// pickSlug - слаг для одного товара по названию
+func pickSlug(ctx context.Context, q *db.Queries, productID, name string) (string, bool, error) {
+	base := slug.Make(name)
+	a, err := newSlugAllocator(ctx, q, []string{base})
+	if err != nil {
+		return "", false, err
+	}
+	s, fresh := a.allocate(productID, base)
+	return s, fresh, nil
+}
+
+// slugConflict - нарушено ограничение уникальности слага. Слаги раздаются
+// под LockSlugBases, так что это не повтор товара, а ошибка раздачи
+func slugConflict(errp *pgconn.PgError) bool {
+	return errp.ConstraintName == "products_tenant_slug_key" || errp.ConstraintName == "product_slugs_pkey"
+}
+
+// renameSlug меняет слаг, только если от нового названия получается другая
+// основа: опечатка в регистре или пунктуации ссылки не ломает
+func renameSlug(ctx context.Context, q *db.Queries, productID, oldName, newName, cur string) (string, error) {
+	if slug.Make(oldName) == slug.Make(newName) {
+		return cur, nil
+	}
+	s, fresh, err := pickSlug(ctx, q, productID, newName)
+	if err != nil {
+		return "", err
+	}
+	if fresh {
+		if err := q.AddProductSlug(ctx, db.AddProductSlugParams{TenantID: auth.Tenant(ctx), Slug: s, ProductID: productID}); err != nil {
+			return "", err
+		}
+	}
+	if err := q.SetProductSlug(ctx, db.SetProductSlugParams{Slug: s, ID: productID, TenantID: auth.Tenant(ctx)}); err != nil {
+		return "", err
+	}
+	return s, nil
+}
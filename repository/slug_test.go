@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSlugLocks(t *testing.T) {
+	got := slugLocks([]string{"donut", "donut-2", "donut-2-2", "7-up", "mix-"})
+	slices.Sort(got)
+	want := []string{"7-up", "donut", "donut", "donut-2", "donut-2", "donut-2-2", "mix-"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
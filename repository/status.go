@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/log"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/repository/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// SetStatus переводит товар в to, если check разрешает переход из текущего
+// статуса. Текущий читается под блокировкой строки, так что два одновременных
+// перехода не проскочат проверку оба
+func (r *Repository) SetStatus(ctx context.Context, id string, to models.ProductStatus, check func(from models.ProductStatus) error) error {
+	err := r.inTx(ctx, func(q *db.Queries) error {
+		cur, err := lockProduct(ctx, q, id)
+		if err != nil {
+			return err
+		}
+		if err := check(models.ProductStatus(cur.Status)); err != nil {
+			return err
+		}
+		if err := q.SetProductStatus(ctx, db.SetProductStatusParams{Status: string(to), ID: id, TenantID: auth.Tenant(ctx)}); err != nil {
+			return err
+		}
+		// расписание, которое в новом статусе не исполнится, сбрасывает SetProductStatus
+		next := lifecycleState(string(to), cur.PublishAt, cur.UnpublishAt)
+		if to != models.StatusInReview {
+			next.PublishAt = ""
+		}
+		if to != models.StatusInReview && to != models.StatusPublished {
+			next.UnpublishAt = ""
+		}
+		return addAudit(ctx, q, id, models.AuditUpdate, lifecycleState(cur.Status, cur.PublishAt, cur.UnpublishAt), next)
+	})
+	if err != nil {
+		return err
+	}
+	r.cache.Delete(cacheKey(ctx, id))
+	return nil
+}
+
+// SetSchedule заменяет расписание товара, если check разрешает его в текущем статусе
+func (r *Repository) SetSchedule(ctx context.Context, id string, s models.Schedule, check func(status models.ProductStatus) error) error {
+	err := r.inTx(ctx, func(q *db.Queries) error {
+		cur, err := lockProduct(ctx, q, id)
+		if err != nil {
+			return err
+		}
+		if err := check(models.ProductStatus(cur.Status)); err != nil {
+			return err
+		}
+		publishAt, unpublishAt := optionalTime(s.PublishAt), optionalTime(s.UnpublishAt)
+		if err := q.SetProductSchedule(ctx, db.SetProductScheduleParams{
+			PublishAt:   publishAt,
+			UnpublishAt: unpublishAt,
+			ID:          id,
+			TenantID:    auth.Tenant(ctx),
+		}); err != nil {
+			return err
+		}
+		old := lifecycleState(cur.Status, cur.PublishAt, cur.UnpublishAt)
+		return addAudit(ctx, q, id, models.AuditUpdate, old, lifecycleState(cur.Status, publishAt, unpublishAt))
+	})
+	if err != nil {
+		return err
+	}
+	r.cache.Delete(cacheKey(ctx, id))
+	return nil
+}
+
+// ApplyDueStatuses исполняет до limit наступивших публикаций и снятий и
+// возвращает, сколько исполнил. Как и ApplyDuePrices, обходит все магазины
+// и чистит кэш после коммита
+func (r *Repository) ApplyDueStatuses(ctx context.Context, limit int) (int, error) {
+	var keys []string
+	err := r.inTx(log.WithTenantID(ctx, allTenants), func(q *db.Queries) error {
+		due, err := q.ListDueStatusChanges(ctx, int32(limit))
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		for _, d := range due {
+			ctx := log.WithTenantID(ctx, d.TenantID)
+			to := models.StatusPublished
+			// снятие могло наступить, пока товар ждал публикации: тогда сразу снимаем
+			if d.Status == string(models.StatusPublished) || d.UnpublishAt.Valid && !d.UnpublishAt.Time.After(now) {
+				to = models.StatusDiscontinued
+			}
+			if err := q.SetProductStatus(ctx, db.SetProductStatusParams{Status: string(to), ID: d.ID, TenantID: d.TenantID}); err != nil {
+				return err
+			}
+			// в журнале без автора: статус меняет планировщик
+			old := &auditState{Status: d.Status}
+			if err := addAudit(ctx, q, d.ID, models.AuditUpdate, old, &auditState{Status: string(to)}); err != nil {
+				return err
+			}
+			keys = append(keys, cacheKey(ctx, d.ID))
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	for _, key := range keys {
+		r.cache.Delete(key)
+	}
+	return len(keys), nil
+}
+
+func lockProduct(ctx context.Context, q *db.Queries, id string) (db.GetForUpdateRow, error) {
+	cur, err := q.GetForUpdate(ctx, db.GetForUpdateParams{ID: id, TenantID: auth.Tenant(ctx)})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return cur, models.ErrNotFound
+	}
+	return cur, err
+}
+
+// lifecycleState - состояние для журнала, где меняются только статус и расписание
+func lifecycleState(status string, publishAt, unpublishAt pgtype.Timestamptz) *auditState {
+	s := &auditState{Status: status}
+	if publishAt.Valid {
+		s.PublishAt = publishAt.Time.UTC().Format(time.RFC3339)
+	}
+	if unpublishAt.Valid {
+		s.UnpublishAt = unpublishAt.Time.UTC().Format(time.RFC3339)
+	}
+	return s
+}
+
+func scheduleFromDB(publishAt, unpublishAt pgtype.Timestamptz) models.Schedule {
+	return models.Schedule{PublishAt: publishAt.Time, UnpublishAt: unpublishAt.Time}
+}
+
+// optionalTime - нулевое время пишется как NULL
+func optionalTime(t time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: t, Valid: !t.IsZero()}
+}
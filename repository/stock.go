@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/repository/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// AdjustStock меняет остаток на складе и пишет движение в журнал в одной
+// транзакции; списание больше остатка возвращает ErrInsufficientStock
+func (r *Repository) AdjustStock(ctx context.Context, productID, location string, delta int, reason, actor string) (int, error) {
+	var quantity int32
+	tenant := auth.Tenant(ctx)
+	err := r.inTx(ctx, func(q *db.Queries) error {
+		var err error
+		if delta > 0 {
+			quantity, err = q.IncrementStock(ctx, db.IncrementStockParams{
+				ProductID: productID,
+				Location:  location,
+				Amount:    int32(delta),
+				TenantID:  tenant,
+			})
+			if err != nil {
+				return categoryError(err)
+			}
+		} else {
+			quantity, err = q.DecrementStock(ctx, db.DecrementStockParams{
+				Amount:    int32(-delta),
+				ProductID: productID,
+				Location:  location,
+				TenantID:  tenant,
+			})
+			if errors.Is(err, pgx.ErrNoRows) {
+				if _, err := q.GetLowStockThreshold(ctx, db.GetLowStockThresholdParams{ID: productID, TenantID: tenant}); errors.Is(err, pgx.ErrNoRows) {
+					return models.ErrNotFound
+				}
+				return models.ErrInsufficientStock
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return q.AddStockMovement(ctx, db.AddStockMovementParams{
+			ProductID:     productID,
+			Location:      location,
+			Delta:         int32(delta),
+			QuantityAfter: quantity,
+			Reason:        reason,
+			Actor:         actor,
+			TenantID:      tenant,
+		})
+	})
+	return int(quantity), err
+}
+
+func (r *Repository) GetStock(ctx context.Context, productID string) (models.Stock, error) {
+	threshold, err := r.q.GetLowStockThreshold(ctx, db.GetLowStockThresholdParams{ID: productID, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Stock{}, models.ErrNotFound
+		}
+		return models.Stock{}, err
+	}
+	levels, err := r.q.ListStockLevels(ctx, db.ListStockLevelsParams{ProductID: productID, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		return models.Stock{}, err
+	}
+	stock := models.Stock{ProductID: productID, LowStockThreshold: int(threshold)}
+	for _, l := range levels {
+		stock.Levels = append(stock.Levels, models.StockLevel{Location: l.Location, Quantity: int(l.Quantity), Reserved: int(l.Reserved)})
+		stock.Total += int(l.Quantity)
+		stock.Reserved += int(l.Reserved)
+	}
+	return stock, nil
+}
+
+func (r *Repository) SetLowStockThreshold(ctx context.Context, productID string, threshold int) error {
+	rows, err := r.q.SetLowStockThreshold(ctx, db.SetLowStockThresholdParams{
+		ID:                productID,
+		LowStockThreshold: int32(threshold),
+		TenantID:          auth.Tenant(ctx),
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return models.ErrNotFound
+	}
+	return nil
+}
+
+// ListLowStock - товары, у которых суммарный остаток не выше порога (без разбивки по складам)
+func (r *Repository) ListLowStock(ctx context.Context, limit, offset int) ([]models.Stock, error) {
+	ress, err := r.q.ListLowStock(ctx, db.ListLowStockParams{TenantID: auth.Tenant(ctx), Lim: int32(limit), Off: int32(offset)})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]models.Stock, len(ress))
+	for i, res := range ress {
+		result[i] = models.Stock{ProductID: res.ID, Total: int(res.Total), LowStockThreshold: int(res.LowStockThreshold)}
+	}
+	return result, nil
+}
+
+func (r *Repository) ListStockMovements(ctx context.Context, productID string, limit, offset int) ([]models.StockMovement, error) {
+	ress, err := r.q.ListStockMovements(ctx, db.ListStockMovementsParams{
+		ProductID: productID,
+		TenantID:  auth.Tenant(ctx),
+		Lim:       int32(limit),
+		Off:       int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]models.StockMovement, len(ress))
+	for i, res := range ress {
+		result[i] = models.StockMovement{
+			ID:            res.ID,
+			ProductID:     res.ProductID,
+			Location:      res.Location,
+			Delta:         int(res.Delta),
+			QuantityAfter: int(res.QuantityAfter),
+			Reason:        res.Reason,
+			Actor:         res.Actor,
+			CreatedAt:     res.CreatedAt.Time,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/repository/db"
+)
+
+func (r *Repository) SetTranslation(ctx context.Context, productID string, t models.Translation) error {
+	err := r.q.UpsertProductTranslation(ctx, db.UpsertProductTranslationParams{
+		TenantID:    auth.Tenant(ctx),
+		ProductID:   productID,
+		Locale:      t.Locale,
+		Name:        t.Name,
+		Description: t.Description,
+	})
+	return categoryError(err)
+}
+
+func (r *Repository) DeleteTranslation(ctx context.Context, productID, locale string) error {
+	n, err := r.q.DeleteProductTranslation(ctx, db.DeleteProductTranslationParams{
+		TenantID:  auth.Tenant(ctx),
+		ProductID: productID,
+		Locale:    locale,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return models.ErrNotFound
+	}
+	return nil
+}
+
+func (r *Repository) ListTranslations(ctx context.Context, productID string) ([]models.Translation, error) {
+	rows, err := r.q.ListProductTranslations(ctx, db.ListProductTranslationsParams{TenantID: auth.Tenant(ctx), ProductID: productID})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]models.Translation, len(rows))
+	for i, row := range rows {
+		res[i] = models.Translation{
+			Locale:      row.Locale,
+			Name:        row.Name,
+			Description: row.Description,
+			UpdatedAt:   row.UpdatedAt.Time,
+		}
+	}
+	return res, nil
+}
+
+// FindTranslations - переводы товаров в любой из locales: id товара -> локаль -> перевод
+func (r *Repository) FindTranslations(ctx context.Context, productIDs, locales []string) (map[string]map[string]models.Translation, error) {
+	rows, err := r.q.FindTranslations(ctx, db.FindTranslationsParams{
+		TenantID:   auth.Tenant(ctx),
+		ProductIds: productIDs,
+		Locales:    locales,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]map[string]models.Translation)
+	for _, row := range rows {
+		if res[row.ProductID] == nil {
+			res[row.ProductID] = make(map[string]models.Translation)
+		}
+		res[row.ProductID][row.Locale] = models.Translation{Locale: row.Locale, Name: row.Name, Description: row.Description}
+	}
+	return res, nil
+}
+
+func (r *Repository) ListMissingTranslations(ctx context.Context, locale string, limit, offset int) ([]models.UntranslatedProduct, error) {
+	rows, err := r.q.ListMissingTranslations(ctx, db.ListMissingTranslationsParams{
+		TenantID: auth.Tenant(ctx),
+		Locale:   locale,
+		Lim:      int32(limit),
+		Off:      int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]models.UntranslatedProduct, len(rows))
+	for i, row := range rows {
+		res[i] = models.UntranslatedProduct{ID: row.ID, Name: row.Name}
+	}
+	return res, nil
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/glekoz/online-shop_product/pkg/auth"
+	"github.com/glekoz/online-shop_product/pkg/models"
+	"github.com/glekoz/online-shop_product/pkg/money"
+	"github.com/glekoz/online-shop_product/repository/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *Repository) CreateVariant(ctx context.Context, id string, v models.Variant) error {
+	options, err := json.Marshal(optionsOrEmpty(v.Options))
+	if err != nil {
+		return err
+	}
+	err = r.q.CreateVariant(ctx, db.CreateVariantParams{
+		ID:            id,
+		ProductID:     v.ProductID,
+		Sku:           v.SKU,
+		PriceOverride: nullInt(v.PriceOverride),
+		Options:       options,
+		Barcode:       nullText(v.Barcode),
+		TenantID:      auth.Tenant(ctx),
+	})
+	return categoryError(err)
+}
+
+func (r *Repository) GetVariant(ctx context.Context, id string) (models.Variant, error) {
+	res, err := r.q.GetVariant(ctx, db.GetVariantParams{ID: id, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Variant{}, models.ErrNotFound
+		}
+		return models.Variant{}, err
+	}
+	return variantFromDB(db.ListProductVariantsRow(res))
+}
+
+func (r *Repository) GetVariantBySKU(ctx context.Context, sku string) (models.Variant, error) {
+	res, err := r.q.GetVariantBySKU(ctx, db.GetVariantBySKUParams{Sku: sku, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Variant{}, models.ErrNotFound
+		}
+		return models.Variant{}, err
+	}
+	return variantFromDB(db.ListProductVariantsRow(res))
+}
+
+func (r *Repository) ListVariants(ctx context.Context, productID string) ([]models.Variant, error) {
+	ress, err := r.q.ListProductVariants(ctx, db.ListProductVariantsParams{ProductID: productID, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]models.Variant, len(ress))
+	for i, res := range ress {
+		if result[i], err = variantFromDB(res); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (r *Repository) UpdateVariant(ctx context.Context, id string, v models.Variant) error {
+	options, err := json.Marshal(optionsOrEmpty(v.Options))
+	if err != nil {
+		return err
+	}
+	rows, err := r.q.UpdateVariant(ctx, db.UpdateVariantParams{
+		ID:            id,
+		Sku:           v.SKU,
+		PriceOverride: nullInt(v.PriceOverride),
+		Options:       options,
+		Barcode:       nullText(v.Barcode),
+		TenantID:      auth.Tenant(ctx),
+	})
+	if err != nil {
+		return categoryError(err)
+	}
+	if rows == 0 {
+		return models.ErrNotFound
+	}
+	return nil
+}
+
+func (r *Repository) DeleteVariant(ctx context.Context, id string) error {
+	rows, err := r.q.DeleteVariant(ctx, db.DeleteVariantParams{ID: id, TenantID: auth.Tenant(ctx)})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return models.ErrNotFound
+	}
+	return nil
+}
+
+func variantFromDB(res db.ListProductVariantsRow) (models.Variant, error) {
+	v := models.Variant{
+		ID:            res.ID,
+		ProductID:     res.ProductID,
+		SKU:           res.Sku,
+		PriceOverride: res.PriceOverride.Int64,
+		Price:         money.New(res.Price, res.Currency),
+		Barcode:       res.Barcode.String,
+	}
+	if err := json.Unmarshal(res.Options, &v.Options); err != nil {
+		return models.Variant{}, err
+	}
+	return v, nil
+}
+
+func optionsOrEmpty(o map[string]string) map[string]string {
+	if o == nil {
+		return map[string]string{}
+	}
+	return o
+}
+
+func nullInt(n int64) pgtype.Int8 {
+	return pgtype.Int8{Int64: n, Valid: n != 0}
+}